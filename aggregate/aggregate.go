@@ -0,0 +1,191 @@
+// Package aggregate groups transfer and transaction records into time or
+// height buckets and computes counts, sums and unique address counts.
+//
+// Any record type can be aggregated by describing how to read it with Fields;
+// ready-made Fields are provided for the flow service's transfer and
+// transaction models.
+package aggregate
+
+import (
+	"sort"
+	"time"
+
+	"github.com/peterargue/find-api/flow"
+)
+
+// Fields describes how to read the values aggregated from a record of type T.
+// Time is required for ByHour/ByDay/ByInterval and Height for ByHeight; Value
+// and Addresses are optional.
+type Fields[T any] struct {
+	// Time returns the record's timestamp. Records with a zero time are skipped.
+	Time func(T) time.Time
+	// Height returns the record's block height
+	Height func(T) uint64
+	// Value returns the amount summed into Bucket.Sum
+	Value func(T) float64
+	// Addresses returns the addresses counted into Bucket.UniqueAddresses
+	Addresses func(T) []string
+}
+
+// Bucket holds the aggregate of all records falling into one time or height window
+type Bucket struct {
+	// Start and End bound time buckets (End is exclusive); zero for height buckets
+	Start time.Time
+	End   time.Time
+	// StartHeight and EndHeight bound height buckets (EndHeight is exclusive); zero for time buckets
+	StartHeight uint64
+	EndHeight   uint64
+
+	Count           int
+	Sum             float64
+	UniqueAddresses int
+}
+
+// ByHour buckets records into UTC hours
+func ByHour[T any](records []T, f Fields[T]) []Bucket {
+	return ByInterval(records, time.Hour, f)
+}
+
+// ByDay buckets records into UTC days
+func ByDay[T any](records []T, f Fields[T]) []Bucket {
+	return ByInterval(records, 24*time.Hour, f)
+}
+
+// ByInterval buckets records into fixed UTC time windows of the given size.
+// Buckets are returned in ascending order; empty windows are omitted.
+func ByInterval[T any](records []T, interval time.Duration, f Fields[T]) []Bucket {
+	if f.Time == nil || interval <= 0 {
+		return nil
+	}
+	buckets := group(records, f, func(r T) (uint64, bool) {
+		t := f.Time(r)
+		if t.IsZero() {
+			return 0, false
+		}
+		return uint64(t.UTC().Truncate(interval).Unix()), true
+	})
+	return finish(buckets, func(key uint64, b *Bucket) {
+		b.Start = time.Unix(int64(key), 0).UTC()
+		b.End = b.Start.Add(interval)
+	})
+}
+
+// ByHeight buckets records into block height windows of the given size.
+// Buckets are returned in ascending order; empty windows are omitted.
+func ByHeight[T any](records []T, window uint64, f Fields[T]) []Bucket {
+	if f.Height == nil || window == 0 {
+		return nil
+	}
+	buckets := group(records, f, func(r T) (uint64, bool) {
+		h := f.Height(r)
+		return h - h%window, true
+	})
+	return finish(buckets, func(key uint64, b *Bucket) {
+		b.StartHeight = key
+		b.EndHeight = key + window
+	})
+}
+
+// Total aggregates all records into a single bucket
+func Total[T any](records []T, f Fields[T]) Bucket {
+	buckets := group(records, f, func(T) (uint64, bool) { return 0, true })
+	if len(buckets) == 0 {
+		return Bucket{}
+	}
+	return buckets[0].Bucket
+}
+
+// keyedBucket is a bucket with the key it was grouped by
+type keyedBucket struct {
+	key uint64
+	Bucket
+}
+
+// group aggregates records by key, returning buckets in ascending key order
+func group[T any](records []T, f Fields[T], key func(T) (uint64, bool)) []keyedBucket {
+	byKey := make(map[uint64]*keyedBucket)
+	addresses := make(map[uint64]map[string]struct{})
+
+	for _, r := range records {
+		k, ok := key(r)
+		if !ok {
+			continue
+		}
+		b, ok := byKey[k]
+		if !ok {
+			b = &keyedBucket{key: k}
+			byKey[k] = b
+			addresses[k] = make(map[string]struct{})
+		}
+		b.Count++
+		if f.Value != nil {
+			b.Sum += f.Value(r)
+		}
+		if f.Addresses != nil {
+			for _, addr := range f.Addresses(r) {
+				if addr != "" {
+					addresses[k][addr] = struct{}{}
+				}
+			}
+		}
+	}
+
+	buckets := make([]keyedBucket, 0, len(byKey))
+	for k, b := range byKey {
+		b.UniqueAddresses = len(addresses[k])
+		buckets = append(buckets, *b)
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		return buckets[i].key < buckets[j].key
+	})
+	return buckets
+}
+
+// finish sets each bucket's bounds from its key and strips the keys
+func finish(keyed []keyedBucket, bounds func(key uint64, b *Bucket)) []Bucket {
+	buckets := make([]Bucket, len(keyed))
+	for i, kb := range keyed {
+		buckets[i] = kb.Bucket
+		bounds(kb.key, &buckets[i])
+	}
+	return buckets
+}
+
+// parseTime parses an API timestamp, returning the zero time if it is invalid
+func parseTime(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// FTTransfers aggregates fungible token transfers, summing Amount
+var FTTransfers = Fields[flow.FTTransfer]{
+	Time:   func(t flow.FTTransfer) time.Time { return parseTime(t.Timestamp) },
+	Height: func(t flow.FTTransfer) uint64 { return t.BlockHeight },
+	Value:  func(t flow.FTTransfer) float64 { return t.Amount },
+	Addresses: func(t flow.FTTransfer) []string {
+		return []string{t.Sender, t.Receiver}
+	},
+}
+
+// NFTTransfers aggregates NFT transfers, summing one per transfer
+var NFTTransfers = Fields[flow.NFTTransfer]{
+	Time:   func(t flow.NFTTransfer) time.Time { return parseTime(t.Timestamp) },
+	Height: func(t flow.NFTTransfer) uint64 { return t.BlockHeight },
+	Value:  func(flow.NFTTransfer) float64 { return 1 },
+	Addresses: func(t flow.NFTTransfer) []string {
+		return []string{t.Sender, t.Receiver}
+	},
+}
+
+// Transactions aggregates transactions, summing Fee and counting payers, proposers and authorizers
+var Transactions = Fields[flow.Transaction]{
+	Time:   func(t flow.Transaction) time.Time { return parseTime(t.Timestamp) },
+	Height: func(t flow.Transaction) uint64 { return t.BlockHeight },
+	Value:  func(t flow.Transaction) float64 { return t.Fee },
+	Addresses: func(t flow.Transaction) []string {
+		return append([]string{t.Payer, t.Proposer}, t.Authorizers...)
+	},
+}
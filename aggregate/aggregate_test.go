@@ -0,0 +1,102 @@
+package aggregate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/peterargue/find-api/flow"
+)
+
+var transfers = []flow.FTTransfer{
+	{BlockHeight: 105, Timestamp: "2024-01-15T10:05:00Z", Amount: 1.5, Sender: "0x1", Receiver: "0x2"},
+	{BlockHeight: 150, Timestamp: "2024-01-15T10:55:00Z", Amount: 2.5, Sender: "0x1", Receiver: "0x3"},
+	{BlockHeight: 230, Timestamp: "2024-01-15T12:00:00Z", Amount: 4, Sender: "0x2", Receiver: "0x1"},
+	{BlockHeight: 240, Timestamp: "2024-01-16T00:30:00Z", Amount: 1, Sender: "0x4", Receiver: "0x1"},
+	{BlockHeight: 250, Timestamp: "not-a-time", Amount: 100},
+}
+
+func TestByHour(t *testing.T) {
+	buckets := ByHour(transfers, FTTransfers)
+	if len(buckets) != 3 {
+		t.Fatalf("Expected 3 buckets, got %d", len(buckets))
+	}
+
+	first := buckets[0]
+	wantStart := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	if !first.Start.Equal(wantStart) {
+		t.Errorf("Expected start %s, got %s", wantStart, first.Start)
+	}
+	if !first.End.Equal(wantStart.Add(time.Hour)) {
+		t.Errorf("Expected end %s, got %s", wantStart.Add(time.Hour), first.End)
+	}
+	if first.Count != 2 {
+		t.Errorf("Expected count 2, got %d", first.Count)
+	}
+	if first.Sum != 4 {
+		t.Errorf("Expected sum 4, got %f", first.Sum)
+	}
+	if first.UniqueAddresses != 3 {
+		t.Errorf("Expected 3 unique addresses, got %d", first.UniqueAddresses)
+	}
+	if first.StartHeight != 0 {
+		t.Errorf("Expected zero StartHeight for time bucket, got %d", first.StartHeight)
+	}
+}
+
+func TestByDay(t *testing.T) {
+	buckets := ByDay(transfers, FTTransfers)
+	if len(buckets) != 2 {
+		t.Fatalf("Expected 2 buckets, got %d", len(buckets))
+	}
+	if buckets[0].Count != 3 || buckets[1].Count != 1 {
+		t.Errorf("Expected counts 3 and 1, got %d and %d", buckets[0].Count, buckets[1].Count)
+	}
+	if !buckets[1].Start.Equal(time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected second bucket to start on 2024-01-16, got %s", buckets[1].Start)
+	}
+}
+
+func TestByHeight(t *testing.T) {
+	buckets := ByHeight(transfers, 100, FTTransfers)
+	if len(buckets) != 2 {
+		t.Fatalf("Expected 2 buckets, got %d", len(buckets))
+	}
+	if buckets[0].StartHeight != 100 || buckets[0].EndHeight != 200 {
+		t.Errorf("Expected window [100, 200), got [%d, %d)", buckets[0].StartHeight, buckets[0].EndHeight)
+	}
+	// Height buckets include records with unparsable timestamps
+	if buckets[1].Count != 3 {
+		t.Errorf("Expected count 3, got %d", buckets[1].Count)
+	}
+	if buckets[1].Sum != 105 {
+		t.Errorf("Expected sum 105, got %f", buckets[1].Sum)
+	}
+}
+
+func TestTotal(t *testing.T) {
+	total := Total(transfers, FTTransfers)
+	if total.Count != 5 {
+		t.Errorf("Expected count 5, got %d", total.Count)
+	}
+	if total.UniqueAddresses != 4 {
+		t.Errorf("Expected 4 unique addresses, got %d", total.UniqueAddresses)
+	}
+	if Total([]flow.FTTransfer{}, FTTransfers).Count != 0 {
+		t.Error("Expected empty total for no records")
+	}
+}
+
+func TestCustomFields(t *testing.T) {
+	type point struct {
+		height uint64
+		value  float64
+	}
+	points := []point{{1, 1}, {2, 2}, {11, 3}}
+	buckets := ByHeight(points, 10, Fields[point]{
+		Height: func(p point) uint64 { return p.height },
+		Value:  func(p point) float64 { return p.value },
+	})
+	if len(buckets) != 2 || buckets[0].Sum != 3 || buckets[1].Sum != 3 {
+		t.Errorf("Unexpected buckets: %+v", buckets)
+	}
+}
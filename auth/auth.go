@@ -4,18 +4,23 @@ import (
 	"context"
 	"net/http"
 	"net/url"
+	"sync"
 	"time"
 )
 
 // Client is an interface for making HTTP requests to the API
 type Client interface {
+	DoRequest(ctx context.Context, method, path string, query url.Values) (*http.Response, error)
+	DoRequestWithBody(ctx context.Context, method, path string, query url.Values, body any) (*http.Response, error)
 	DoRequestWithBasicAuth(ctx context.Context, method, path string, query url.Values, username, password string) (*http.Response, error)
 	DecodeResponse(resp *http.Response, v any) error
 }
 
 // Service handles Auth API operations
 type Service struct {
-	client   Client
+	client Client
+
+	mu       sync.RWMutex
 	username string
 	password string
 }
@@ -29,6 +34,15 @@ func NewService(client Client, username, password string) *Service {
 	}
 }
 
+// SetCredentials replaces the credentials used for future token requests.
+// It is safe to call concurrently with GenerateToken.
+func (s *Service) SetCredentials(username, password string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.username = username
+	s.password = password
+}
+
 // TokenResponse represents the response from the JWT generation endpoint
 type TokenResponse struct {
 	AccessToken  string `json:"access_token"`
@@ -46,7 +60,11 @@ func (s *Service) GenerateToken(ctx context.Context, expiry time.Duration) (*Tok
 	query := url.Values{}
 	query.Set("expiry", expiry.String())
 
-	resp, err := s.client.DoRequestWithBasicAuth(ctx, http.MethodPost, "/auth/v1/generate", query, s.username, s.password)
+	s.mu.RLock()
+	username, password := s.username, s.password
+	s.mu.RUnlock()
+
+	resp, err := s.client.DoRequestWithBasicAuth(ctx, http.MethodPost, "/auth/v1/generate", query, username, password)
 	if err != nil {
 		return nil, err
 	}
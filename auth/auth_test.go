@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -35,6 +36,33 @@ func (m *mockClient) DoRequestWithBasicAuth(ctx context.Context, method, path st
 	return http.DefaultClient.Do(req)
 }
 
+func (m *mockClient) DoRequest(ctx context.Context, method, path string, query url.Values) (*http.Response, error) {
+	return m.DoRequestWithBody(ctx, method, path, query, nil)
+}
+
+func (m *mockClient) DoRequestWithBody(ctx context.Context, method, path string, query url.Values, body any) (*http.Response, error) {
+	u, err := url.Parse(m.server.URL + path)
+	if err != nil {
+		return nil, err
+	}
+	u.RawQuery = query.Encode()
+
+	var bodyReader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		bodyReader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bodyReader)
+	if err != nil {
+		return nil, err
+	}
+	return http.DefaultClient.Do(req)
+}
+
 func (m *mockClient) DecodeResponse(resp *http.Response, v any) error {
 	defer resp.Body.Close()
 
@@ -0,0 +1,100 @@
+package findapi
+
+import (
+	"context"
+	"iter"
+	"math/rand/v2"
+	"time"
+)
+
+// Backoff computes exponentially growing delays with jitter. It is the same
+// implementation the client uses between transient retries, exported so
+// polling loops outside the SDK can reuse it.
+//
+//	for attempt := range findapi.DefaultBackoff().Attempts(ctx) {
+//		if err := poll(ctx); err == nil {
+//			break
+//		}
+//	}
+type Backoff struct {
+	// Base is the delay before the first retry
+	Base time.Duration
+	// Max caps the delay between attempts
+	Max time.Duration
+	// MaxAttempts limits the number of attempts (0 means unlimited)
+	MaxAttempts int
+	// NoJitter disables randomisation, returning the exact exponential delay
+	NoJitter bool
+}
+
+// DefaultBackoff returns the backoff policy used by the client for transient retries
+func DefaultBackoff() Backoff {
+	return Backoff{
+		Base: retryBaseDelay,
+		Max:  retryMaxDelay,
+	}
+}
+
+// Delay returns the delay to wait after the given attempt (0-based).
+// With jitter enabled the result lies between half and all of the exponential delay.
+func (b Backoff) Delay(attempt int) time.Duration {
+	if b.Base <= 0 {
+		return 0
+	}
+	if attempt < 0 {
+		attempt = 0
+	}
+
+	d := b.Max
+	if attempt < 63 {
+		if exp := b.Base << attempt; exp > 0 && exp>>attempt == b.Base {
+			d = exp
+		}
+	}
+	if b.Max > 0 && d > b.Max {
+		d = b.Max
+	}
+	if d <= 0 {
+		d = b.Base
+	}
+
+	if b.NoJitter {
+		return d
+	}
+	// Equal jitter: wait at least half the delay, plus a random share of the rest
+	half := d / 2
+	return half + rand.N(half+1)
+}
+
+// Delays iterates over the delays for successive attempts without sleeping.
+// The sequence ends after MaxAttempts-1 delays, or never if MaxAttempts is 0.
+func (b Backoff) Delays() iter.Seq[time.Duration] {
+	return func(yield func(time.Duration) bool) {
+		for attempt := 0; b.MaxAttempts <= 0 || attempt < b.MaxAttempts-1; attempt++ {
+			if !yield(b.Delay(attempt)) {
+				return
+			}
+		}
+	}
+}
+
+// Attempts iterates over attempt numbers (starting at 0), sleeping the backoff
+// delay between them. The first attempt is yielded immediately. Iteration stops
+// when the caller breaks, MaxAttempts is reached, or ctx is done; check ctx.Err()
+// after the loop to distinguish cancellation from exhaustion.
+func (b Backoff) Attempts(ctx context.Context) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for attempt := 0; b.MaxAttempts <= 0 || attempt < b.MaxAttempts; attempt++ {
+			if attempt > 0 {
+				if err := sleepContext(ctx, b.Delay(attempt-1)); err != nil {
+					return
+				}
+			} else if ctx.Err() != nil {
+				return
+			}
+			if !yield(attempt) {
+				return
+			}
+		}
+	}
+}
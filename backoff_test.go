@@ -0,0 +1,85 @@
+package findapi
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestBackoff_Delay(t *testing.T) {
+	b := Backoff{Base: 100 * time.Millisecond, Max: time.Second, NoJitter: true}
+
+	expected := []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+		time.Second,
+	}
+	for attempt, want := range expected {
+		if got := b.Delay(attempt); got != want {
+			t.Errorf("attempt %d: expected %v, got %v", attempt, want, got)
+		}
+	}
+
+	// Large attempts must not overflow
+	if got := b.Delay(200); got != time.Second {
+		t.Errorf("Expected capped delay for large attempt, got %v", got)
+	}
+}
+
+func TestBackoff_DelayJitter(t *testing.T) {
+	b := DefaultBackoff()
+	for attempt := 0; attempt < 10; attempt++ {
+		d := b.Delay(attempt)
+		if d <= 0 || d > b.Max {
+			t.Errorf("attempt %d: delay %v out of range", attempt, d)
+		}
+	}
+}
+
+func TestBackoff_Delays(t *testing.T) {
+	b := Backoff{Base: time.Millisecond, Max: time.Second, MaxAttempts: 4, NoJitter: true}
+
+	var delays []time.Duration
+	for d := range b.Delays() {
+		delays = append(delays, d)
+	}
+	if len(delays) != 3 {
+		t.Fatalf("Expected 3 delays for 4 attempts, got %d", len(delays))
+	}
+	if delays[2] != 4*time.Millisecond {
+		t.Errorf("Expected third delay 4ms, got %v", delays[2])
+	}
+}
+
+func TestBackoff_Attempts(t *testing.T) {
+	b := Backoff{Base: time.Millisecond, Max: 5 * time.Millisecond, MaxAttempts: 3}
+
+	count := 0
+	for attempt := range b.Attempts(context.Background()) {
+		if attempt != count {
+			t.Errorf("Expected attempt %d, got %d", count, attempt)
+		}
+		count++
+	}
+	if count != 3 {
+		t.Errorf("Expected 3 attempts, got %d", count)
+	}
+}
+
+func TestBackoff_AttemptsCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	b := Backoff{Base: time.Hour, Max: time.Hour}
+
+	count := 0
+	for range b.Attempts(ctx) {
+		count++
+		cancel()
+	}
+	if count != 1 {
+		t.Errorf("Expected iteration to stop after cancellation, got %d attempts", count)
+	}
+}
@@ -0,0 +1,90 @@
+package findapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Bulk runs fns with at most concurrency of them in flight and waits for all
+// of them to finish. Every function runs even if others fail; the failures are
+// returned joined, each prefixed with the function's index. Functions that had
+// not started when ctx is done are skipped and ctx's error is included.
+//
+// Requests made through the same Client share its rate limit handling, so a
+// 429 from one call backs off that call without failing the batch.
+func Bulk(ctx context.Context, concurrency int, fns ...func(ctx context.Context) error) error {
+	f := NewBulkFetcher[struct{}](concurrency)
+	for _, fn := range fns {
+		f.Add(func(ctx context.Context) (struct{}, error) {
+			return struct{}{}, fn(ctx)
+		})
+	}
+	_, err := f.Do(ctx)
+	return err
+}
+
+// BulkFetcher runs typed fetches with bounded concurrency and collects their
+// results in the order they were added
+type BulkFetcher[T any] struct {
+	concurrency int
+	fns         []func(ctx context.Context) (T, error)
+}
+
+// NewBulkFetcher creates a fetcher running at most concurrency fetches at once.
+// A concurrency below 1 runs one at a time.
+func NewBulkFetcher[T any](concurrency int) *BulkFetcher[T] {
+	return &BulkFetcher[T]{concurrency: max(concurrency, 1)}
+}
+
+// Add queues a fetch, typically a builder's Do method value or a closure
+// around one
+func (f *BulkFetcher[T]) Add(fn func(ctx context.Context) (T, error)) *BulkFetcher[T] {
+	f.fns = append(f.fns, fn)
+	return f
+}
+
+// Do runs the queued fetches and returns their results in the order they were
+// added. A failed or skipped fetch leaves the zero value in its slot; the
+// failures are returned joined as with Bulk.
+func (f *BulkFetcher[T]) Do(ctx context.Context) ([]T, error) {
+	results := make([]T, len(f.fns))
+	errs := make([]error, len(f.fns))
+
+	var (
+		wg      sync.WaitGroup
+		sem     = make(chan struct{}, f.concurrency)
+		skipped bool
+	)
+	for i, fn := range f.fns {
+		if ctx.Err() == nil {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+			}
+		}
+		if ctx.Err() != nil {
+			skipped = true
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			v, err := fn(ctx)
+			if err != nil {
+				errs[i] = fmt.Errorf("call %d: %w", i, err)
+				return
+			}
+			results[i] = v
+		}()
+	}
+	wg.Wait()
+
+	if skipped {
+		errs = append(errs, ctx.Err())
+	}
+	return results, errors.Join(errs...)
+}
@@ -0,0 +1,81 @@
+package findapi
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBulk(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	errBoom := errors.New("boom")
+
+	var fns []func(context.Context) error
+	for i := range 10 {
+		fns = append(fns, func(ctx context.Context) error {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			if i == 3 || i == 7 {
+				return errBoom
+			}
+			return nil
+		})
+	}
+
+	err := Bulk(context.Background(), 3, fns...)
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("Expected joined errors wrapping boom, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "call 3") || !strings.Contains(err.Error(), "call 7") {
+		t.Errorf("Expected both failures reported, got %v", err)
+	}
+	if p := peak.Load(); p > 3 {
+		t.Errorf("Expected at most 3 calls in flight, got %d", p)
+	}
+
+	if err := Bulk(context.Background(), 2); err != nil {
+		t.Errorf("Expected no error for empty batch, got %v", err)
+	}
+}
+
+func TestBulkFetcher(t *testing.T) {
+	f := NewBulkFetcher[int](4)
+	for i := range 8 {
+		f.Add(func(ctx context.Context) (int, error) {
+			time.Sleep(time.Duration(8-i) * time.Millisecond)
+			return i * i, nil
+		})
+	}
+	results, err := f.Do(context.Background())
+	if err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	for i, v := range results {
+		if v != i*i {
+			t.Errorf("Expected result %d in slot %d, got %d", i*i, i, v)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var calls atomic.Int32
+	_, err = NewBulkFetcher[int](1).
+		Add(func(ctx context.Context) (int, error) { calls.Add(1); return 1, nil }).
+		Do(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if calls.Load() != 0 {
+		t.Errorf("Expected no calls after cancellation, got %d", calls.Load())
+	}
+}
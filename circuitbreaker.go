@@ -0,0 +1,113 @@
+package findapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// circuitBreaker tracks consecutive failures per endpoint and rejects requests
+// to endpoints that are considered unhealthy until a cooldown has elapsed
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu        sync.Mutex
+	endpoints map[string]*circuitState
+}
+
+// circuitState is the breaker state for a single endpoint
+type circuitState struct {
+	failures int
+	open     bool
+	openedAt time.Time
+	probing  bool
+}
+
+// WithCircuitBreaker enables a per-endpoint circuit breaker, keyed by route
+// template (e.g. "GET /flow/v1/account/{address}") rather than the raw path.
+// After threshold consecutive failures (transport errors or 5xx responses)
+// the endpoint is opened and requests fail fast with a CircuitOpenError. Once
+// cooldown has elapsed a single probe request is let through; if it succeeds
+// the circuit closes, otherwise it opens again for another cooldown period.
+func WithCircuitBreaker(threshold int, cooldown time.Duration) ClientOption {
+	return func(c *Client) {
+		if threshold <= 0 {
+			c.breaker = nil
+			return
+		}
+		c.breaker = &circuitBreaker{
+			threshold: threshold,
+			cooldown:  cooldown,
+			endpoints: make(map[string]*circuitState),
+		}
+	}
+}
+
+// allow returns a CircuitOpenError if requests to the endpoint should be rejected
+func (cb *circuitBreaker) allow(endpoint string) error {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	st, ok := cb.endpoints[endpoint]
+	if !ok || !st.open {
+		return nil
+	}
+
+	remaining := time.Until(st.openedAt.Add(cb.cooldown))
+	if remaining > 0 {
+		return &CircuitOpenError{Endpoint: endpoint, RetryAfter: remaining}
+	}
+
+	// Half-open: let exactly one probe through
+	if st.probing {
+		return &CircuitOpenError{Endpoint: endpoint}
+	}
+	st.probing = true
+	return nil
+}
+
+// record updates the endpoint state with the outcome of a request
+func (cb *circuitBreaker) record(endpoint string, resp *http.Response, err error) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	st, ok := cb.endpoints[endpoint]
+	if !ok {
+		st = &circuitState{}
+		cb.endpoints[endpoint] = st
+	}
+
+	switch {
+	case err != nil && !isBreakerFailure(err):
+		// Neither success nor failure (e.g. cancelled); release any probe slot
+		st.probing = false
+	case err != nil, resp != nil && resp.StatusCode >= http.StatusInternalServerError:
+		st.failures++
+		if st.probing || st.failures >= cb.threshold {
+			st.open = true
+			st.openedAt = time.Now()
+		}
+		st.probing = false
+	default:
+		*st = circuitState{}
+	}
+}
+
+// isBreakerFailure reports whether an error indicates the endpoint is unhealthy
+func isBreakerFailure(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var rateLimitErr *RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= http.StatusInternalServerError
+	}
+	return true
+}
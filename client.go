@@ -1,6 +1,7 @@
 package findapi
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"encoding/json"
@@ -13,18 +14,24 @@ import (
 	"time"
 
 	"github.com/peterargue/find-api/auth"
+	"github.com/peterargue/find-api/find"
 	"github.com/peterargue/find-api/flow"
+	"github.com/peterargue/find-api/market"
+	"github.com/peterargue/find-api/search"
 	"github.com/peterargue/find-api/simple"
 )
 
 const (
-	FindApiURL = "https://api.find.xyz"
+	FindApiURL        = "https://api.find.xyz"
+	TestnetFindApiURL = "https://api.testnet.find.xyz"
 )
 
 // Client is the main client for interacting with the FindLabs API
 type Client struct {
 	httpClient *http.Client
 	baseURL    string
+	failover   *failover
+	network    Network
 	username   string
 	password   string
 
@@ -33,10 +40,44 @@ type Client struct {
 	accessToken string
 	tokenExpiry time.Time
 
+	// Headers applied to every request
+	userAgent      string
+	defaultHeaders http.Header
+
+	// Optional per-endpoint circuit breaker
+	breaker *circuitBreaker
+
+	// Optional deduplication of concurrent identical requests
+	flights *flightGroup
+
+	// Transport options resolved after all options are applied
+	transportConfig transportConfig
+
+	// Optional latency observation
+	slowHooks []slowRequestHook
+	latencies *latencyRecorder
+
+	// Retry policy
+	maxRetries       int
+	retryStatusCodes []int
+	// Cap on the total time a request waits out 429 responses (0 means no cap)
+	maxRateLimitWait time.Duration
+
+	// Latest rate limit quota reported by the API
+	rateLimits rateLimitTracker
+
+	// Reject response fields the SDK's types do not declare
+	strictDecoding bool
+	// Largest response body read, in bytes (0 means no limit)
+	maxResponseSize int64
+
 	// Services
 	Simple *simple.Service
 	Auth   *auth.Service
 	Flow   *flow.Service
+	Search *search.Service
+	Market *market.Service
+	Find   *find.Service
 }
 
 // ClientOption is a functional option for configuring the Client
@@ -56,6 +97,46 @@ func WithBaseURL(baseURL string) ClientOption {
 	}
 }
 
+// WithNetwork selects the Flow network to query, e.g. findapi.Testnet, and
+// its API base URL. WithBaseURL applied after it overrides the URL while
+// keeping the network.
+func WithNetwork(n Network) ClientOption {
+	return func(c *Client) {
+		c.network = n
+		c.baseURL = n.BaseURL
+	}
+}
+
+// Network returns the Flow network the client queries (Mainnet by default)
+func (c *Client) Network() Network {
+	return c.network
+}
+
+// StrictDecoding reports whether the client was created with
+// WithStrictDecoding, so service packages that decode streamed bodies
+// themselves can reject unknown fields too
+func (c *Client) StrictDecoding() bool {
+	return c.strictDecoding
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithDefaultHeader adds a header sent with every request (e.g. tenant or tracing IDs).
+// Headers managed by the client (Authorization, Accept, Content-Type) take precedence.
+func WithDefaultHeader(key, value string) ClientOption {
+	return func(c *Client) {
+		if c.defaultHeaders == nil {
+			c.defaultHeaders = make(http.Header)
+		}
+		c.defaultHeaders.Add(key, value)
+	}
+}
+
 // WithToken pre-loads a JWT token, skipping the Basic Auth credential flow.
 // Used by the CLI to inject a stored token without needing credentials.
 func WithToken(token string, exp int64) ClientOption {
@@ -67,26 +148,53 @@ func WithToken(token string, exp int64) ClientOption {
 	}
 }
 
+// WithStrictDecoding rejects responses containing fields the SDK's types do
+// not declare, returning a DecodeError naming the field. Use it in staging to
+// detect upstream schema changes before they silently drop data.
+func WithStrictDecoding() ClientOption {
+	return func(c *Client) {
+		c.strictDecoding = true
+	}
+}
+
+// WithMaxResponseSize caps the size of response bodies, including shared
+// deduplicated and streamed ones. Larger responses fail with a
+// ResponseTooLargeError instead of being read into memory, protecting
+// long-running collectors from pathological upstream responses. A limit of 0
+// or less disables the cap.
+func WithMaxResponseSize(bytes int64) ClientOption {
+	return func(c *Client) {
+		c.maxResponseSize = max(bytes, 0)
+	}
+}
+
 // NewClient creates a new FindLabs API client
 func NewClient(username, password string, opts ...ClientOption) *Client {
 	c := &Client{
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
-		baseURL:  FindApiURL,
-		username: username,
-		password: password,
+		baseURL:          FindApiURL,
+		network:          Mainnet,
+		username:         username,
+		password:         password,
+		maxRetries:       defaultMaxRetries,
+		retryStatusCodes: defaultRetryStatusCodes,
 	}
 
 	// Apply options
 	for _, opt := range opts {
 		opt(c)
 	}
+	c.configureTransport()
 
 	// Initialize services
 	c.Simple = simple.NewService(c)
 	c.Auth = auth.NewService(c, username, password)
 	c.Flow = flow.NewService(c)
+	c.Search = search.NewService(c)
+	c.Market = market.NewService(c)
+	c.Find = find.NewService(c)
 
 	return c
 }
@@ -94,14 +202,38 @@ func NewClient(username, password string, opts ...ClientOption) *Client {
 // DoRequest performs an HTTP request with automatic authentication and rate limiting handling
 // This method is exported to allow service packages to make requests
 func (c *Client) DoRequest(ctx context.Context, method, path string, query url.Values) (*http.Response, error) {
+	if c.flights != nil && method == http.MethodGet {
+		key := path
+		if len(query) > 0 {
+			key += "?" + query.Encode()
+		}
+		return c.flights.do(ctx, key, c.httpClient.Timeout, func(ctx context.Context) (*http.Response, error) {
+			return c.doRequest(ctx, method, path, query, nil)
+		})
+	}
 	return c.doRequest(ctx, method, path, query, nil)
 }
 
+// DoRequestWithBody performs an HTTP request with a JSON-encoded body, e.g. for
+// batch queries and write operations, with the same authentication, rate
+// limiting and retry handling as DoRequest. A nil body sends no body.
+// This method is exported to allow service packages to make requests
+func (c *Client) DoRequestWithBody(ctx context.Context, method, path string, query url.Values, body any) (*http.Response, error) {
+	var data []byte
+	if body != nil {
+		var err error
+		if data, err = json.Marshal(body); err != nil {
+			return nil, fmt.Errorf("failed to encode request body: %w", err)
+		}
+	}
+	return c.doRequest(ctx, method, path, query, data)
+}
+
 // DoRequestWithBasicAuth performs an HTTP request with Basic Auth (used by auth service)
 // This method is exported to allow the auth service to make requests without JWT
 func (c *Client) DoRequestWithBasicAuth(ctx context.Context, method, path string, query url.Values, username, password string) (*http.Response, error) {
 	// Build URL
-	u, err := url.Parse(c.baseURL + path)
+	u, err := url.Parse(c.activeBaseURL() + path)
 	if err != nil {
 		return nil, fmt.Errorf("invalid URL: %w", err)
 	}
@@ -115,6 +247,8 @@ func (c *Client) DoRequestWithBasicAuth(ctx context.Context, method, path string
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
+	c.applyDefaultHeaders(req)
+
 	// Set Basic Auth header
 	authStr := username + ":" + password
 	encodedAuth := base64.StdEncoding.EncodeToString([]byte(authStr))
@@ -131,9 +265,29 @@ func (c *Client) DoRequestWithBasicAuth(ctx context.Context, method, path string
 }
 
 // doRequest performs an HTTP request with automatic authentication and rate limiting handling
-func (c *Client) doRequest(ctx context.Context, method, path string, query url.Values, body io.Reader) (*http.Response, error) {
+func (c *Client) doRequest(ctx context.Context, method, path string, query url.Values, body []byte) (resp *http.Response, err error) {
+	rt := matchRoute(method, path)
+	start := time.Now()
+	defer func() {
+		status := 0
+		if resp != nil {
+			status = resp.StatusCode
+		}
+		c.observeRequest(rt, path, query, start, status, err)
+	}()
+
+	// Fail fast if the endpoint's circuit is open. Circuits are per route
+	// template, so requests for different IDs on a failing endpoint share one.
+	if c.breaker != nil {
+		endpoint := rt.method + " " + rt.template
+		if err := c.breaker.allow(endpoint); err != nil {
+			return nil, err
+		}
+		defer func() { c.breaker.record(endpoint, resp, err) }()
+	}
+
 	// Build URL
-	u, err := url.Parse(c.baseURL + path)
+	u, err := url.Parse(c.activeBaseURL() + path)
 	if err != nil {
 		return nil, fmt.Errorf("invalid URL: %w", err)
 	}
@@ -141,18 +295,23 @@ func (c *Client) doRequest(ctx context.Context, method, path string, query url.V
 		u.RawQuery = query.Encode()
 	}
 
-	// Create request
-	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	// Create request. A body is buffered so it can be resent on retries.
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bodyReader)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	// Set headers
+	c.applyDefaultHeaders(req)
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 
-	// Add authentication token (skip for auth endpoints)
-	if path != "/auth/v1/generate" {
+	// Add authentication token (skip for public and auth endpoints)
+	if rt.auth == authBearer {
 		token, err := c.getValidToken(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get valid token: %w", err)
@@ -160,39 +319,95 @@ func (c *Client) doRequest(ctx context.Context, method, path string, query url.V
 		req.Header.Set("Authorization", "Bearer "+token)
 	}
 
-	// Execute request with retry logic for rate limiting
-	var resp *http.Response
-	maxRetries := 3
-	for i := 0; i < maxRetries; i++ {
+	// Execute request with retry logic for rate limiting and transient failures
+	maxAttempts := c.maxRetries + 1
+	retryable := isIdempotent(method)
+	var rateLimitWaited time.Duration
+	for i := 0; i < maxAttempts; i++ {
+		if i > 0 && req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, fmt.Errorf("failed to reset request body: %w", err)
+			}
+		}
+		var base string
+		if c.failover != nil {
+			base = c.failover.pick()
+			if req.URL, err = url.Parse(base + path); err != nil {
+				return nil, fmt.Errorf("invalid URL: %w", err)
+			}
+			req.URL.RawQuery = u.RawQuery
+		}
 		resp, err = c.httpClient.Do(req)
+		if c.failover != nil {
+			c.failover.record(base, resp, err)
+		}
+		if err == nil {
+			c.rateLimits.observe(resp)
+		}
 		if err != nil {
+			// Retry connection resets, EOFs and timeouts for idempotent requests
+			if retryable && i < maxAttempts-1 && isTransientError(err) {
+				if err := sleepContext(ctx, backoffDelay(i)); err != nil {
+					return nil, err
+				}
+				continue
+			}
 			return nil, fmt.Errorf("request failed: %w", err)
 		}
 
-		// Handle rate limiting
+		// Handle rate limiting. Waits are skipped when they would overrun the
+		// rate limit budget or the context deadline, so callers fail fast
+		// instead of sleeping into a timeout.
 		if resp.StatusCode == http.StatusTooManyRequests {
 			retryAfter := c.getRetryAfter(resp)
-			if i < maxRetries-1 {
-				resp.Body.Close()
-				select {
-				case <-time.After(retryAfter):
-					continue
-				case <-ctx.Done():
-					return nil, ctx.Err()
+			resp.Body.Close()
+			if i < maxAttempts-1 && c.canWaitForRateLimit(ctx, rateLimitWaited, retryAfter) {
+				if err := sleepContext(ctx, retryAfter); err != nil {
+					return nil, err
 				}
+				rateLimitWaited += retryAfter
+				continue
 			}
-			// Last retry exhausted
-			defer resp.Body.Close()
-			return nil, &RateLimitError{RetryAfter: retryAfter}
+			return nil, &RateLimitError{RetryAfter: retryAfter, Attempts: i + 1}
 		}
 
-		// Success or non-rate-limit error
+		// Retry transient gateway errors (502/503/504 unless configured with
+		// WithRetryStatusCodes) for idempotent requests.
+		// Once retries are exhausted the response is returned as-is so the
+		// caller surfaces it as an APIError.
+		if retryable && i < maxAttempts-1 && c.isRetryStatus(resp.StatusCode) {
+			resp.Body.Close()
+			if err := sleepContext(ctx, backoffDelay(i)); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		// Success or non-retryable error
 		break
 	}
 
+	// Cap the body wherever it is read: DecodeResponse, shared deduplicated
+	// requests and streamed decoding
+	if c.maxResponseSize > 0 {
+		resp.Body = &limitedBody{ReadCloser: resp.Body, resp: resp, limit: c.maxResponseSize, remaining: c.maxResponseSize}
+	}
+
 	return resp, nil
 }
 
+// applyDefaultHeaders sets the configured User-Agent and default headers on a request
+func (c *Client) applyDefaultHeaders(req *http.Request) {
+	for key, values := range c.defaultHeaders {
+		for _, v := range values {
+			req.Header.Add(key, v)
+		}
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+}
+
 // getValidToken returns a valid JWT token, refreshing if necessary
 func (c *Client) getValidToken(ctx context.Context) (string, error) {
 	c.tokenMu.RLock()
@@ -214,6 +429,11 @@ func (c *Client) getValidToken(ctx context.Context) (string, error) {
 		return c.accessToken, nil
 	}
 
+	// Without credentials only public endpoints can be used
+	if c.username == "" && c.password == "" {
+		return "", ErrMissingCredentials
+	}
+
 	// Generate new token
 	tokenResp, err := c.Auth.GenerateToken(ctx, 10*time.Minute)
 	if err != nil {
@@ -221,11 +441,35 @@ func (c *Client) getValidToken(ctx context.Context) (string, error) {
 	}
 
 	c.accessToken = tokenResp.AccessToken
-	c.tokenExpiry = time.Unix(tokenResp.Exp, 0)
+	c.tokenExpiry = tokenExpiry(tokenResp.AccessToken, tokenResp.Exp)
 
 	return c.accessToken, nil
 }
 
+// SetCredentials replaces the username and password used to generate tokens
+// and discards the cached token, so the next authenticated request fetches a
+// new one with the new credentials. It is safe to call while requests are in
+// flight; those already holding a token complete with it.
+func (c *Client) SetCredentials(username, password string) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	c.username = username
+	c.password = password
+	c.accessToken = ""
+	c.tokenExpiry = time.Time{}
+	c.Auth.SetCredentials(username, password)
+}
+
+// Close discards the cached access token without contacting the API. The
+// client stays usable; the next authenticated request generates a new token.
+func (c *Client) Close() error {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	c.accessToken = ""
+	c.tokenExpiry = time.Time{}
+	return nil
+}
+
 // getRetryAfter extracts the retry-after duration from response headers
 func (c *Client) getRetryAfter(resp *http.Response) time.Duration {
 	retryAfter := resp.Header.Get("Retry-After")
@@ -248,6 +492,71 @@ func (c *Client) getRetryAfter(resp *http.Response) time.Duration {
 	return time.Second
 }
 
+// rawCaptureKey scopes raw response capture to a single client
+type rawCaptureKey struct{ client *Client }
+
+// RawCaptureFunc receives the exact bytes of a successful API response along
+// with the request that produced it
+type RawCaptureFunc func(req *http.Request, body []byte)
+
+// WithRawCapture returns a context under which every successful response
+// decoded by this client is also passed to fn, before typed decoding.
+// This lets callers archive the upstream JSON without issuing requests twice.
+func (c *Client) WithRawCapture(ctx context.Context, fn RawCaptureFunc) context.Context {
+	return context.WithValue(ctx, rawCaptureKey{client: c}, fn)
+}
+
+// DoRaw calls do, typically a builder's Do method value, under raw capture and
+// returns its typed result along with the JSON body of every upstream response
+// it decoded, in the order they arrived. Helpers that page or merge several
+// requests return one body per request.
+//
+//	resp, raw, err := findapi.DoRaw(ctx, client, client.Flow.GetAccount().Address(addr).Do)
+func DoRaw[T any](ctx context.Context, c *Client, do func(context.Context) (T, error)) (T, []json.RawMessage, error) {
+	var (
+		mu  sync.Mutex
+		raw []json.RawMessage
+	)
+	ctx = c.WithRawCapture(ctx, func(_ *http.Request, body []byte) {
+		mu.Lock()
+		defer mu.Unlock()
+		raw = append(raw, json.RawMessage(body))
+	})
+
+	v, err := do(ctx)
+	mu.Lock()
+	defer mu.Unlock()
+	return v, raw, err
+}
+
+// limitedBody is a response body that fails with a ResponseTooLargeError once
+// more than limit bytes are read
+type limitedBody struct {
+	io.ReadCloser
+	resp      *http.Response
+	limit     int64
+	remaining int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.remaining <= 0 {
+		// Read one byte past the limit to tell a body of exactly the limit
+		// from a larger one
+		var probe [1]byte
+		n, err := b.ReadCloser.Read(probe[:])
+		if n > 0 {
+			return 0, newResponseTooLargeError(b.resp, b.limit)
+		}
+		return 0, err
+	}
+	if int64(len(p)) > b.remaining {
+		p = p[:b.remaining]
+	}
+	n, err := b.ReadCloser.Read(p)
+	b.remaining -= int64(n)
+	return n, err
+}
+
 // DecodeResponse decodes a JSON response into the provided interface
 // This method is exported to allow service packages to decode responses
 func (c *Client) DecodeResponse(resp *http.Response, v any) error {
@@ -258,7 +567,11 @@ func (c *Client) DecodeResponse(resp *http.Response, v any) error {
 func (c *Client) decodeResponse(resp *http.Response, v any) error {
 	defer resp.Body.Close()
 
+	// Bodies over WithMaxResponseSize fail to read with a ResponseTooLargeError
 	body, err := io.ReadAll(resp.Body)
+	if IsResponseTooLargeError(err) {
+		return err
+	}
 	if err != nil {
 		return fmt.Errorf("failed to read response: %w", err)
 	}
@@ -270,17 +583,35 @@ func (c *Client) decodeResponse(resp *http.Response, v any) error {
 		}
 	}
 
+	if resp.Request != nil {
+		if fn, ok := resp.Request.Context().Value(rawCaptureKey{client: c}).(RawCaptureFunc); ok && fn != nil {
+			fn(resp.Request, body)
+		}
+	}
+
 	// Dump raw response when FIND_DEBUG=1 to help diagnose field mapping issues.
 	if os.Getenv("FIND_DEBUG") == "1" {
 		fmt.Fprintf(os.Stderr, "[debug] %s %s\n%s\n", resp.Request.Method, resp.Request.URL, body)
 	}
 
+	// Some endpoints report failures in an error field of a 200 response
+	if msg := bodyError(body); msg != "" {
+		return &APIError{
+			StatusCode: resp.StatusCode,
+			Message:    msg,
+		}
+	}
+
 	if v == nil {
 		return nil
 	}
 
-	if err := json.Unmarshal(body, v); err != nil {
-		return fmt.Errorf("failed to decode response: %w", err)
+	dec := json.NewDecoder(bytes.NewReader(body))
+	if c.strictDecoding {
+		dec.DisallowUnknownFields()
+	}
+	if err := dec.Decode(v); err != nil {
+		return newDecodeError(resp.Request, v, err)
 	}
 
 	return nil
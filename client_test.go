@@ -3,8 +3,14 @@ package findapi
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -125,3 +131,745 @@ func TestClient_RateLimitExhausted(t *testing.T) {
 		t.Errorf("Expected RateLimitError, got %T: %v", err, err)
 	}
 }
+
+func TestClient_RateLimitBudget(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Retry-After", "10")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	t.Run("context deadline", func(t *testing.T) {
+		requests = 0
+		client := NewClient("", "", WithBaseURL(server.URL), WithToken("token", time.Now().Add(time.Hour).Unix()))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		start := time.Now()
+		_, err := client.Simple.GetBlocks().Height(96708412).Do(ctx)
+
+		var rateLimitErr *RateLimitError
+		if !errors.As(err, &rateLimitErr) {
+			t.Fatalf("Expected RateLimitError, got %T: %v", err, err)
+		}
+		if rateLimitErr.Attempts != 1 || requests != 1 {
+			t.Errorf("Expected 1 attempt, got %d (%d requests)", rateLimitErr.Attempts, requests)
+		}
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("Expected to fail fast, took %v", elapsed)
+		}
+	})
+
+	t.Run("max wait", func(t *testing.T) {
+		requests = 0
+		client := NewClient("", "", WithBaseURL(server.URL), WithToken("token", time.Now().Add(time.Hour).Unix()),
+			WithMaxRateLimitWait(5*time.Second))
+
+		_, err := client.Simple.GetBlocks().Height(96708412).Do(context.Background())
+
+		var rateLimitErr *RateLimitError
+		if !errors.As(err, &rateLimitErr) {
+			t.Fatalf("Expected RateLimitError, got %T: %v", err, err)
+		}
+		if rateLimitErr.RetryAfter != 10*time.Second {
+			t.Errorf("Expected RetryAfter 10s, got %v", rateLimitErr.RetryAfter)
+		}
+		if rateLimitErr.Attempts != 1 || requests != 1 {
+			t.Errorf("Expected 1 attempt, got %d (%d requests)", rateLimitErr.Attempts, requests)
+		}
+	})
+}
+
+// serveTestToken answers the auth endpoint with a valid token and reports whether it handled the request
+func serveTestToken(w http.ResponseWriter, r *http.Request) bool {
+	if r.URL.Path != "/auth/v1/generate" {
+		return false
+	}
+	resp := struct {
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+		ExpiresIn   int    `json:"expires_in"`
+		Exp         int64  `json:"exp"`
+		Iat         int64  `json:"iat"`
+	}{
+		AccessToken: "test-token",
+		TokenType:   "Bearer",
+		ExpiresIn:   600,
+		Exp:         time.Now().Add(10 * time.Minute).Unix(),
+		Iat:         time.Now().Unix(),
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+	return true
+}
+
+func TestClient_RetryTransientStatus(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if serveTestToken(w, r) {
+			return
+		}
+
+		requestCount++
+		if requestCount < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"blocks":[{"height":96708412,"id":"abc123"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test", "test", WithBaseURL(server.URL))
+
+	resp, err := client.Simple.GetBlocks().Height(96708412).Do(context.Background())
+	if err != nil {
+		t.Fatalf("GetBlocks failed after retry: %v", err)
+	}
+	if len(resp.Blocks) != 1 {
+		t.Errorf("Expected 1 block, got %d", len(resp.Blocks))
+	}
+	if requestCount != 3 {
+		t.Errorf("Expected 3 requests (2 retries), got %d", requestCount)
+	}
+}
+
+func TestClient_RetryTransientStatusExhausted(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if serveTestToken(w, r) {
+			return
+		}
+		requestCount++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := NewClient("test", "test", WithBaseURL(server.URL))
+
+	_, err := client.Simple.GetBlocks().Height(96708412).Do(context.Background())
+	if err == nil {
+		t.Fatal("Expected error after exhausting retries")
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadGateway {
+		t.Errorf("Expected APIError with status 502, got %T: %v", err, err)
+	}
+	if requestCount != 3 {
+		t.Errorf("Expected 3 requests, got %d", requestCount)
+	}
+}
+
+func TestClient_RetryOptions(t *testing.T) {
+	requestCount := 0
+	status := http.StatusInternalServerError
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if serveTestToken(w, r) {
+			return
+		}
+		requestCount++
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	// 500 is not retried by default
+	client := NewClient("test", "test", WithBaseURL(server.URL))
+	client.Simple.GetBlocks().Height(96708412).Do(context.Background())
+	if requestCount != 1 {
+		t.Errorf("Expected 1 request, got %d", requestCount)
+	}
+
+	requestCount = 0
+	client = NewClient("test", "test", WithBaseURL(server.URL),
+		WithMaxRetries(4), WithRetryStatusCodes(http.StatusInternalServerError))
+	client.Simple.GetBlocks().Height(96708412).Do(context.Background())
+	if requestCount != 5 {
+		t.Errorf("Expected 5 requests (4 retries), got %d", requestCount)
+	}
+
+	requestCount = 0
+	status = http.StatusBadGateway
+	client = NewClient("test", "test", WithBaseURL(server.URL), WithMaxRetries(0))
+	client.Simple.GetBlocks().Height(96708412).Do(context.Background())
+	if requestCount != 1 {
+		t.Errorf("Expected 1 request with retries disabled, got %d", requestCount)
+	}
+}
+
+func TestClient_RetryConnectionReset(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if serveTestToken(w, r) {
+			return
+		}
+
+		requestCount++
+		if requestCount == 1 {
+			// Drop the connection without writing a response
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				t.Fatalf("hijack failed: %v", err)
+			}
+			conn.Close()
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"blocks":[]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test", "test", WithBaseURL(server.URL))
+
+	if _, err := client.Simple.GetBlocks().Height(96708412).Do(context.Background()); err != nil {
+		t.Fatalf("GetBlocks failed after retry: %v", err)
+	}
+	if requestCount != 2 {
+		t.Errorf("Expected 2 requests (1 retry), got %d", requestCount)
+	}
+}
+
+func TestClient_CircuitBreaker(t *testing.T) {
+	healthy := false
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if serveTestToken(w, r) {
+			return
+		}
+		requestCount++
+		if !healthy {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"blocks":[]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test", "test", WithBaseURL(server.URL), WithCircuitBreaker(2, 50*time.Millisecond))
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		_, err := client.Simple.GetBlocks().Height(1).Do(ctx)
+		if !IsAPIError(err) {
+			t.Fatalf("request %d: expected APIError, got %T: %v", i, err, err)
+		}
+	}
+
+	_, err := client.Simple.GetBlocks().Height(1).Do(ctx)
+	if !IsCircuitOpenError(err) {
+		t.Fatalf("Expected CircuitOpenError, got %T: %v", err, err)
+	}
+	if requestCount != 2 {
+		t.Errorf("Expected open circuit to skip the request, got %d requests", requestCount)
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	healthy = true
+
+	if _, err := client.Simple.GetBlocks().Height(1).Do(ctx); err != nil {
+		t.Fatalf("Expected probe to succeed, got %v", err)
+	}
+	if _, err := client.Simple.GetBlocks().Height(1).Do(ctx); err != nil {
+		t.Fatalf("Expected closed circuit after probe, got %v", err)
+	}
+}
+
+func TestClient_CircuitBreakerPerRoute(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if serveTestToken(w, r) {
+			return
+		}
+		requestCount++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := NewClient("test", "test", WithBaseURL(server.URL), WithCircuitBreaker(2, time.Minute))
+	ctx := context.Background()
+
+	// Failures on different addresses count towards the same route's circuit
+	addresses := []string{"0x1654653399040a61", "0xf233dcee88fe0abe", "0x1d7e57aa55817448"}
+	for i, address := range addresses {
+		_, err := client.Flow.GetAccount().Address(address).Do(ctx)
+		if i < 2 && !IsAPIError(err) {
+			t.Fatalf("request %d: expected APIError, got %T: %v", i, err, err)
+		}
+		if i == 2 {
+			var open *CircuitOpenError
+			if !errors.As(err, &open) {
+				t.Fatalf("Expected CircuitOpenError, got %T: %v", err, err)
+			}
+			if open.Endpoint != "GET /flow/v1/account/{address}" {
+				t.Errorf("Expected route template endpoint, got %s", open.Endpoint)
+			}
+		}
+	}
+	if requestCount != 2 {
+		t.Errorf("Expected 2 requests, got %d", requestCount)
+	}
+	if n := len(client.breaker.endpoints); n != 1 {
+		t.Errorf("Expected 1 tracked endpoint, got %d", n)
+	}
+}
+
+func TestClient_CircuitBreakerProbeFailureReopens(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if serveTestToken(w, r) {
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := NewClient("test", "test", WithBaseURL(server.URL), WithCircuitBreaker(1, 20*time.Millisecond))
+	ctx := context.Background()
+
+	if _, err := client.Simple.GetBlocks().Height(1).Do(ctx); !IsAPIError(err) {
+		t.Fatalf("Expected APIError, got %T: %v", err, err)
+	}
+
+	time.Sleep(30 * time.Millisecond)
+	if _, err := client.Simple.GetBlocks().Height(1).Do(ctx); !IsAPIError(err) {
+		t.Fatalf("Expected probe to reach the server, got %T: %v", err, err)
+	}
+	if _, err := client.Simple.GetBlocks().Height(1).Do(ctx); !IsCircuitOpenError(err) {
+		t.Fatalf("Expected circuit to reopen after failed probe, got %T: %v", err, err)
+	}
+}
+
+func TestClient_RequestDeduplication(t *testing.T) {
+	var upstream atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if serveTestToken(w, r) {
+			return
+		}
+		upstream.Add(1)
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"blocks":[{"height":96708412,"id":"abc123"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("", "",
+		WithBaseURL(server.URL),
+		WithToken("test-token", time.Now().Add(time.Hour).Unix()),
+		WithRequestDeduplication(),
+	)
+
+	const callers = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Simple.GetBlocks().Height(96708412).Do(context.Background())
+			if err == nil && (len(resp.Blocks) != 1 || resp.Blocks[0].ID != "abc123") {
+				err = fmt.Errorf("unexpected response: %+v", resp)
+			}
+			errs <- err
+		}()
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("Caller failed: %v", err)
+		}
+	}
+	if n := upstream.Load(); n != 1 {
+		t.Errorf("Expected 1 upstream request, got %d", n)
+	}
+}
+
+func TestClient_RequestDeduplicationCancel(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if serveTestToken(w, r) {
+			return
+		}
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"blocks":[{"height":96708412,"id":"abc123"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("", "",
+		WithBaseURL(server.URL),
+		WithToken("test-token", time.Now().Add(time.Hour).Unix()),
+		WithRequestDeduplication(),
+	)
+
+	// The first caller starts the shared request, then gives up
+	firstCtx, cancel := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := client.Simple.GetBlocks().Height(96708412).Do(firstCtx)
+		firstErr <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	secondErr := make(chan error, 1)
+	go func() {
+		_, err := client.Simple.GetBlocks().Height(96708412).Do(context.Background())
+		secondErr <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	cancel()
+	if err := <-firstErr; !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled for the cancelled caller, got %v", err)
+	}
+
+	close(release)
+	if err := <-secondErr; err != nil {
+		t.Errorf("Expected the other caller to succeed, got %v", err)
+	}
+}
+
+func TestClient_UserAgentAndDefaultHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("User-Agent"); got != "my-app/1.0" {
+			t.Errorf("Expected User-Agent my-app/1.0, got %q", got)
+		}
+		if got := r.Header.Get("X-Tenant"); got != "acme" {
+			t.Errorf("Expected X-Tenant acme, got %q", got)
+		}
+		if serveTestToken(w, r) {
+			return
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer test-token" {
+			t.Errorf("Default headers must not override Authorization, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"blocks":[]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test", "test",
+		WithBaseURL(server.URL),
+		WithUserAgent("my-app/1.0"),
+		WithDefaultHeader("X-Tenant", "acme"),
+		WithDefaultHeader("Authorization", "ignored"),
+	)
+
+	if _, err := client.Simple.GetBlocks().Height(1).Do(context.Background()); err != nil {
+		t.Fatalf("GetBlocks failed: %v", err)
+	}
+}
+
+func TestClient_AnonymousPublicEndpoint(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		if r.URL.Path == "/auth/v1/generate" {
+			t.Error("Anonymous client must not request a token")
+		}
+		if got := r.Header.Get("Authorization"); got != "" {
+			t.Errorf("Expected no Authorization header, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("", "", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	resp, err := client.DoRequest(ctx, http.MethodGet, "/public/v1/resolver", nil)
+	if err != nil {
+		t.Fatalf("Public request failed: %v", err)
+	}
+	if err := client.DecodeResponse(resp, nil); err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+
+	_, err = client.Flow.GetBlocks().Do(ctx)
+	if !errors.Is(err, ErrMissingCredentials) {
+		t.Errorf("Expected ErrMissingCredentials, got %v", err)
+	}
+	if requestCount != 1 {
+		t.Errorf("Expected only the public request to reach the server, got %d requests", requestCount)
+	}
+}
+
+func TestClient_DoRequestWithBody(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/v1/generate" {
+			fmt.Fprintf(w, `{"access_token":"test-token","exp":%d}`, time.Now().Add(10*time.Minute).Unix())
+			return
+		}
+		if r.Method != http.MethodPost {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if got := r.Header.Get("Content-Type"); got != "application/json" {
+			t.Errorf("Expected Content-Type application/json, got %s", got)
+		}
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+
+		// Rate limit the first attempt so the body has to be resent
+		if len(bodies) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"data":[]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test", "test", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	body := map[string][]string{"addresses": {"0x1654653399040a61", "0xf233dcee88fe0abe"}}
+	resp, err := client.DoRequestWithBody(ctx, http.MethodPost, "/flow/v1/account/batch", nil, body)
+	if err != nil {
+		t.Fatalf("DoRequestWithBody failed: %v", err)
+	}
+	if err := client.DecodeResponse(resp, nil); err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+
+	want := `{"addresses":["0x1654653399040a61","0xf233dcee88fe0abe"]}`
+	if len(bodies) != 2 || bodies[0] != want || bodies[1] != want {
+		t.Errorf("Expected body %s on both attempts, got %q", want, bodies)
+	}
+
+	if _, err := client.DoRequestWithBody(ctx, http.MethodPost, "/flow/v1/account/batch", nil, func() {}); err == nil {
+		t.Error("Expected error for a body that cannot be encoded")
+	}
+}
+
+func TestClient_SetCredentials(t *testing.T) {
+	var tokenUsers []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/v1/generate" {
+			user, _, _ := r.BasicAuth()
+			tokenUsers = append(tokenUsers, user)
+			fmt.Fprintf(w, `{"access_token":"token-%s","exp":%d}`, user, time.Now().Add(10*time.Minute).Unix())
+			return
+		}
+		if got, want := r.Header.Get("Authorization"), "Bearer token-"+tokenUsers[len(tokenUsers)-1]; got != want {
+			t.Errorf("Expected Authorization %q, got %q", want, got)
+		}
+		w.Write([]byte(`{"blocks":[]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("old", "secret", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	if _, err := client.Simple.GetBlocks().Height(1).Do(ctx); err != nil {
+		t.Fatalf("GetBlocks failed: %v", err)
+	}
+	client.SetCredentials("new", "rotated")
+	if _, err := client.Simple.GetBlocks().Height(1).Do(ctx); err != nil {
+		t.Fatalf("GetBlocks failed: %v", err)
+	}
+	if len(tokenUsers) != 2 || tokenUsers[1] != "new" {
+		t.Errorf("Expected a new token for the rotated credentials, got tokens for %v", tokenUsers)
+	}
+}
+
+func TestClient_InBodyError(t *testing.T) {
+	body := `{"data":[],"error":"upstream index unavailable"}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client := NewClient("", "", WithBaseURL(server.URL), WithToken("token", time.Now().Add(time.Hour).Unix()))
+	ctx := context.Background()
+
+	_, err := client.Flow.GetFTTransfers().Do(ctx)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Message != "upstream index unavailable" {
+		t.Fatalf("Expected APIError from the error field, got %T: %v", err, err)
+	}
+
+	body = `{"data":[],"error":{"code":1,"message":"bad token"}}`
+	if _, err := client.Flow.GetFTTransfers().Do(ctx); !errors.As(err, &apiErr) || apiErr.Message != "bad token" {
+		t.Errorf("Expected APIError from the error object, got %v", err)
+	}
+
+	for _, b := range []string{`{"data":[],"error":null}`, `{"data":[],"error":""}`, `{"data":[],"error":{}}`, `[]`} {
+		body = b
+		var v any
+		resp, err := client.DoRequest(ctx, http.MethodGet, "/flow/v1/ft/transfer", nil)
+		if err != nil {
+			t.Fatalf("DoRequest failed: %v", err)
+		}
+		if err := client.DecodeResponse(resp, &v); err != nil {
+			t.Errorf("Expected %s to decode without error, got %v", b, err)
+		}
+	}
+}
+
+func TestClient_StrictDecoding(t *testing.T) {
+	body := `{"blocks":[{"height":1,"id":"abc","new_field":true}]}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	token := WithToken("token", time.Now().Add(time.Hour).Unix())
+	ctx := context.Background()
+
+	lenient := NewClient("", "", WithBaseURL(server.URL), token)
+	if _, err := lenient.Simple.GetBlocks().Height(1).Do(ctx); err != nil {
+		t.Fatalf("Expected unknown fields to be ignored by default, got %v", err)
+	}
+
+	strict := NewClient("", "", WithBaseURL(server.URL), token, WithStrictDecoding())
+	_, err := strict.Simple.GetBlocks().Height(1).Do(ctx)
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("Expected DecodeError, got %T: %v", err, err)
+	}
+	if !decodeErr.Unknown || decodeErr.Field != "new_field" || decodeErr.Endpoint != "GET /simple/v1/blocks" {
+		t.Errorf("Expected unknown field new_field on GET /simple/v1/blocks, got %+v", decodeErr)
+	}
+
+	// Type mismatches are reported in either mode
+	body = `{"blocks":[{"height":"one"}]}`
+	_, err = lenient.Simple.GetBlocks().Height(1).Do(ctx)
+	if !errors.As(err, &decodeErr) || decodeErr.Unknown || !strings.HasSuffix(decodeErr.Field, "height") {
+		t.Errorf("Expected type mismatch on the height field, got %v", err)
+	}
+}
+
+func TestClient_StreamErrors(t *testing.T) {
+	var body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	token := WithToken("token", time.Now().Add(time.Hour).Unix())
+	ctx := context.Background()
+	client := NewClient("", "", WithBaseURL(server.URL), token)
+	strict := NewClient("", "", WithBaseURL(server.URL), token, WithStrictDecoding())
+
+	streamErr := func(c *Client) error {
+		for _, err := range c.Flow.GetNFTTransfers().Stream(ctx) {
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// An error field in a 200 envelope surfaces as an APIError
+	body = `{"data":[],"error":{"code":400,"message":"bad address"}}`
+	var apiErr *APIError
+	if err := streamErr(client); !errors.As(err, &apiErr) || apiErr.Message != "bad address" {
+		t.Errorf("Expected APIError with message bad address, got %T: %v", err, err)
+	}
+
+	// Unknown fields are only rejected with strict decoding
+	body = `{"data":[{"nft_id":1,"new_field":true}]}`
+	if err := streamErr(client); err != nil {
+		t.Errorf("Expected unknown fields to be ignored by default, got %v", err)
+	}
+	if err := streamErr(strict); err == nil || !strings.Contains(err.Error(), "new_field") {
+		t.Errorf("Expected unknown field new_field to be rejected, got %v", err)
+	}
+}
+
+func TestClient_MaxResponseSize(t *testing.T) {
+	body := `{"blocks":[{"height":1,"id":"abc"}]}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	token := WithToken("token", time.Now().Add(time.Hour).Unix())
+	ctx := context.Background()
+
+	exact := NewClient("", "", WithBaseURL(server.URL), token, WithMaxResponseSize(int64(len(body))))
+	if _, err := exact.Simple.GetBlocks().Height(1).Do(ctx); err != nil {
+		t.Fatalf("Expected a body at the limit to decode, got %v", err)
+	}
+
+	limited := NewClient("", "", WithBaseURL(server.URL), token, WithMaxResponseSize(int64(len(body)-1)))
+	_, err := limited.Simple.GetBlocks().Height(1).Do(ctx)
+	var sizeErr *ResponseTooLargeError
+	if !errors.As(err, &sizeErr) || !IsResponseTooLargeError(err) {
+		t.Fatalf("Expected ResponseTooLargeError, got %T: %v", err, err)
+	}
+	if sizeErr.Endpoint != "GET /simple/v1/blocks" || sizeErr.Limit != int64(len(body)-1) || sizeErr.StatusCode != http.StatusOK {
+		t.Errorf("Expected a %d byte limit on GET /simple/v1/blocks, got %+v", len(body)-1, sizeErr)
+	}
+
+	// Deduplicated requests read the shared body under the same limit
+	deduped := NewClient("", "", WithBaseURL(server.URL), token, WithRequestDeduplication(), WithMaxResponseSize(int64(len(body)-1)))
+	if _, err := deduped.Simple.GetBlocks().Height(1).Do(ctx); !IsResponseTooLargeError(err) {
+		t.Errorf("Expected ResponseTooLargeError for a deduplicated request, got %T: %v", err, err)
+	}
+
+	// Streamed responses are cut off once they pass the limit
+	body = `{"data":[{"nft_id":1},{"nft_id":2},{"nft_id":3}]}`
+	streamed := NewClient("", "", WithBaseURL(server.URL), token, WithMaxResponseSize(int64(len(body)-1)))
+	var ids []int64
+	var streamErr error
+	for transfer, err := range streamed.Flow.GetNFTTransfers().Stream(ctx) {
+		if err != nil {
+			streamErr = err
+			break
+		}
+		ids = append(ids, transfer.NFTId)
+	}
+	if !IsResponseTooLargeError(streamErr) {
+		t.Errorf("Expected ResponseTooLargeError from Stream, got %T: %v (after %v)", streamErr, streamErr, ids)
+	}
+}
+
+func TestDoRaw(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"events":[{"name":%q,"block_height":5}],"upstream_only":true}`, r.URL.Query().Get("name"))
+	}))
+	defer server.Close()
+
+	client := NewClient("", "", WithBaseURL(server.URL), WithToken("token", time.Now().Add(time.Hour).Unix()))
+	ctx := context.Background()
+
+	resp, raw, err := DoRaw(ctx, client, client.Simple.GetEvents().Names("A", "B").FromHeight(1).ToHeight(10).Do)
+	if err != nil {
+		t.Fatalf("DoRaw failed: %v", err)
+	}
+	if len(resp.Events) != 2 {
+		t.Errorf("Expected 2 typed events, got %d", len(resp.Events))
+	}
+	if len(raw) != 2 {
+		t.Fatalf("Expected 2 raw bodies, got %d", len(raw))
+	}
+	var body map[string]any
+	if err := json.Unmarshal(raw[0], &body); err != nil {
+		t.Fatalf("Expected valid raw JSON, got %v", err)
+	}
+	if body["upstream_only"] != true {
+		t.Errorf("Expected raw body to keep fields missing from the typed response, got %s", raw[0])
+	}
+
+	// Capture is scoped to the DoRaw call
+	if _, err := client.Simple.GetEvents().Name("A").FromHeight(1).ToHeight(10).Do(ctx); err != nil {
+		t.Fatalf("GetEvents failed: %v", err)
+	}
+	if len(raw) != 2 {
+		t.Errorf("Expected capture to stop after DoRaw, got %d bodies", len(raw))
+	}
+}
@@ -4,6 +4,7 @@ import "github.com/spf13/cobra"
 
 var Cmd = &cobra.Command{
 	Use:              "accounts",
+	Aliases:          []string{"account"},
 	Short:            "Query Flow accounts",
 	TraverseChildren: true,
 }
@@ -7,6 +7,7 @@ import (
 	"text/tabwriter"
 
 	"github.com/peterargue/find-api/cmd/findapi/internal/command"
+	"github.com/peterargue/find-api/display"
 	"github.com/peterargue/find-api/flow"
 	"github.com/spf13/cobra"
 )
@@ -37,8 +38,9 @@ func (r *ftTransfersResult) String() string {
 	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
 	fmt.Fprintln(w, "DIRECTION\tAMOUNT\tTOKEN\tSENDER\tRECEIVER\tHEIGHT")
 	for _, t := range r.transfers {
-		fmt.Fprintf(w, "%s\t%g\t%s\t%s\t%s\t%d\n",
-			t.Direction, t.Amount, t.Token.Token, t.Sender, t.Receiver, t.BlockHeight)
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\n",
+			t.Direction, display.FormatToken(t.Amount, display.FlowDecimals, ""), t.Token.Token,
+			display.ShortenAddress(t.Sender), display.ShortenAddress(t.Receiver), t.BlockHeight)
 	}
 	w.Flush()
 	return buf.String()
@@ -4,9 +4,9 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/peterargue/find-api/cmd/findapi/internal/command"
+	"github.com/peterargue/find-api/display"
 	"github.com/peterargue/find-api/flow"
 	"github.com/spf13/cobra"
 )
@@ -40,7 +40,7 @@ func (r *accountResult) String() string {
 	var buf bytes.Buffer
 	a := r.account
 	fmt.Fprintf(&buf, "Address:           %s\n", a.Address)
-	fmt.Fprintf(&buf, "Flow Balance:      %s\n", strconv.FormatFloat(a.FlowBalance, 'f', -1, 64))
+	fmt.Fprintf(&buf, "Flow Balance:      %s\n", display.FormatFlow(a.FlowBalance))
 	fmt.Fprintf(&buf, "Storage Used:      %s\n", formatBytes(a.StorageUsed))
 	fmt.Fprintf(&buf, "Storage Available: %s\n", formatBytes(a.StorageAvailable))
 	if a.Find != nil && a.Find.Name != "" {
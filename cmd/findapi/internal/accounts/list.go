@@ -7,6 +7,7 @@ import (
 	"text/tabwriter"
 
 	"github.com/peterargue/find-api/cmd/findapi/internal/command"
+	"github.com/peterargue/find-api/display"
 	"github.com/peterargue/find-api/flow"
 	"github.com/spf13/cobra"
 )
@@ -37,7 +38,7 @@ func (r *accountsResult) String() string {
 	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
 	fmt.Fprintln(w, "ADDRESS\tBALANCE\tSTORAGE\tFIND_NAME")
 	for _, a := range r.accounts {
-		fmt.Fprintf(w, "%s\t%g\t%g\t%s\n", a.Address, a.FlowBalance, a.FlowStorage, a.FindName)
+		fmt.Fprintf(w, "%s\t%s\t%g\t%s\n", a.Address, display.FormatFlow(a.FlowBalance), a.FlowStorage, a.FindName)
 	}
 	w.Flush()
 	return buf.String()
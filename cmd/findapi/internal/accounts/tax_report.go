@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"os"
 	"text/tabwriter"
 
 	"github.com/peterargue/find-api/cmd/findapi/internal/command"
+	"github.com/peterargue/find-api/export"
 	"github.com/peterargue/find-api/flow"
 	"github.com/spf13/cobra"
 )
@@ -15,6 +17,7 @@ type taxReportFlags struct {
 	Height uint64 `flag:"height" info:"Block height filter"`
 	Limit  int    `flag:"limit"  info:"Number of results (max 100)"`
 	Offset int    `flag:"offset" info:"Pagination offset"`
+	CSV    string `flag:"csv"    info:"Export the full report (all pages) as CSV to this file"`
 }
 
 var taxReportFlagsVal = &taxReportFlags{}
@@ -23,7 +26,7 @@ var taxReportCmd = &command.Command{
 	Cmd: &cobra.Command{
 		Use:     "tax-report <address>",
 		Short:   "Get tax report for an account",
-		Example: "find accounts tax-report 0x1234567890abcdef",
+		Example: "find accounts tax-report 0x1234567890abcdef\nfind accounts tax-report 0x1234567890abcdef --csv report.csv",
 		Args:    cobra.ExactArgs(1),
 	},
 	Flags: taxReportFlagsVal,
@@ -47,8 +50,23 @@ func (r *taxReportResult) String() string {
 func (r *taxReportResult) Oneliner() string { return fmt.Sprintf("%d entries", len(r.entries)) }
 func (r *taxReportResult) JSON() any        { return r.entries }
 
+type taxReportExportResult struct {
+	File    string `json:"file"`
+	Entries int    `json:"entries"`
+}
+
+func (r *taxReportExportResult) String() string {
+	return fmt.Sprintf("Wrote %d entries to %s\n", r.Entries, r.File)
+}
+
+func (r *taxReportExportResult) Oneliner() string { return fmt.Sprintf("%d entries", r.Entries) }
+func (r *taxReportExportResult) JSON() any        { return r }
+
 func runTaxReport(args []string, flags *command.GlobalFlags) (command.Result, error) {
 	client := command.MustLoadClient()
+	if taxReportFlagsVal.CSV != "" {
+		return exportTaxReport(client.Flow, args[0], taxReportFlagsVal.CSV)
+	}
 	b := client.Flow.GetAccountTaxReport().Address(args[0])
 	if taxReportFlagsVal.Height > 0 {
 		b = b.Height(taxReportFlagsVal.Height)
@@ -65,3 +83,18 @@ func runTaxReport(args []string, flags *command.GlobalFlags) (command.Result, er
 	}
 	return &taxReportResult{entries: resp.Data}, nil
 }
+
+func exportTaxReport(service *flow.Service, address, file string) (command.Result, error) {
+	f, err := os.Create(file)
+	if err != nil {
+		return nil, fmt.Errorf("create %s: %w", file, err)
+	}
+	n, err := export.TaxReportCSV(context.Background(), service, address, f)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &taxReportExportResult{File: file, Entries: n}, nil
+}
@@ -11,6 +11,13 @@ import (
 	findapi "github.com/peterargue/find-api"
 )
 
+const (
+	// UsernameEnv and PasswordEnv hold credentials used instead of the stored token,
+	// e.g. in CI where running "find auth login" is impractical.
+	UsernameEnv = "FINDAPI_USERNAME"
+	PasswordEnv = "FINDAPI_PASSWORD"
+)
+
 type tokenFile struct {
 	AccessToken string `json:"access_token"`
 	Exp         int64  `json:"exp"`
@@ -60,9 +67,21 @@ func ClientOptions() []findapi.ClientOption {
 	return opts
 }
 
-// MustLoadClient loads the stored token and returns a configured API client.
-// If the token is missing or expired it prints a helpful message and exits.
+// EnvCredentials returns the credentials set in the environment, if both are present.
+func EnvCredentials() (username, password string, ok bool) {
+	username = os.Getenv(UsernameEnv)
+	password = os.Getenv(PasswordEnv)
+	return username, password, username != "" && password != ""
+}
+
+// MustLoadClient returns a configured API client, authenticating with credentials
+// from the environment if set and the stored token otherwise.
+// If neither is available it prints a helpful message and exits.
 func MustLoadClient() *findapi.Client {
+	if username, password, ok := EnvCredentials(); ok {
+		return findapi.NewClient(username, password, ClientOptions()...)
+	}
+
 	token, exp, err := LoadToken(TokenPath())
 	if err != nil {
 		fmt.Fprintln(os.Stderr, "Not authenticated. Run: find auth login")
@@ -69,3 +69,20 @@ func TestDefaultTokenPathUsesHomeConfig(t *testing.T) {
 		t.Errorf("got %q, want %q", got, expected)
 	}
 }
+
+func TestEnvCredentials(t *testing.T) {
+	t.Setenv(command.UsernameEnv, "alice")
+	t.Setenv(command.PasswordEnv, "")
+	if _, _, ok := command.EnvCredentials(); ok {
+		t.Error("expected no credentials when password is unset")
+	}
+
+	t.Setenv(command.PasswordEnv, "s3cr3t")
+	user, pass, ok := command.EnvCredentials()
+	if !ok {
+		t.Fatal("expected credentials from environment")
+	}
+	if user != "alice" || pass != "s3cr3t" {
+		t.Errorf("credentials: got %q/%q, want %q/%q", user, pass, "alice", "s3cr3t")
+	}
+}
@@ -55,7 +55,8 @@ func (c *Command) AddToParent(parent *cobra.Command) {
 // handleError formats errors for display, with special handling for 401.
 func handleError(err error) {
 	var apiErr *findapi.APIError
-	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusUnauthorized {
+	if errors.Is(err, findapi.ErrMissingCredentials) ||
+		errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusUnauthorized {
 		fmt.Fprintln(os.Stderr, "Not authenticated. Run: find auth login")
 		os.Exit(1)
 	}
@@ -0,0 +1,76 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/peterargue/find-api/cmd/findapi/internal/command"
+	"github.com/peterargue/find-api/simple"
+	"github.com/spf13/cobra"
+)
+
+type eventsFlags struct {
+	Name   string `flag:"name"   info:"Fully qualified event name, e.g. A.1654653399040a61.FlowToken.TokensDeposited (required)"`
+	From   uint64 `flag:"from"   info:"Starting block height (required)"`
+	To     uint64 `flag:"to"     info:"Ending block height (required)"`
+	Offset int    `flag:"offset" info:"Pagination offset"`
+}
+
+var eventsFlagsVal = &eventsFlags{}
+
+// Cmd is the "events" command.
+var Cmd = &command.Command{
+	Cmd: &cobra.Command{
+		Use:     "events",
+		Short:   "Query events by name over a block height range",
+		Example: "find events --name A.1654653399040a61.FlowToken.TokensDeposited --from 85000000 --to 85000100",
+		Args:    cobra.NoArgs,
+	},
+	Flags: eventsFlagsVal,
+	Run:   runEvents,
+}
+
+type eventsResult struct {
+	events []simple.Event
+}
+
+func (r *eventsResult) String() string {
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "HEIGHT\tINDEX\tNAME\tTX\tTIMESTAMP")
+	for _, e := range r.events {
+		fmt.Fprintf(w, "%d\t%d\t%s\t%s\t%s\n", e.BlockHeight, e.EventIndex, e.Name, e.TransactionHash, e.Timestamp)
+	}
+	w.Flush()
+	return buf.String()
+}
+
+func (r *eventsResult) Oneliner() string {
+	return fmt.Sprintf("%d events", len(r.events))
+}
+
+func (r *eventsResult) JSON() any { return r.events }
+
+func runEvents(args []string, flags *command.GlobalFlags) (command.Result, error) {
+	if eventsFlagsVal.Name == "" {
+		return nil, fmt.Errorf("--name is required")
+	}
+	if eventsFlagsVal.From == 0 || eventsFlagsVal.To == 0 {
+		return nil, fmt.Errorf("--from and --to are required")
+	}
+	client := command.MustLoadClient()
+	b := client.Simple.GetEvents().
+		Name(eventsFlagsVal.Name).
+		FromHeight(eventsFlagsVal.From).
+		ToHeight(eventsFlagsVal.To)
+	if eventsFlagsVal.Offset > 0 {
+		b = b.Offset(eventsFlagsVal.Offset)
+	}
+	resp, err := b.Do(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return &eventsResult{events: resp.Events}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"text/tabwriter"
 
 	"github.com/peterargue/find-api/cmd/findapi/internal/command"
+	"github.com/peterargue/find-api/display"
 	"github.com/peterargue/find-api/flow"
 	"github.com/spf13/cobra"
 )
@@ -37,7 +38,7 @@ func (r *ftHoldingsResult) String() string {
 	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
 	fmt.Fprintln(w, "ADDRESS\tBALANCE\tPERCENTAGE")
 	for _, h := range r.holdings {
-		fmt.Fprintf(w, "%s\t%g\t%g\n", h.Address, h.Balance, h.Percentage)
+		fmt.Fprintf(w, "%s\t%s\t%g\n", h.Address, display.FormatToken(h.Balance.Float64(), display.FlowDecimals, ""), h.Percentage)
 	}
 	w.Flush()
 	return buf.String()
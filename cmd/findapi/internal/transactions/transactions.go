@@ -3,7 +3,7 @@ package transactions
 import "github.com/spf13/cobra"
 
 var Cmd = &cobra.Command{
-	Use: "transactions", Short: "Query Flow transactions", TraverseChildren: true,
+	Use: "transactions", Aliases: []string{"tx"}, Short: "Query Flow transactions", TraverseChildren: true,
 }
 
 func init() {
@@ -11,6 +11,7 @@ import (
 	"github.com/peterargue/find-api/cmd/findapi/internal/blocks"
 	"github.com/peterargue/find-api/cmd/findapi/internal/command"
 	"github.com/peterargue/find-api/cmd/findapi/internal/contracts"
+	"github.com/peterargue/find-api/cmd/findapi/internal/events"
 	"github.com/peterargue/find-api/cmd/findapi/internal/evm"
 	"github.com/peterargue/find-api/cmd/findapi/internal/ft"
 	"github.com/peterargue/find-api/cmd/findapi/internal/nft"
@@ -34,6 +35,7 @@ func main() {
 	cmd.AddCommand(nodes.Cmd)
 	cmd.AddCommand(contracts.Cmd)
 	cmd.AddCommand(evm.Cmd)
+	events.Cmd.AddToParent(cmd)
 
 	command.InitFlags(cmd)
 
@@ -0,0 +1,124 @@
+// Package display formats amounts and addresses for presentation, so UI layers
+// and the CLI render values consistently.
+//
+// The package-level functions use the default Locale. A Locale can be carried
+// in a context with WithLocale so request-scoped code formats for its user:
+//
+//	ctx = display.WithLocale(ctx, display.LocaleDE)
+//	display.LocaleFromContext(ctx).FormatFlow(1234.5) // "1.234,5 FLOW"
+package display
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// FlowDecimals is the number of decimal places of a FLOW (UFix64) amount
+const FlowDecimals = 8
+
+// Locale controls the separators used when formatting numbers
+type Locale struct {
+	// Thousands separates groups of three integer digits; empty disables grouping
+	Thousands string
+	// Decimal separates the integer and fractional parts
+	Decimal string
+}
+
+var (
+	// LocaleEN formats numbers as 1,234.5
+	LocaleEN = Locale{Thousands: ",", Decimal: "."}
+	// LocaleDE formats numbers as 1.234,5
+	LocaleDE = Locale{Thousands: ".", Decimal: ","}
+	// LocaleFR formats numbers as 1 234,5
+	LocaleFR = Locale{Thousands: " ", Decimal: ","}
+	// LocalePlain formats numbers as 1234.5
+	LocalePlain = Locale{Decimal: "."}
+
+	// DefaultLocale is used by the package-level functions and when a context carries no locale
+	DefaultLocale = LocaleEN
+)
+
+type localeKey struct{}
+
+// WithLocale returns a context carrying the locale
+func WithLocale(ctx context.Context, l Locale) context.Context {
+	return context.WithValue(ctx, localeKey{}, l)
+}
+
+// LocaleFromContext returns the context's locale, or DefaultLocale
+func LocaleFromContext(ctx context.Context) Locale {
+	if l, ok := ctx.Value(localeKey{}).(Locale); ok {
+		return l
+	}
+	return DefaultLocale
+}
+
+// FormatFlow formats a FLOW amount, e.g. "1,234.5 FLOW"
+func FormatFlow(amount float64) string {
+	return DefaultLocale.FormatFlow(amount)
+}
+
+// FormatToken formats a token amount rounded to decimals places with trailing
+// zeros removed, followed by symbol if set, e.g. "1,234.5 USDC"
+func FormatToken(amount float64, decimals int, symbol string) string {
+	return DefaultLocale.FormatToken(amount, decimals, symbol)
+}
+
+// FormatFlow formats a FLOW amount using the locale
+func (l Locale) FormatFlow(amount float64) string {
+	return l.FormatToken(amount, FlowDecimals, "FLOW")
+}
+
+// FormatToken formats a token amount using the locale
+func (l Locale) FormatToken(amount float64, decimals int, symbol string) string {
+	s := l.FormatNumber(amount, decimals)
+	if symbol == "" {
+		return s
+	}
+	return s + " " + symbol
+}
+
+// FormatNumber formats a number rounded to decimals places with trailing zeros removed
+func (l Locale) FormatNumber(amount float64, decimals int) string {
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return strconv.FormatFloat(amount, 'f', -1, 64)
+	}
+	decimals = max(decimals, 0)
+
+	s := strconv.FormatFloat(math.Abs(amount), 'f', decimals, 64)
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	fracPart = strings.TrimRight(fracPart, "0")
+
+	var b strings.Builder
+	if amount < 0 && (strings.Trim(intPart, "0") != "" || fracPart != "") {
+		b.WriteByte('-')
+	}
+	for i, c := range intPart {
+		if i > 0 && l.Thousands != "" && (len(intPart)-i)%3 == 0 {
+			b.WriteString(l.Thousands)
+		}
+		b.WriteRune(c)
+	}
+	if fracPart != "" {
+		b.WriteString(l.Decimal)
+		b.WriteString(fracPart)
+	}
+	return b.String()
+}
+
+// ShortenAddress abbreviates a Flow or EVM address to its first and last four
+// hex digits, e.g. "0x1654…0a61". Short addresses are returned unchanged.
+func ShortenAddress(address string) string {
+	const keep = 4
+	prefix := ""
+	hex := address
+	if strings.HasPrefix(address, "0x") {
+		prefix, hex = "0x", address[2:]
+	}
+	if len(hex) <= 2*keep+1 {
+		return address
+	}
+	return prefix + hex[:keep] + "…" + hex[len(hex)-keep:]
+}
@@ -0,0 +1,69 @@
+package display
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFormatToken(t *testing.T) {
+	tests := []struct {
+		amount   float64
+		decimals int
+		symbol   string
+		want     string
+	}{
+		{1234.5, 8, "FLOW", "1,234.5 FLOW"},
+		{0.00000001, 8, "FLOW", "0.00000001 FLOW"},
+		{1234567.891, 2, "USDC", "1,234,567.89 USDC"},
+		{1000, 6, "", "1,000"},
+		{-42.1, 2, "", "-42.1"},
+		{-0.001, 2, "", "0"},
+		{999.999, 2, "", "1,000"},
+		{0, 8, "", "0"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatToken(tt.amount, tt.decimals, tt.symbol); got != tt.want {
+			t.Errorf("FormatToken(%v, %d, %q): expected %q, got %q", tt.amount, tt.decimals, tt.symbol, tt.want, got)
+		}
+	}
+}
+
+func TestFormatFlow(t *testing.T) {
+	if got := FormatFlow(12.3456789012); got != "12.3456789 FLOW" {
+		t.Errorf("Expected 12.3456789 FLOW, got %q", got)
+	}
+}
+
+func TestLocale(t *testing.T) {
+	if got := LocaleDE.FormatFlow(1234.5); got != "1.234,5 FLOW" {
+		t.Errorf("Expected 1.234,5 FLOW, got %q", got)
+	}
+	if got := LocalePlain.FormatNumber(1234567.5, 2); got != "1234567.5" {
+		t.Errorf("Expected 1234567.5, got %q", got)
+	}
+
+	ctx := WithLocale(context.Background(), LocaleDE)
+	if got := LocaleFromContext(ctx); got != LocaleDE {
+		t.Errorf("Expected LocaleDE from context, got %+v", got)
+	}
+	if got := LocaleFromContext(context.Background()); got != DefaultLocale {
+		t.Errorf("Expected DefaultLocale, got %+v", got)
+	}
+}
+
+func TestShortenAddress(t *testing.T) {
+	tests := map[string]string{
+		"0x1654653399040a61": "0x1654…0a61",
+		"0xf8d6e0586b0a20c7": "0xf8d6…20c7",
+		"0x1":                "0x1",
+		"0x1234abcd":         "0x1234abcd",
+		"0x8ac7a4b0a5a0e6b0c3d2f1e9876543210abcdef1": "0x8ac7…def1",
+		"1654653399040a61":                           "1654…0a61",
+	}
+	for in, want := range tests {
+		if got := ShortenAddress(in); got != want {
+			t.Errorf("ShortenAddress(%q): expected %q, got %q", in, want, got)
+		}
+	}
+}
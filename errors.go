@@ -1,10 +1,18 @@
 package findapi
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 	"time"
 )
 
+// ErrMissingCredentials is returned when an endpoint requires authentication
+// but the client was created without credentials or a token
+var ErrMissingCredentials = errors.New("endpoint requires authentication but no credentials or token were provided")
+
 // APIError represents an error returned by the FindLabs API
 type APIError struct {
 	StatusCode int
@@ -15,13 +23,40 @@ func (e *APIError) Error() string {
 	return fmt.Sprintf("API error (status %d): %s", e.StatusCode, e.Message)
 }
 
+// bodyError returns the message of a non-empty top-level error field in a
+// response body, which may be a string or an object with a message
+func bodyError(body []byte) string {
+	var envelope struct {
+		Error json.RawMessage `json:"error"`
+	}
+	if json.Unmarshal(body, &envelope) != nil || len(envelope.Error) == 0 {
+		return ""
+	}
+
+	var msg string
+	if json.Unmarshal(envelope.Error, &msg) == nil {
+		return msg
+	}
+
+	var obj map[string]any
+	if json.Unmarshal(envelope.Error, &obj) != nil || len(obj) == 0 {
+		return ""
+	}
+	if msg, ok := obj["message"].(string); ok && msg != "" {
+		return msg
+	}
+	return string(envelope.Error)
+}
+
 // RateLimitError represents a rate limiting error (HTTP 429)
 type RateLimitError struct {
 	RetryAfter time.Duration
+	// Attempts is the number of requests made before giving up
+	Attempts int
 }
 
 func (e *RateLimitError) Error() string {
-	return fmt.Sprintf("rate limit exceeded, retry after %v", e.RetryAfter)
+	return fmt.Sprintf("rate limit exceeded after %d attempts, retry after %v", e.Attempts, e.RetryAfter)
 }
 
 // IsRateLimitError checks if an error is a rate limit error
@@ -35,3 +70,99 @@ func IsAPIError(err error) bool {
 	_, ok := err.(*APIError)
 	return ok
 }
+
+// DecodeError is returned when a response body does not match the SDK's
+// types, e.g. a field changed type upstream or, with strict decoding, a field
+// the SDK does not know about appeared
+type DecodeError struct {
+	// Endpoint is the method and path of the request
+	Endpoint string
+	// Target is the Go type the body was decoded into
+	Target string
+	// Field is the JSON field that failed to decode, if known
+	Field string
+	// Unknown is set when Field is not part of the target type
+	Unknown bool
+	Err     error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("failed to decode response: %v", e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// IsDecodeError checks if an error is a decode error
+func IsDecodeError(err error) bool {
+	var decodeErr *DecodeError
+	return errors.As(err, &decodeErr)
+}
+
+// newDecodeError describes a failure to decode a response into target
+func newDecodeError(req *http.Request, target any, err error) *DecodeError {
+	e := &DecodeError{Target: fmt.Sprintf("%T", target), Err: err}
+	if req != nil {
+		e.Endpoint = req.Method + " " + req.URL.Path
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		e.Field = typeErr.Field
+	} else if field, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+		e.Field = strings.Trim(field, `"`)
+		e.Unknown = true
+	}
+	return e
+}
+
+// ResponseTooLargeError is returned when a response body exceeds the limit set
+// with WithMaxResponseSize. The body is not decoded.
+type ResponseTooLargeError struct {
+	// Endpoint is the method and path of the request
+	Endpoint string
+	// StatusCode is the HTTP status of the response
+	StatusCode int
+	// Limit is the maximum body size in bytes
+	Limit int64
+}
+
+func (e *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("response from %s exceeds %d bytes", e.Endpoint, e.Limit)
+}
+
+// IsResponseTooLargeError checks if an error is a response size error
+func IsResponseTooLargeError(err error) bool {
+	var sizeErr *ResponseTooLargeError
+	return errors.As(err, &sizeErr)
+}
+
+// newResponseTooLargeError describes a response whose body exceeds limit
+func newResponseTooLargeError(resp *http.Response, limit int64) *ResponseTooLargeError {
+	e := &ResponseTooLargeError{StatusCode: resp.StatusCode, Limit: limit}
+	if resp.Request != nil {
+		e.Endpoint = resp.Request.Method + " " + resp.Request.URL.Path
+	}
+	return e
+}
+
+// CircuitOpenError is returned when a request is rejected because the
+// endpoint's circuit breaker is open
+type CircuitOpenError struct {
+	Endpoint   string
+	RetryAfter time.Duration
+}
+
+func (e *CircuitOpenError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("circuit open for %s, retry after %v", e.Endpoint, e.RetryAfter)
+	}
+	return fmt.Sprintf("circuit open for %s, probe in progress", e.Endpoint)
+}
+
+// IsCircuitOpenError checks if an error is a circuit breaker error
+func IsCircuitOpenError(err error) bool {
+	_, ok := err.(*CircuitOpenError)
+	return ok
+}
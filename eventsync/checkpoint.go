@@ -0,0 +1,123 @@
+package eventsync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Checkpoint records sync progress: every event below Height has been
+// handled, as have the first Handled events at Height
+type Checkpoint struct {
+	Height  uint64 `json:"height"`
+	Handled int    `json:"handled"`
+}
+
+// Checkpointer persists checkpoints, keyed by event name
+type Checkpointer interface {
+	// Load returns the checkpoint for an event name, and false if none was saved
+	Load(ctx context.Context, name string) (Checkpoint, bool, error)
+	// Save stores the checkpoint for an event name
+	Save(ctx context.Context, name string, cp Checkpoint) error
+}
+
+// MemoryCheckpointer keeps checkpoints in memory. It is safe for concurrent use.
+type MemoryCheckpointer struct {
+	mu          sync.Mutex
+	checkpoints map[string]Checkpoint
+}
+
+// NewMemoryCheckpointer creates an empty in-memory checkpointer
+func NewMemoryCheckpointer() *MemoryCheckpointer {
+	return &MemoryCheckpointer{checkpoints: map[string]Checkpoint{}}
+}
+
+// Load implements Checkpointer
+func (m *MemoryCheckpointer) Load(_ context.Context, name string) (Checkpoint, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	cp, ok := m.checkpoints[name]
+	return cp, ok, nil
+}
+
+// Save implements Checkpointer
+func (m *MemoryCheckpointer) Save(_ context.Context, name string, cp Checkpoint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.checkpoints[name] = cp
+	return nil
+}
+
+// FileCheckpointer stores checkpoints as a JSON object in a file, replacing it
+// atomically on each save. It is safe for concurrent use within a process.
+type FileCheckpointer struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileCheckpointer creates a checkpointer backed by the file at path. The
+// file is created on the first save.
+func NewFileCheckpointer(path string) *FileCheckpointer {
+	return &FileCheckpointer{path: path}
+}
+
+// Load implements Checkpointer
+func (f *FileCheckpointer) Load(_ context.Context, name string) (Checkpoint, bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	all, err := f.read()
+	if err != nil {
+		return Checkpoint{}, false, err
+	}
+	cp, ok := all[name]
+	return cp, ok, nil
+}
+
+// Save implements Checkpointer
+func (f *FileCheckpointer) Save(_ context.Context, name string, cp Checkpoint) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	all, err := f.read()
+	if err != nil {
+		return err
+	}
+	all[name] = cp
+
+	data, err := json.MarshalIndent(all, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(f.path), filepath.Base(f.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), f.path)
+}
+
+// read loads all checkpoints from the file
+func (f *FileCheckpointer) read() (map[string]Checkpoint, error) {
+	all := map[string]Checkpoint{}
+	data, err := os.ReadFile(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return all, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, fmt.Errorf("decode checkpoints %s: %w", f.path, err)
+	}
+	return all, nil
+}
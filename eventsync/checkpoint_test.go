@@ -0,0 +1,36 @@
+package eventsync
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileCheckpointer(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "checkpoints.json")
+
+	f := NewFileCheckpointer(path)
+	if _, ok, err := f.Load(ctx, "A.1.Token.Deposited"); err != nil || ok {
+		t.Fatalf("Expected no checkpoint, got ok=%v err=%v", ok, err)
+	}
+
+	if err := f.Save(ctx, "A.1.Token.Deposited", Checkpoint{Height: 100, Handled: 3}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if err := f.Save(ctx, "A.1.Token.Withdrawn", Checkpoint{Height: 50}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	reopened := NewFileCheckpointer(path)
+	cp, ok, err := reopened.Load(ctx, "A.1.Token.Deposited")
+	if err != nil || !ok {
+		t.Fatalf("Expected checkpoint, got ok=%v err=%v", ok, err)
+	}
+	if cp.Height != 100 || cp.Handled != 3 {
+		t.Errorf("Unexpected checkpoint %+v", cp)
+	}
+	if cp, _, _ := reopened.Load(ctx, "A.1.Token.Withdrawn"); cp.Height != 50 {
+		t.Errorf("Expected second checkpoint to be kept, got %+v", cp)
+	}
+}
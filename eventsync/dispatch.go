@@ -0,0 +1,110 @@
+package eventsync
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/peterargue/find-api/simple"
+)
+
+// TypedEvent is an event with its fields decoded into T
+type TypedEvent[T any] struct {
+	simple.Event
+	// Data holds the event fields decoded into T
+	Data T
+}
+
+// Dispatcher routes events to handlers registered by event name, decoding
+// each event's fields into the handler's type. Use Handle as the Handler of a
+// Run, or Sync to backfill every registered event type.
+type Dispatcher struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+}
+
+// NewDispatcher creates an empty dispatcher
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{handlers: map[string][]Handler{}}
+}
+
+// OnEvent registers fn for events named name. The event fields are decoded
+// into T through their JSON representation, so T's fields use json tags
+// matching the Cadence field names. Several handlers may be registered for a
+// name; they run in registration order.
+func OnEvent[T any](d *Dispatcher, name string, fn func(ctx context.Context, event TypedEvent[T]) error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.handlers[name] = append(d.handlers[name], func(ctx context.Context, e simple.Event) error {
+		typed := TypedEvent[T]{Event: e}
+		if err := e.DecodeFields(&typed.Data); err != nil {
+			return fmt.Errorf("decode %s fields: %w", e.Name, err)
+		}
+		return fn(ctx, typed)
+	})
+}
+
+// Names returns the registered event names, sorted
+func (d *Dispatcher) Names() []string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	names := make([]string, 0, len(d.handlers))
+	for name := range d.handlers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Handle routes an event to the handlers registered for its name. Events
+// without a handler are ignored. It satisfies Handler.
+func (d *Dispatcher) Handle(ctx context.Context, e simple.Event) error {
+	d.mu.RLock()
+	handlers := d.handlers[e.Name]
+	d.mu.RUnlock()
+	for _, h := range handlers {
+		if err := h(ctx, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Sync runs a sync for every registered event name concurrently, using cfg
+// for each with EventName set to the name. Each name keeps its own
+// checkpoint. The first error cancels the other syncs and is returned.
+func (d *Dispatcher) Sync(ctx context.Context, src Source, cfg Config) error {
+	names := d.Names()
+	if len(names) == 0 {
+		return fmt.Errorf("no event handlers registered")
+	}
+	if cfg.Checkpointer == nil {
+		cfg.Checkpointer = NewMemoryCheckpointer()
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	for _, name := range names {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := cfg
+			c.EventName = name
+			if err := Run(ctx, src, c, d.Handle); err != nil {
+				errOnce.Do(func() {
+					firstErr = fmt.Errorf("sync %s: %w", name, err)
+					cancel()
+				})
+			}
+		}()
+	}
+	wg.Wait()
+	return firstErr
+}
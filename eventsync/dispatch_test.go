@@ -0,0 +1,107 @@
+package eventsync
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/peterargue/find-api/simple"
+)
+
+type deposited struct {
+	Amount string `json:"amount"`
+	To     string `json:"to"`
+}
+
+type withdrawn struct {
+	Amount string `json:"amount"`
+	From   string `json:"from"`
+}
+
+func TestDispatcher_Handle(t *testing.T) {
+	d := NewDispatcher()
+
+	var deposits []TypedEvent[deposited]
+	var withdrawals []withdrawn
+	OnEvent(d, "A.1.Token.Deposited", func(ctx context.Context, e TypedEvent[deposited]) error {
+		deposits = append(deposits, e)
+		return nil
+	})
+	OnEvent(d, "A.1.Token.Withdrawn", func(ctx context.Context, e TypedEvent[withdrawn]) error {
+		withdrawals = append(withdrawals, e.Data)
+		return nil
+	})
+
+	ctx := context.Background()
+	events := []simple.Event{
+		{Name: "A.1.Token.Deposited", BlockHeight: 10, Fields: map[string]interface{}{"amount": "1.5", "to": "0x01"}},
+		{Name: "A.1.Token.Withdrawn", BlockHeight: 11, Fields: map[string]interface{}{"amount": "2.0", "from": "0x02"}},
+		{Name: "A.1.Token.Unregistered", BlockHeight: 12},
+	}
+	for _, e := range events {
+		if err := d.Handle(ctx, e); err != nil {
+			t.Fatalf("Handle failed: %v", err)
+		}
+	}
+
+	if len(deposits) != 1 || deposits[0].Data.To != "0x01" || deposits[0].BlockHeight != 10 {
+		t.Errorf("Unexpected deposits %+v", deposits)
+	}
+	if len(withdrawals) != 1 || withdrawals[0].From != "0x02" {
+		t.Errorf("Unexpected withdrawals %+v", withdrawals)
+	}
+
+	if names := d.Names(); len(names) != 2 || names[0] != "A.1.Token.Deposited" {
+		t.Errorf("Unexpected names %v", names)
+	}
+}
+
+func TestDispatcher_HandleDecodeError(t *testing.T) {
+	d := NewDispatcher()
+	OnEvent(d, "A.1.Token.Deposited", func(ctx context.Context, e TypedEvent[deposited]) error {
+		return nil
+	})
+
+	err := d.Handle(context.Background(), simple.Event{Name: "A.1.Token.Deposited", Fields: map[string]interface{}{"amount": 1.5}})
+	if err == nil {
+		t.Error("Expected error decoding a number into a string field")
+	}
+}
+
+func TestDispatcher_Sync(t *testing.T) {
+	src := &fakeSource{head: 300, events: testEvents()}
+	cps := NewMemoryCheckpointer()
+
+	d := NewDispatcher()
+	var deposits, withdrawals atomic.Int64
+	OnEvent(d, "A.1.Token.Deposited", func(ctx context.Context, e TypedEvent[map[string]any]) error {
+		deposits.Add(1)
+		return nil
+	})
+	OnEvent(d, "A.1.Token.Withdrawn", func(ctx context.Context, e TypedEvent[map[string]any]) error {
+		withdrawals.Add(1)
+		return nil
+	})
+
+	if err := d.Sync(context.Background(), src, Config{StartHeight: 100, Checkpointer: cps, Retry: fastRetry()}); err != nil {
+		t.Fatalf("Sync failed: %v", err)
+	}
+	if deposits.Load() != 254 || withdrawals.Load() != 1 {
+		t.Errorf("Expected 254 deposits and 1 withdrawal, got %d and %d", deposits.Load(), withdrawals.Load())
+	}
+
+	if err := NewDispatcher().Sync(context.Background(), src, Config{StartHeight: 100}); err == nil {
+		t.Error("Expected error without handlers")
+	}
+
+	fail := errors.New("boom")
+	failing := NewDispatcher()
+	OnEvent(failing, "A.1.Token.Withdrawn", func(ctx context.Context, e TypedEvent[withdrawn]) error {
+		return fail
+	})
+	err := failing.Sync(context.Background(), &fakeSource{head: 300, events: testEvents()}, Config{StartHeight: 100, Retry: fastRetry()})
+	if !errors.Is(err, fail) {
+		t.Errorf("Expected handler error from Sync, got %v", err)
+	}
+}
@@ -0,0 +1,228 @@
+// Package eventsync backfills and follows an event type from the simple API,
+// persisting progress through a Checkpointer so a restarted sync resumes where
+// it stopped and hands each event to the handler once.
+package eventsync
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	findapi "github.com/peterargue/find-api"
+	"github.com/peterargue/find-api/simple"
+)
+
+const (
+	// DefaultChunkSize is the number of blocks scanned per range
+	DefaultChunkSize = 250
+
+	// eventsPageSize is the number of events the events endpoint returns per page
+	eventsPageSize = 100
+)
+
+// Source fetches events and the chain head. *simple.Service satisfies it
+// through SimpleSource.
+type Source interface {
+	LatestHeight(ctx context.Context) (uint64, error)
+	Events(ctx context.Context, name string, fromHeight, toHeight uint64, offset int) ([]simple.Event, error)
+}
+
+// Handler processes an event. Returning an error stops the sync without
+// recording the event as handled, so it is delivered again on the next run.
+type Handler func(ctx context.Context, event simple.Event) error
+
+// Config configures a sync
+type Config struct {
+	// EventName is the fully qualified event type, e.g.
+	// A.1654653399040a61.FlowToken.TokensDeposited (required)
+	EventName string
+	// StartHeight is the first height scanned when no checkpoint exists (required)
+	StartHeight uint64
+	// ChunkSize is the number of blocks scanned per range (default DefaultChunkSize)
+	ChunkSize uint64
+	// Checkpointer persists progress (default an in-memory checkpointer)
+	Checkpointer Checkpointer
+	// Retry is the backoff policy for failed requests. A zero MaxAttempts
+	// defaults to 5 attempts per request.
+	Retry findapi.Backoff
+	// PollInterval is how long to wait for new blocks once the sync reaches
+	// the chain head. Zero stops the sync at the head.
+	PollInterval time.Duration
+}
+
+// simpleSource adapts the simple service to Source
+type simpleSource struct {
+	service *simple.Service
+}
+
+// SimpleSource returns a Source reading from the simple API
+func SimpleSource(service *simple.Service) Source {
+	return simpleSource{service: service}
+}
+
+func (s simpleSource) LatestHeight(ctx context.Context) (uint64, error) {
+	return s.service.GetLatestHeight(ctx)
+}
+
+func (s simpleSource) Events(ctx context.Context, name string, fromHeight, toHeight uint64, offset int) ([]simple.Event, error) {
+	req := s.service.GetEvents().Name(name).FromHeight(fromHeight).ToHeight(toHeight)
+	if offset > 0 {
+		req.Offset(offset)
+	}
+	resp, err := req.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Events, nil
+}
+
+// Run scans cfg.EventName from the checkpoint (or cfg.StartHeight) to the
+// chain head in chunks, calling handler for each event in chain order and
+// saving the checkpoint after each one. Failed requests are retried with
+// cfg.Retry. Run returns nil once it reaches the head if cfg.PollInterval is
+// zero, and otherwise keeps following the head until ctx is done.
+//
+// Events are handed over once as long as the handler's effects and the
+// checkpoint save succeed together; a crash between the two redelivers that
+// single event.
+func Run(ctx context.Context, src Source, cfg Config, handler Handler) error {
+	if cfg.EventName == "" {
+		return fmt.Errorf("event name is required")
+	}
+	if cfg.StartHeight == 0 {
+		return fmt.Errorf("start height is required")
+	}
+	if cfg.ChunkSize == 0 {
+		cfg.ChunkSize = DefaultChunkSize
+	}
+	if cfg.Checkpointer == nil {
+		cfg.Checkpointer = NewMemoryCheckpointer()
+	}
+	if cfg.Retry.MaxAttempts == 0 {
+		cfg.Retry.MaxAttempts = 5
+	}
+
+	cp, ok, err := cfg.Checkpointer.Load(ctx, cfg.EventName)
+	if err != nil {
+		return fmt.Errorf("load checkpoint: %w", err)
+	}
+	if !ok {
+		cp = Checkpoint{Height: cfg.StartHeight}
+	}
+
+	for {
+		head, err := retry(ctx, cfg.Retry, func() (uint64, error) {
+			return src.LatestHeight(ctx)
+		})
+		if err != nil {
+			return fmt.Errorf("fetch latest height: %w", err)
+		}
+
+		if cp.Height > head {
+			if cfg.PollInterval <= 0 {
+				return nil
+			}
+			if err := sleep(ctx, cfg.PollInterval); err != nil {
+				return err
+			}
+			continue
+		}
+
+		to := min(cp.Height+cfg.ChunkSize-1, head)
+		events, err := retry(ctx, cfg.Retry, func() ([]simple.Event, error) {
+			return fetchRange(ctx, src, cfg.EventName, cp.Height, to)
+		})
+		if err != nil {
+			return fmt.Errorf("fetch events %d-%d: %w", cp.Height, to, err)
+		}
+
+		if cp, err = deliver(ctx, cfg, cp, events, handler); err != nil {
+			return err
+		}
+
+		cp = Checkpoint{Height: to + 1}
+		if err := cfg.Checkpointer.Save(ctx, cfg.EventName, cp); err != nil {
+			return fmt.Errorf("save checkpoint: %w", err)
+		}
+	}
+}
+
+// deliver hands the events of a range to the handler, skipping those the
+// checkpoint records as handled, and saves the checkpoint after each one
+func deliver(ctx context.Context, cfg Config, cp Checkpoint, events []simple.Event, handler Handler) (Checkpoint, error) {
+	start, skip := cp.Height, cp.Handled
+	for _, e := range events {
+		if e.BlockHeight < start {
+			continue
+		}
+		if e.BlockHeight == start && skip > 0 {
+			skip--
+			continue
+		}
+
+		if err := handler(ctx, e); err != nil {
+			return cp, fmt.Errorf("handle event at height %d: %w", e.BlockHeight, err)
+		}
+
+		if e.BlockHeight != cp.Height {
+			cp = Checkpoint{Height: e.BlockHeight}
+		}
+		cp.Handled++
+		if err := cfg.Checkpointer.Save(ctx, cfg.EventName, cp); err != nil {
+			return cp, fmt.Errorf("save checkpoint: %w", err)
+		}
+	}
+	return cp, nil
+}
+
+// fetchRange fetches every page of events in a height range, in chain order
+func fetchRange(ctx context.Context, src Source, name string, from, to uint64) ([]simple.Event, error) {
+	var events []simple.Event
+	for offset := 0; ; offset += eventsPageSize {
+		page, err := src.Events(ctx, name, from, to, offset)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, page...)
+		if len(page) < eventsPageSize {
+			break
+		}
+	}
+	slices.SortStableFunc(events, func(a, b simple.Event) int { return cmp.Compare(a.BlockHeight, b.BlockHeight) })
+	return events, nil
+}
+
+// retry calls fn until it succeeds, the backoff's attempts are exhausted or
+// ctx is done, returning the last error
+func retry[T any](ctx context.Context, b findapi.Backoff, fn func() (T, error)) (T, error) {
+	var (
+		zero    T
+		lastErr error
+	)
+	for range b.Attempts(ctx) {
+		v, err := fn()
+		if err == nil {
+			return v, nil
+		}
+		lastErr = err
+	}
+	if err := ctx.Err(); err != nil {
+		return zero, errors.Join(err, lastErr)
+	}
+	return zero, lastErr
+}
+
+// sleep waits for d or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
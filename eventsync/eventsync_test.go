@@ -0,0 +1,157 @@
+package eventsync
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	findapi "github.com/peterargue/find-api"
+	"github.com/peterargue/find-api/simple"
+)
+
+// fakeSource serves events from memory, failing the first failures calls
+type fakeSource struct {
+	mu       sync.Mutex
+	head     uint64
+	events   []simple.Event
+	failures int
+	calls    int
+}
+
+func (f *fakeSource) LatestHeight(ctx context.Context) (uint64, error) {
+	return f.head, nil
+}
+
+func (f *fakeSource) Events(ctx context.Context, name string, from, to uint64, offset int) ([]simple.Event, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	if f.failures > 0 {
+		f.failures--
+		return nil, errors.New("temporary failure")
+	}
+	var matched []simple.Event
+	for _, e := range f.events {
+		if e.Name == name && e.BlockHeight >= from && e.BlockHeight <= to {
+			matched = append(matched, e)
+		}
+	}
+	if offset >= len(matched) {
+		return nil, nil
+	}
+	return matched[offset:min(offset+eventsPageSize, len(matched))], nil
+}
+
+const testEvent = "A.1.Token.Deposited"
+
+func testEvents() []simple.Event {
+	var events []simple.Event
+	// 250 events at height 105 (spanning pages), plus events spread across chunks
+	for i := range 250 {
+		events = append(events, simple.Event{Name: testEvent, BlockHeight: 105, EventIndex: i})
+	}
+	for _, h := range []uint64{101, 150, 151, 299} {
+		events = append(events, simple.Event{Name: testEvent, BlockHeight: h})
+	}
+	events = append(events, simple.Event{Name: "A.1.Token.Withdrawn", BlockHeight: 120})
+	return events
+}
+
+func fastRetry() findapi.Backoff {
+	return findapi.Backoff{Base: time.Millisecond, Max: time.Millisecond, MaxAttempts: 3}
+}
+
+func TestRun(t *testing.T) {
+	src := &fakeSource{head: 300, events: testEvents(), failures: 2}
+	cps := NewMemoryCheckpointer()
+
+	var handled []simple.Event
+	err := Run(context.Background(), src, Config{
+		EventName:    testEvent,
+		StartHeight:  100,
+		ChunkSize:    50,
+		Checkpointer: cps,
+		Retry:        fastRetry(),
+	}, func(ctx context.Context, e simple.Event) error {
+		handled = append(handled, e)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+
+	if len(handled) != 254 {
+		t.Fatalf("Expected 254 events, got %d", len(handled))
+	}
+	if handled[0].BlockHeight != 101 || handled[len(handled)-1].BlockHeight != 299 {
+		t.Errorf("Unexpected event order: first %d, last %d", handled[0].BlockHeight, handled[len(handled)-1].BlockHeight)
+	}
+
+	cp, ok, _ := cps.Load(context.Background(), testEvent)
+	if !ok || cp.Height != 301 || cp.Handled != 0 {
+		t.Errorf("Expected checkpoint at 301, got %+v", cp)
+	}
+}
+
+func TestRunResumesAfterHandlerError(t *testing.T) {
+	src := &fakeSource{head: 300, events: testEvents()}
+	cps := NewMemoryCheckpointer()
+	cfg := Config{EventName: testEvent, StartHeight: 100, ChunkSize: 50, Checkpointer: cps, Retry: fastRetry()}
+
+	seen := map[[2]uint64]int{}
+	count := 0
+	fail := errors.New("handler failed")
+	handler := func(ctx context.Context, e simple.Event) error {
+		if count == 120 {
+			count++
+			return fail
+		}
+		count++
+		seen[[2]uint64{e.BlockHeight, uint64(e.EventIndex)}]++
+		return nil
+	}
+
+	if err := Run(context.Background(), src, cfg, handler); !errors.Is(err, fail) {
+		t.Fatalf("Expected handler error, got %v", err)
+	}
+	cp, _, _ := cps.Load(context.Background(), testEvent)
+	if cp.Height != 105 || cp.Handled != 119 {
+		t.Errorf("Expected checkpoint mid-block at 105/119, got %+v", cp)
+	}
+
+	if err := Run(context.Background(), src, cfg, handler); err != nil {
+		t.Fatalf("Resumed Run failed: %v", err)
+	}
+	if len(seen) != 254 {
+		t.Errorf("Expected 254 distinct events, got %d", len(seen))
+	}
+	for key, n := range seen {
+		if n != 1 {
+			t.Errorf("Event %v handled %d times", key, n)
+		}
+	}
+}
+
+func TestRunRetryExhausted(t *testing.T) {
+	src := &fakeSource{head: 300, events: testEvents(), failures: 10}
+	err := Run(context.Background(), src, Config{EventName: testEvent, StartHeight: 100, Retry: fastRetry()},
+		func(ctx context.Context, e simple.Event) error { return nil })
+	if err == nil {
+		t.Fatal("Expected error after retries are exhausted")
+	}
+	if src.calls != 3 {
+		t.Errorf("Expected 3 attempts, got %d", src.calls)
+	}
+}
+
+func TestRunRequiredFields(t *testing.T) {
+	handler := func(ctx context.Context, e simple.Event) error { return nil }
+	if err := Run(context.Background(), &fakeSource{}, Config{StartHeight: 1}, handler); err == nil {
+		t.Error("Expected error without event name")
+	}
+	if err := Run(context.Background(), &fakeSource{}, Config{EventName: testEvent}, handler); err == nil {
+		t.Error("Expected error without start height")
+	}
+}
@@ -0,0 +1,184 @@
+package export
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"time"
+
+	findapi "github.com/peterargue/find-api"
+)
+
+const (
+	// DefaultPageSize is the page size used when dumping an endpoint (the API maximum)
+	DefaultPageSize = 100
+
+	// ManifestFile is the name of the manifest written alongside the page files
+	ManifestFile = "manifest.json"
+)
+
+// Pager is implemented by request builders that support offset pagination,
+// such as the builders returned by the flow service.
+type Pager[B any, R any] interface {
+	Limit(limit int) B
+	Offset(offset int) B
+	Do(ctx context.Context) (R, error)
+}
+
+// PageFile describes a single page written by DumpEndpoint
+type PageFile struct {
+	File    string `json:"file"`
+	URL     string `json:"url"`
+	Offset  int    `json:"offset"`
+	Records int    `json:"records"`
+	Bytes   int    `json:"bytes"`
+	SHA256  string `json:"sha256"`
+}
+
+// Manifest describes a complete endpoint dump. Checksums are computed over
+// the uncompressed JSON so they stay stable regardless of gzip settings.
+type Manifest struct {
+	Query      string     `json:"query"`
+	PageSize   int        `json:"page_size"`
+	PageCount  int        `json:"page_count"`
+	Records    int        `json:"records"`
+	StartedAt  time.Time  `json:"started_at"`
+	FinishedAt time.Time  `json:"finished_at"`
+	Pages      []PageFile `json:"pages"`
+}
+
+// DumpEndpoint pages through the builder's endpoint until it is exhausted and
+// writes each page's raw upstream JSON to dir as a gzip-compressed file,
+// followed by a manifest describing the dump. The builder's Limit and Offset
+// are overwritten for each page.
+func DumpEndpoint[B Pager[B, R], R any](ctx context.Context, client *findapi.Client, builder B, dir string) (*Manifest, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create dump dir: %w", err)
+	}
+
+	manifest := &Manifest{
+		PageSize:  DefaultPageSize,
+		StartedAt: time.Now().UTC(),
+	}
+
+	for offset := 0; ; offset += DefaultPageSize {
+		var raw []byte
+		var rawURL string
+		pageCtx := client.WithRawCapture(ctx, func(req *http.Request, body []byte) {
+			raw = body
+			rawURL = req.URL.String()
+		})
+
+		resp, err := builder.Limit(DefaultPageSize).Offset(offset).Do(pageCtx)
+		if err != nil {
+			return nil, fmt.Errorf("fetch page at offset %d: %w", offset, err)
+		}
+		if raw == nil {
+			return nil, fmt.Errorf("no raw response captured at offset %d", offset)
+		}
+
+		records := dataLen(resp)
+		if records < 0 {
+			return nil, fmt.Errorf("response type %T has no Data slice", resp)
+		}
+
+		if manifest.Query == "" {
+			manifest.Query = queryWithoutPaging(rawURL)
+		}
+
+		page, err := writePage(dir, len(manifest.Pages), raw)
+		if err != nil {
+			return nil, err
+		}
+		page.URL = rawURL
+		page.Offset = offset
+		page.Records = records
+
+		manifest.Pages = append(manifest.Pages, page)
+		manifest.Records += records
+
+		if records < DefaultPageSize {
+			break
+		}
+	}
+
+	manifest.PageCount = len(manifest.Pages)
+	manifest.FinishedAt = time.Now().UTC()
+
+	b, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encode manifest: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, ManifestFile), b, 0o644); err != nil {
+		return nil, fmt.Errorf("write manifest: %w", err)
+	}
+
+	return manifest, nil
+}
+
+// writePage gzips a raw page to dir and returns its description
+func writePage(dir string, index int, raw []byte) (PageFile, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(raw); err != nil {
+		return PageFile{}, fmt.Errorf("compress page %d: %w", index, err)
+	}
+	if err := zw.Close(); err != nil {
+		return PageFile{}, fmt.Errorf("compress page %d: %w", index, err)
+	}
+
+	name := fmt.Sprintf("page-%06d.json.gz", index)
+	if err := os.WriteFile(filepath.Join(dir, name), buf.Bytes(), 0o644); err != nil {
+		return PageFile{}, fmt.Errorf("write page %d: %w", index, err)
+	}
+
+	sum := sha256.Sum256(raw)
+	return PageFile{
+		File:   name,
+		Bytes:  len(raw),
+		SHA256: hex.EncodeToString(sum[:]),
+	}, nil
+}
+
+// queryWithoutPaging strips the scheme, host, limit and offset from a request URL
+func queryWithoutPaging(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	q := u.Query()
+	q.Del("limit")
+	q.Del("offset")
+	if len(q) == 0 {
+		return u.Path
+	}
+	return u.Path + "?" + q.Encode()
+}
+
+// dataLen returns the length of the Data slice on a response, or -1 if there is none
+func dataLen(resp any) int {
+	v := reflect.ValueOf(resp)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return -1
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return -1
+	}
+	data := v.FieldByName("Data")
+	if !data.IsValid() || data.Kind() != reflect.Slice {
+		return -1
+	}
+	return data.Len()
+}
@@ -0,0 +1,97 @@
+package export
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	findapi "github.com/peterargue/find-api"
+)
+
+func TestDumpEndpoint(t *testing.T) {
+	const total = 150
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/flow/v1/block" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		var blocks []string
+		for i := offset; i < total && i < offset+limit; i++ {
+			blocks = append(blocks, fmt.Sprintf(`{"height":%d}`, i+1))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"data":[%s]}`, strings.Join(blocks, ","))
+	}))
+	defer server.Close()
+
+	client := findapi.NewClient("", "",
+		findapi.WithBaseURL(server.URL),
+		findapi.WithToken("test-token", time.Now().Add(time.Hour).Unix()),
+	)
+
+	dir := t.TempDir()
+	manifest, err := DumpEndpoint(context.Background(), client, client.Flow.GetBlocks().Height(500), dir)
+	if err != nil {
+		t.Fatalf("DumpEndpoint failed: %v", err)
+	}
+
+	if manifest.PageCount != 2 {
+		t.Errorf("Expected 2 pages, got %d", manifest.PageCount)
+	}
+	if manifest.Records != total {
+		t.Errorf("Expected %d records, got %d", total, manifest.Records)
+	}
+	if manifest.Query != "/flow/v1/block?height=500" {
+		t.Errorf("Unexpected query %q", manifest.Query)
+	}
+
+	// Verify page contents against the manifest checksum
+	page := manifest.Pages[1]
+	f, err := os.Open(filepath.Join(dir, page.File))
+	if err != nil {
+		t.Fatalf("open page: %v", err)
+	}
+	defer f.Close()
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatalf("gzip reader: %v", err)
+	}
+	raw, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatalf("read page: %v", err)
+	}
+	sum := sha256.Sum256(raw)
+	if hex.EncodeToString(sum[:]) != page.SHA256 {
+		t.Error("Page checksum does not match manifest")
+	}
+	if page.Records != 50 || page.Offset != 100 {
+		t.Errorf("Unexpected page metadata: %+v", page)
+	}
+
+	b, err := os.ReadFile(filepath.Join(dir, ManifestFile))
+	if err != nil {
+		t.Fatalf("read manifest: %v", err)
+	}
+	var onDisk Manifest
+	if err := json.Unmarshal(b, &onDisk); err != nil {
+		t.Fatalf("decode manifest: %v", err)
+	}
+	if onDisk.PageCount != manifest.PageCount {
+		t.Errorf("Manifest on disk does not match returned manifest")
+	}
+}
@@ -0,0 +1,118 @@
+package export
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/peterargue/find-api/flow"
+)
+
+// TaxReportColumns is the stable column order used when exporting tax reports.
+// New columns are only ever appended so existing spreadsheets keep working.
+var TaxReportColumns = []string{
+	"time",
+	"block_height",
+	"transaction_hash",
+	"type",
+	"direction",
+	"token",
+	"amount",
+	"abs_amount",
+	"fee",
+	"address",
+	"otherside",
+}
+
+// TaxReportRow returns an entry's values in TaxReportColumns order. It can be
+// used to feed other tabular writers (e.g. a Parquet encoder). Values are not
+// escaped for spreadsheets; the CSV writers do that.
+func TaxReportRow(e flow.TaxReportEntry) []string {
+	return []string{
+		e.Time,
+		strconv.FormatUint(e.BlockHeight, 10),
+		e.TransactionHash,
+		e.Type,
+		e.Direction,
+		e.Token,
+		formatAmount(e.Amount),
+		formatAmount(e.AbsAmount),
+		formatAmount(e.Fee),
+		e.Address,
+		e.Otherside,
+	}
+}
+
+// WriteTaxReportCSV writes entries as CSV with a header row
+func WriteTaxReportCSV(w io.Writer, entries []flow.TaxReportEntry) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(TaxReportColumns); err != nil {
+		return fmt.Errorf("write csv header: %w", err)
+	}
+	for _, e := range entries {
+		if err := cw.Write(csvRow(TaxReportRow(e))); err != nil {
+			return fmt.Errorf("write csv row: %w", err)
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// TaxReportCSV pages through an account's full tax report and writes it to w
+// as CSV, returning the number of entries written
+func TaxReportCSV(ctx context.Context, service *flow.Service, address string, w io.Writer) (int, error) {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(TaxReportColumns); err != nil {
+		return 0, fmt.Errorf("write csv header: %w", err)
+	}
+
+	written := 0
+	for offset := 0; ; offset += DefaultPageSize {
+		resp, err := service.GetAccountTaxReport().
+			Address(address).
+			Limit(DefaultPageSize).
+			Offset(offset).
+			Do(ctx)
+		if err != nil {
+			return written, fmt.Errorf("fetch tax report at offset %d: %w", offset, err)
+		}
+
+		for _, e := range resp.Data {
+			if err := cw.Write(csvRow(TaxReportRow(e))); err != nil {
+				return written, fmt.Errorf("write csv row: %w", err)
+			}
+			written++
+		}
+
+		if len(resp.Data) < DefaultPageSize {
+			break
+		}
+	}
+
+	cw.Flush()
+	return written, cw.Error()
+}
+
+// csvRow escapes a row's cells so spreadsheets do not evaluate them as
+// formulas: a cell starting with =, +, - or @ is prefixed with a single quote
+// unless it is a number, so negative amounts stay numeric
+func csvRow(row []string) []string {
+	for i, cell := range row {
+		if cell == "" || !strings.ContainsRune("=+-@", rune(cell[0])) {
+			continue
+		}
+		if _, err := strconv.ParseFloat(cell, 64); err == nil {
+			continue
+		}
+		row[i] = "'" + cell
+	}
+	return row
+}
+
+// formatAmount formats a float with the minimal digits needed to round-trip it
+func formatAmount(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
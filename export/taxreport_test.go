@@ -0,0 +1,142 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	findapi "github.com/peterargue/find-api"
+	"github.com/peterargue/find-api/flow"
+)
+
+func TestWriteTaxReportCSV(t *testing.T) {
+	entries := []flow.TaxReportEntry{
+		{
+			Time:            "2024-01-15T10:00:00Z",
+			BlockHeight:     96708412,
+			TransactionHash: "abc",
+			Type:            "transfer",
+			Direction:       "in",
+			Token:           "A.1654653399040a61.FlowToken",
+			Amount:          1.5,
+			AbsAmount:       1.5,
+			Fee:             0.00001,
+			Address:         "0x01",
+			Otherside:       "0x02, \"quoted\"",
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteTaxReportCSV(&buf, entries); err != nil {
+		t.Fatalf("WriteTaxReportCSV failed: %v", err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("Invalid CSV: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("Expected header and 1 row, got %d records", len(records))
+	}
+	if records[0][0] != "time" || len(records[0]) != len(TaxReportColumns) {
+		t.Errorf("Unexpected header %v", records[0])
+	}
+
+	row := records[1]
+	if row[1] != "96708412" {
+		t.Errorf("Expected block height 96708412, got %s", row[1])
+	}
+	if row[8] != "0.00001" {
+		t.Errorf("Expected fee 0.00001, got %s", row[8])
+	}
+	if row[10] != "0x02, \"quoted\"" {
+		t.Errorf("Expected otherside to round-trip, got %s", row[10])
+	}
+}
+
+func TestWriteTaxReportCSVFormulaEscaping(t *testing.T) {
+	entries := []flow.TaxReportEntry{
+		{
+			Type:      "=HYPERLINK(\"http://example.com\")",
+			Token:     "+cmd",
+			Direction: "@SUM(A1)",
+			Amount:    -2.5,
+			Otherside: "-1+1",
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteTaxReportCSV(&buf, entries); err != nil {
+		t.Fatalf("WriteTaxReportCSV failed: %v", err)
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("Invalid CSV: %v", err)
+	}
+
+	row := records[1]
+	for i, want := range map[int]string{
+		3:  "'=HYPERLINK(\"http://example.com\")",
+		4:  "'@SUM(A1)",
+		5:  "'+cmd",
+		6:  "-2.5",
+		10: "'-1+1",
+	} {
+		if row[i] != want {
+			t.Errorf("Expected %s %s, got %s", TaxReportColumns[i], want, row[i])
+		}
+	}
+
+	// TaxReportRow is left unescaped for other writers
+	if got := TaxReportRow(entries[0])[5]; got != "+cmd" {
+		t.Errorf("Expected unescaped token +cmd, got %s", got)
+	}
+}
+
+func TestTaxReportCSV(t *testing.T) {
+	const total = 130
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/flow/v1/account/0x01/tax-report" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		resp := flow.TaxReportResponse{}
+		for i := offset; i < total && i < offset+limit; i++ {
+			resp.Data = append(resp.Data, flow.TaxReportEntry{BlockHeight: uint64(i + 1)})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := findapi.NewClient("", "",
+		findapi.WithBaseURL(server.URL),
+		findapi.WithToken("test-token", time.Now().Add(time.Hour).Unix()),
+	)
+
+	var buf bytes.Buffer
+	n, err := TaxReportCSV(context.Background(), client.Flow, "0x01", &buf)
+	if err != nil {
+		t.Fatalf("TaxReportCSV failed: %v", err)
+	}
+	if n != total {
+		t.Errorf("Expected %d entries, got %d", total, n)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("Invalid CSV: %v", err)
+	}
+	if len(records) != total+1 {
+		t.Errorf("Expected %d records, got %d", total+1, len(records))
+	}
+}
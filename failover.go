@@ -0,0 +1,109 @@
+package findapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// failoverThreshold is the number of consecutive failures against the
+	// active base URL before the client moves to the next one
+	failoverThreshold = 3
+	// failoverProbeInterval is how long the client waits after failing over
+	// before probing the primary base URL again
+	failoverProbeInterval = 30 * time.Second
+)
+
+// WithBaseURLs sets a primary base URL and fallbacks to fail over to. After
+// consecutive connection errors or 5xx responses from the active URL the
+// client moves to the next one, and while on a fallback it periodically sends
+// a single request to the primary, switching back once it succeeds.
+func WithBaseURLs(primary string, fallbacks ...string) ClientOption {
+	return func(c *Client) {
+		c.baseURL = primary
+		c.failover = &failover{
+			urls: append([]string{primary}, fallbacks...),
+			now:  time.Now,
+		}
+	}
+}
+
+// activeBaseURL returns the base URL requests are currently sent to
+func (c *Client) activeBaseURL() string {
+	if c.failover != nil {
+		return c.failover.current()
+	}
+	return c.baseURL
+}
+
+// failover tracks the health of a client's base URLs
+type failover struct {
+	mu         sync.Mutex
+	urls       []string
+	active     int
+	failures   int
+	switchedAt time.Time
+	probing    bool
+	now        func() time.Time
+}
+
+// pick returns the base URL for the next request. Once the probe interval has
+// passed since failing over, one request at a time is sent to the primary.
+func (f *failover) pick() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.active != 0 && !f.probing && f.now().Sub(f.switchedAt) >= failoverProbeInterval {
+		f.probing = true
+		return f.urls[0]
+	}
+	return f.urls[f.active]
+}
+
+// current returns the active base URL without probing
+func (f *failover) current() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.urls[f.active]
+}
+
+// record updates the health of base after a request to it returned resp or err.
+// Requests abandoned by their context say nothing about the URL's health.
+func (f *failover) record(base string, resp *http.Response, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	abandoned := errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+	failed := err != nil || resp.StatusCode >= http.StatusInternalServerError
+
+	// Result of a probe of the primary
+	if f.active != 0 && base == f.urls[0] {
+		f.probing = false
+		switch {
+		case abandoned:
+		case failed:
+			f.switchedAt = f.now()
+		default:
+			f.active = 0
+			f.failures = 0
+		}
+		return
+	}
+
+	// Ignore results from a URL the client has already moved away from
+	if base != f.urls[f.active] || abandoned {
+		return
+	}
+	if !failed {
+		f.failures = 0
+		return
+	}
+	f.failures++
+	if f.failures >= failoverThreshold {
+		f.active = (f.active + 1) % len(f.urls)
+		f.failures = 0
+		f.switchedAt = f.now()
+	}
+}
@@ -0,0 +1,61 @@
+package findapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClient_WithBaseURLs(t *testing.T) {
+	primaryDown := true
+	var primaryHits, fallbackHits int
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		primaryHits++
+		if primaryDown {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"blocks":[]}`))
+	}))
+	defer primary.Close()
+	fallback := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fallbackHits++
+		w.Write([]byte(`{"blocks":[]}`))
+	}))
+	defer fallback.Close()
+
+	now := time.Now()
+	client := NewClient("", "", WithBaseURLs(primary.URL, fallback.URL), WithMaxRetries(0),
+		WithToken("token", now.Add(time.Hour).Unix()))
+	client.failover.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	for range failoverThreshold {
+		client.Simple.GetBlocks().Height(96708412).Do(ctx)
+	}
+	if _, err := client.Simple.GetBlocks().Height(96708412).Do(ctx); err != nil {
+		t.Fatalf("Expected request to succeed on the fallback, got %v", err)
+	}
+	if primaryHits != failoverThreshold || fallbackHits != 1 {
+		t.Errorf("Expected %d primary and 1 fallback requests, got %d and %d", failoverThreshold, primaryHits, fallbackHits)
+	}
+
+	// A failed probe keeps the client on the fallback
+	now = now.Add(failoverProbeInterval)
+	client.Simple.GetBlocks().Height(96708412).Do(ctx)
+	client.Simple.GetBlocks().Height(96708412).Do(ctx)
+	if primaryHits != failoverThreshold+1 || fallbackHits != 2 {
+		t.Errorf("Expected one probe of the primary, got %d primary and %d fallback requests", primaryHits, fallbackHits)
+	}
+
+	// A successful probe switches back to the primary
+	primaryDown = false
+	now = now.Add(failoverProbeInterval)
+	client.Simple.GetBlocks().Height(96708412).Do(ctx)
+	client.Simple.GetBlocks().Height(96708412).Do(ctx)
+	if primaryHits != failoverThreshold+3 || fallbackHits != 2 {
+		t.Errorf("Expected the client back on the primary, got %d primary and %d fallback requests", primaryHits, fallbackHits)
+	}
+}
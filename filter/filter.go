@@ -0,0 +1,194 @@
+// Package filter defines reusable query filters that can be applied to any
+// compatible request builder with Apply.
+//
+// A filter is defined once and shared across queries:
+//
+//	recent := []filter.Filter{filter.Last(24 * time.Hour), filter.Address("0x1234567890abcdef")}
+//	txs, err := client.Flow.GetAccountTransactions().Apply(recent...).Do(ctx)
+//
+// Builders report a filter they cannot express (e.g. a time range on an
+// endpoint without time parameters) as an error from Do rather than silently
+// dropping it.
+package filter
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Filter is a query constraint that can be applied to request builders
+type Filter interface {
+	applyTo(p *Params)
+}
+
+// Params is the combined set of constraints of one or more filters. Builders
+// read it in their Apply methods.
+type Params struct {
+	FromHeight *uint64
+	ToHeight   *uint64
+	FromTime   *time.Time
+	ToTime     *time.Time
+	Address    *string
+	Limit      *int
+	Offset     *int
+}
+
+// Collect combines filters into Params; later filters override earlier ones
+func Collect(filters ...Filter) Params {
+	var p Params
+	for _, f := range filters {
+		if f != nil {
+			f.applyTo(&p)
+		}
+	}
+	return p
+}
+
+// Field names used by Unsupported
+const (
+	FieldHeightRange = "height range"
+	FieldTimeRange   = "time range"
+	FieldAddress     = "address"
+	FieldPagination  = "pagination"
+)
+
+// Unsupported returns an error naming the constraints in p that are not in
+// supported, or nil if the builder can express all of them
+func (p Params) Unsupported(builder string, supported ...string) error {
+	isSupported := func(name string) bool {
+		for _, s := range supported {
+			if s == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	var missing []string
+	if (p.FromHeight != nil || p.ToHeight != nil) && !isSupported(FieldHeightRange) {
+		missing = append(missing, FieldHeightRange)
+	}
+	if (p.FromTime != nil || p.ToTime != nil) && !isSupported(FieldTimeRange) {
+		missing = append(missing, FieldTimeRange)
+	}
+	if p.Address != nil && !isSupported(FieldAddress) {
+		missing = append(missing, FieldAddress)
+	}
+	if (p.Limit != nil || p.Offset != nil) && !isSupported(FieldPagination) {
+		missing = append(missing, FieldPagination)
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s does not support %s filters", builder, strings.Join(missing, ", "))
+}
+
+// SingleHeight returns the height of a range that covers exactly one block, for
+// endpoints that only filter by a single height
+func (p Params) SingleHeight() (uint64, bool) {
+	if p.FromHeight == nil || p.ToHeight == nil || *p.FromHeight != *p.ToHeight {
+		return 0, false
+	}
+	return *p.FromHeight, true
+}
+
+// HeightRange limits results to blocks between From and To, inclusive. A zero
+// bound is open.
+type HeightRange struct {
+	From uint64
+	To   uint64
+}
+
+// Heights returns a HeightRange from one height to another, inclusive
+func Heights(from, to uint64) HeightRange {
+	return HeightRange{From: from, To: to}
+}
+
+// AtHeight returns a HeightRange covering a single block
+func AtHeight(height uint64) HeightRange {
+	return HeightRange{From: height, To: height}
+}
+
+func (f HeightRange) applyTo(p *Params) {
+	p.FromHeight, p.ToHeight = nil, nil
+	if f.From != 0 {
+		from := f.From
+		p.FromHeight = &from
+	}
+	if f.To != 0 {
+		to := f.To
+		p.ToHeight = &to
+	}
+}
+
+// TimeRange limits results to between From and To. A zero bound is open.
+type TimeRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// Last returns a TimeRange covering the duration up to now
+func Last(d time.Duration) TimeRange {
+	return TimeRange{From: time.Now().Add(-d)}
+}
+
+// Between returns a TimeRange between two times
+func Between(from, to time.Time) TimeRange {
+	return TimeRange{From: from, To: to}
+}
+
+func (f TimeRange) applyTo(p *Params) {
+	p.FromTime, p.ToTime = nil, nil
+	if !f.From.IsZero() {
+		from := f.From
+		p.FromTime = &from
+	}
+	if !f.To.IsZero() {
+		to := f.To
+		p.ToTime = &to
+	}
+}
+
+// AddressFilter limits results to an account
+type AddressFilter struct {
+	Address string
+}
+
+// Address returns an AddressFilter for an account
+func Address(address string) AddressFilter {
+	return AddressFilter{Address: address}
+}
+
+func (f AddressFilter) applyTo(p *Params) {
+	address := f.Address
+	p.Address = &address
+}
+
+// Pagination selects a page of results. A zero Limit uses the endpoint default.
+type Pagination struct {
+	Limit  int
+	Offset int
+}
+
+// Page returns a Pagination for the given limit and offset
+func Page(limit, offset int) Pagination {
+	return Pagination{Limit: limit, Offset: offset}
+}
+
+func (f Pagination) applyTo(p *Params) {
+	p.Limit, p.Offset = nil, nil
+	if f.Limit != 0 {
+		limit := f.Limit
+		p.Limit = &limit
+	}
+	if f.Offset != 0 {
+		offset := f.Offset
+		p.Offset = &offset
+	}
+}
+
+// FormatTime formats a time bound as an ISO 8601 query value
+func FormatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,55 @@
+package filter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCollect(t *testing.T) {
+	p := Collect(Heights(10, 20), Address("0x1"), Page(50, 100), Page(25, 0))
+
+	if *p.FromHeight != 10 || *p.ToHeight != 20 {
+		t.Errorf("Expected heights 10-20, got %d-%d", *p.FromHeight, *p.ToHeight)
+	}
+	if *p.Address != "0x1" {
+		t.Errorf("Expected address 0x1, got %s", *p.Address)
+	}
+	if *p.Limit != 25 || p.Offset != nil {
+		t.Errorf("Expected the later pagination to win, got limit %d offset %v", *p.Limit, p.Offset)
+	}
+	if p.FromTime != nil || p.ToTime != nil {
+		t.Error("Expected no time range")
+	}
+}
+
+func TestLast(t *testing.T) {
+	before := time.Now().Add(-time.Hour)
+	p := Collect(Last(time.Hour))
+	if p.FromTime == nil || p.FromTime.Before(before) || p.ToTime != nil {
+		t.Errorf("Expected an open-ended range starting an hour ago, got %v-%v", p.FromTime, p.ToTime)
+	}
+}
+
+func TestParams_Unsupported(t *testing.T) {
+	p := Collect(Last(time.Hour), Address("0x1"), Page(10, 0))
+
+	if err := p.Unsupported("GetThings", FieldTimeRange, FieldAddress, FieldPagination); err != nil {
+		t.Errorf("Expected all filters supported, got %v", err)
+	}
+	err := p.Unsupported("GetThings", FieldPagination)
+	if err == nil || err.Error() != "GetThings does not support time range, address filters" {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestParams_SingleHeight(t *testing.T) {
+	if h, ok := Collect(AtHeight(5)).SingleHeight(); !ok || h != 5 {
+		t.Errorf("Expected single height 5, got %d %v", h, ok)
+	}
+	if _, ok := Collect(Heights(5, 6)).SingleHeight(); ok {
+		t.Error("Expected a two-block range to not be a single height")
+	}
+	if _, ok := Collect(Heights(5, 0)).SingleHeight(); ok {
+		t.Error("Expected an open range to not be a single height")
+	}
+}
@@ -0,0 +1,144 @@
+// Package find resolves .find names to Flow addresses and back.
+package find
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Client is an interface for making HTTP requests to the API
+type Client interface {
+	DoRequest(ctx context.Context, method, path string, query url.Values) (*http.Response, error)
+	DoRequestWithBody(ctx context.Context, method, path string, query url.Values, body any) (*http.Response, error)
+	DecodeResponse(resp *http.Response, v any) error
+}
+
+// Service handles .find name lookups
+type Service struct {
+	client Client
+}
+
+// NewService creates a new find service
+func NewService(client Client) *Service {
+	return &Service{client: client}
+}
+
+// ErrNotFound is returned when a name or address has no .find registration
+var ErrNotFound = errors.New("no .find name registered")
+
+// resolverResponse is the response from the resolver endpoint
+type resolverResponse struct {
+	Data []struct {
+		ID     string `json:"id"`
+		Source string `json:"source"`
+	} `json:"data"`
+}
+
+// accountResponse is the response from the public account endpoint, reduced
+// to the profile
+type accountResponse struct {
+	Data []struct {
+		Address string   `json:"address"`
+		Profile *Profile `json:"profile"`
+	} `json:"data"`
+}
+
+// Resolve returns the address that owns a .find name. The name may include
+// the .find suffix. Candidates from the resolver are confirmed against the
+// account's profile, so a fuzzy match never resolves to the wrong address.
+func (s *Service) Resolve(ctx context.Context, name string) (string, error) {
+	name = normalizeName(name)
+	if name == "" {
+		return "", fmt.Errorf("name is required")
+	}
+
+	query := url.Values{}
+	query.Set("id", name)
+
+	resp, err := s.client.DoRequest(ctx, http.MethodGet, "/public/v1/resolver", query)
+	if err != nil {
+		return "", err
+	}
+
+	var resolved resolverResponse
+	if err := s.client.DecodeResponse(resp, &resolved); err != nil {
+		return "", err
+	}
+
+	for _, r := range resolved.Data {
+		if r.Source != "accounts" {
+			continue
+		}
+		profile, err := s.profile(ctx, r.ID)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		if normalizeName(profile.FindName) == name {
+			return r.ID, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s", ErrNotFound, name)
+}
+
+// ReverseLookup returns the .find name of an address, without the .find suffix
+func (s *Service) ReverseLookup(ctx context.Context, address string) (string, error) {
+	if err := validateAddress(address); err != nil {
+		return "", err
+	}
+
+	profile, err := s.profile(ctx, address)
+	if err != nil {
+		return "", err
+	}
+	if profile.FindName == "" {
+		return "", fmt.Errorf("%w: %s", ErrNotFound, address)
+	}
+	return profile.FindName, nil
+}
+
+// profile fetches the .find profile of an address
+func (s *Service) profile(ctx context.Context, address string) (*Profile, error) {
+	path := fmt.Sprintf("/public/v1/account/%s", address)
+	resp, err := s.client.DoRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var accountResp accountResponse
+	if err := s.client.DecodeResponse(resp, &accountResp); err != nil {
+		return nil, err
+	}
+	if len(accountResp.Data) == 0 || accountResp.Data[0].Profile == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, address)
+	}
+
+	profile := accountResp.Data[0].Profile
+	if profile.Address == "" {
+		profile.Address = accountResp.Data[0].Address
+	}
+	return profile, nil
+}
+
+// normalizeName lowercases a name and strips its .find suffix
+func normalizeName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".find")
+}
+
+// flowAddressRe matches a Flow address, with or without the 0x prefix
+var flowAddressRe = regexp.MustCompile(`^(0x)?[0-9a-fA-F]{1,16}$`)
+
+// validateAddress checks that address is a Flow address
+func validateAddress(address string) error {
+	if !flowAddressRe.MatchString(address) {
+		return fmt.Errorf("invalid account address %q", address)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package find
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+// mockClient implements the Client interface for testing
+type mockClient struct {
+	server *httptest.Server
+}
+
+func (m *mockClient) DoRequest(ctx context.Context, method, path string, query url.Values) (*http.Response, error) {
+	return m.DoRequestWithBody(ctx, method, path, query, nil)
+}
+
+func (m *mockClient) DoRequestWithBody(ctx context.Context, method, path string, query url.Values, body any) (*http.Response, error) {
+	u, err := url.Parse(m.server.URL + path)
+	if err != nil {
+		return nil, err
+	}
+	u.RawQuery = query.Encode()
+
+	var bodyReader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		bodyReader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bodyReader)
+	if err != nil {
+		return nil, err
+	}
+	return http.DefaultClient.Do(req)
+}
+
+func (m *mockClient) DecodeResponse(resp *http.Response, v any) error {
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, body)
+	}
+	return json.Unmarshal(body, v)
+}
+
+func newTestServer(t *testing.T) *httptest.Server {
+	profiles := map[string]string{
+		"0x1654653399040a61": "",
+		"0x886f3aeaf848c535": "bjartek",
+		"0xf233dcee88fe0abe": "bjartek2",
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/public/v1/resolver" {
+			if id := r.URL.Query().Get("id"); id != "bjartek" {
+				t.Errorf("Expected id bjartek, got %s", id)
+			}
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": []map[string]string{
+					{"id": "0xf233dcee88fe0abe", "source": "accounts"},
+					{"id": "0x886f3aeaf848c535", "source": "accounts"},
+				},
+			})
+			return
+		}
+
+		address := r.URL.Path[len("/public/v1/account/"):]
+		name, ok := profiles[address]
+		if !ok {
+			json.NewEncoder(w).Encode(map[string]any{"data": []any{}})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"data": []map[string]any{
+				{"address": address, "profile": map[string]string{"findName": name}},
+			},
+		})
+	}))
+}
+
+func TestFindService_Resolve(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	ctx := context.Background()
+
+	address, err := service.Resolve(ctx, "Bjartek.find")
+	if err != nil {
+		t.Fatalf("Resolve failed: %v", err)
+	}
+	if address != "0x886f3aeaf848c535" {
+		t.Errorf("Expected address 0x886f3aeaf848c535, got %s", address)
+	}
+
+	if _, err := service.Resolve(ctx, " "); err == nil {
+		t.Error("Expected error for empty name")
+	}
+}
+
+func TestFindService_ReverseLookup(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	ctx := context.Background()
+
+	name, err := service.ReverseLookup(ctx, "0x886f3aeaf848c535")
+	if err != nil {
+		t.Fatalf("ReverseLookup failed: %v", err)
+	}
+	if name != "bjartek" {
+		t.Errorf("Expected name bjartek, got %s", name)
+	}
+
+	if _, err := service.ReverseLookup(ctx, "0x1654653399040a61"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+	if _, err := service.ReverseLookup(ctx, "not-an-address"); err == nil {
+		t.Error("Expected error for invalid address")
+	}
+}
@@ -0,0 +1,100 @@
+package find
+
+import (
+	"context"
+	"fmt"
+)
+
+// Profile is the .find profile of an account
+type Profile struct {
+	Address               string          `json:"address"`
+	AllowStoringFollowers bool            `json:"allowStoringFollowers"`
+	Avatar                string          `json:"avatar"`
+	CreatedAt             string          `json:"createdAt"`
+	Description           string          `json:"description"`
+	FindName              string          `json:"findName"`
+	Followers             []FriendStatus  `json:"followers"`
+	Following             []FriendStatus  `json:"following"`
+	Gender                string          `json:"gender"`
+	Links                 map[string]Link `json:"links"`
+	Name                  string          `json:"name"`
+	Tags                  []string        `json:"tags"`
+	Wallets               []Wallet        `json:"wallets"`
+}
+
+// FriendStatus is a follow relationship between two profiles
+type FriendStatus struct {
+	Status      string `json:"status"`
+	UserAddress string `json:"userAddress"`
+}
+
+// Link is a titled URL on a profile, e.g. a website or social account
+type Link struct {
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+// Wallet is a wallet linked to a profile
+type Wallet struct {
+	Address string `json:"address"`
+	Name    string `json:"name"`
+}
+
+// ProfileRequestBuilder builds a request to get a .find profile
+type ProfileRequestBuilder struct {
+	service *Service
+	address string
+	name    string
+}
+
+// GetProfile creates a new profile request builder
+func (s *Service) GetProfile() *ProfileRequestBuilder {
+	return &ProfileRequestBuilder{service: s}
+}
+
+// Clone returns an independent copy of the profile request builder
+func (b *ProfileRequestBuilder) Clone() *ProfileRequestBuilder {
+	c := *b
+	return &c
+}
+
+// Address sets the account address (Address or Name required)
+func (b *ProfileRequestBuilder) Address(address string) *ProfileRequestBuilder {
+	b.address = address
+	return b
+}
+
+// Name sets the .find name, which is resolved to its address first (Address or Name required)
+func (b *ProfileRequestBuilder) Name(name string) *ProfileRequestBuilder {
+	b.name = name
+	return b
+}
+
+// Validate checks the profile request without making a network call
+func (b *ProfileRequestBuilder) Validate() error {
+	switch {
+	case b.address != "" && b.name != "":
+		return fmt.Errorf("only one of address and name may be set")
+	case b.address != "":
+		return validateAddress(b.address)
+	case normalizeName(b.name) != "":
+		return nil
+	}
+	return fmt.Errorf("address or name is required")
+}
+
+// Do executes the profile request. Accounts without a profile return ErrNotFound.
+func (b *ProfileRequestBuilder) Do(ctx context.Context) (*Profile, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	address := b.address
+	if address == "" {
+		var err error
+		if address, err = b.service.Resolve(ctx, b.name); err != nil {
+			return nil, err
+		}
+	}
+	return b.service.profile(ctx, address)
+}
@@ -0,0 +1,75 @@
+package find
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFindService_GetProfile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/public/v1/resolver" {
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": []map[string]string{{"id": "0x886f3aeaf848c535", "source": "accounts"}},
+			})
+			return
+		}
+		if r.URL.Path != "/public/v1/account/0x886f3aeaf848c535" {
+			t.Errorf("Expected path /public/v1/account/0x886f3aeaf848c535, got %s", r.URL.Path)
+		}
+
+		json.NewEncoder(w).Encode(map[string]any{
+			"data": []map[string]any{
+				{
+					"address": "0x886f3aeaf848c535",
+					"profile": map[string]any{
+						"findName":    "bjartek",
+						"avatar":      "https://find.xyz/avatar.png",
+						"description": "Flow builder",
+						"links": map[string]any{
+							"twitter": map[string]string{"title": "Twitter", "url": "https://twitter.com/bjartek"},
+						},
+						"wallets": []map[string]string{{"name": "Flow", "address": "0x886f3aeaf848c535"}},
+					},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	ctx := context.Background()
+
+	for _, b := range []*ProfileRequestBuilder{
+		service.GetProfile().Address("0x886f3aeaf848c535"),
+		service.GetProfile().Name("bjartek.find"),
+	} {
+		profile, err := b.Do(ctx)
+		if err != nil {
+			t.Fatalf("GetProfile failed: %v", err)
+		}
+		if profile.Address != "0x886f3aeaf848c535" {
+			t.Errorf("Expected address 0x886f3aeaf848c535, got %s", profile.Address)
+		}
+		if profile.Description != "Flow builder" {
+			t.Errorf("Expected description Flow builder, got %s", profile.Description)
+		}
+		if profile.Links["twitter"].URL != "https://twitter.com/bjartek" {
+			t.Errorf("Expected twitter link, got %+v", profile.Links)
+		}
+		if len(profile.Wallets) != 1 || profile.Wallets[0].Name != "Flow" {
+			t.Errorf("Expected 1 Flow wallet, got %+v", profile.Wallets)
+		}
+	}
+
+	if err := service.GetProfile().Validate(); err == nil {
+		t.Error("Expected error when neither address nor name is set")
+	}
+	if err := service.GetProfile().Address("0x886f3aeaf848c535").Name("bjartek").Validate(); err == nil {
+		t.Error("Expected error when both address and name are set")
+	}
+}
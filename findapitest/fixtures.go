@@ -0,0 +1,125 @@
+package findapitest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// fixture is a canned response served for requests matching a URL pattern
+type fixture struct {
+	method   string
+	segments []string
+	status   int
+	body     []byte
+}
+
+// AddFixture serves body with the given status for requests matching pattern.
+//
+// A pattern is an optional method followed by a path, e.g.
+// "GET /flow/v1/account/{address}/ft". A {name} segment matches any single
+// path segment and a trailing "*" matches the rest of the path. When several
+// fixtures match, the one with the most literal segments wins. Fixtures take
+// precedence over the built-in routes and are served without authentication.
+func (s *Server) AddFixture(pattern string, status int, body []byte) {
+	method, path, ok := strings.Cut(pattern, " ")
+	if !ok {
+		method, path = "", pattern
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fixtures = append(s.fixtures, fixture{
+		method:   strings.ToUpper(method),
+		segments: splitPath(path),
+		status:   status,
+		body:     body,
+	})
+}
+
+// LoadFixture serves the contents of file with status 200 for requests matching
+// pattern. Gzip-compressed files are decompressed transparently.
+func (s *Server) LoadFixture(pattern, file string) error {
+	body, err := ReadFixture(file)
+	if err != nil {
+		return err
+	}
+	s.AddFixture(pattern, http.StatusOK, body)
+	return nil
+}
+
+// ReadFixture reads a fixture file, decompressing it if it is gzip-compressed
+func ReadFixture(file string) ([]byte, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("read fixture: %w", err)
+	}
+	if !isGzip(data) {
+		return data, nil
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decompress fixture %s: %w", file, err)
+	}
+	defer zr.Close()
+
+	body, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("decompress fixture %s: %w", file, err)
+	}
+	return body, nil
+}
+
+// isGzip reports whether data starts with the gzip magic number
+func isGzip(data []byte) bool {
+	return len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b
+}
+
+// matchFixture returns the most specific fixture matching the request
+func (s *Server) matchFixture(r *http.Request) (fixture, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	path := splitPath(r.URL.Path)
+	best, bestScore := fixture{}, -1
+	for _, f := range s.fixtures {
+		if f.method != "" && f.method != r.Method {
+			continue
+		}
+		score, ok := matchSegments(f.segments, path)
+		if ok && score > bestScore {
+			best, bestScore = f, score
+		}
+	}
+	return best, bestScore >= 0
+}
+
+// matchSegments matches a path against pattern segments, returning the number of literal segments matched
+func matchSegments(pattern, path []string) (int, bool) {
+	literals := 0
+	for i, seg := range pattern {
+		if seg == "*" && i == len(pattern)-1 {
+			return literals, true
+		}
+		if i >= len(path) {
+			return 0, false
+		}
+		if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") {
+			continue
+		}
+		if seg != path[i] {
+			return 0, false
+		}
+		literals++
+	}
+	return literals, len(pattern) == len(path)
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
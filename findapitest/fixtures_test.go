@@ -0,0 +1,84 @@
+package findapitest
+
+import (
+	"compress/gzip"
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	findapi "github.com/peterargue/find-api"
+)
+
+func writeGzipFixture(t *testing.T, name, body string) string {
+	t.Helper()
+	file := filepath.Join(t.TempDir(), name)
+	f, err := os.Create(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zw := gzip.NewWriter(f)
+	zw.Write([]byte(body))
+	zw.Close()
+	f.Close()
+	return file
+}
+
+func TestServer_LoadFixture(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	file := writeGzipFixture(t, "account_ft.json.gz", `{"data":[{"address":"0x01","token":"A.1.FlowToken"}]}`)
+	if err := srv.LoadFixture("GET /flow/v1/account/{address}/ft", file); err != nil {
+		t.Fatalf("LoadFixture failed: %v", err)
+	}
+
+	client := srv.Client()
+	ctx := context.Background()
+
+	for _, addr := range []string{"0x01", "0x02"} {
+		resp, err := client.Flow.GetAccountFTs().Address(addr).Do(ctx)
+		if err != nil {
+			t.Fatalf("GetAccountFTs(%s) failed: %v", addr, err)
+		}
+		if len(resp.Data) != 1 {
+			t.Errorf("Expected 1 collection, got %d", len(resp.Data))
+		}
+	}
+}
+
+func TestServer_FixturePrecedence(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	srv.AddFixture("/flow/v1/nft/*", http.StatusOK, []byte(`{"data":[]}`))
+	srv.AddFixture("/flow/v1/nft/{type}/holding", http.StatusTeapot, []byte(`{}`))
+	srv.AddFixture("POST /flow/v1/nft/A.1.Foo", http.StatusInternalServerError, nil)
+
+	client := srv.Client()
+	ctx := context.Background()
+
+	if _, err := client.Flow.GetNFTCollection().NFTType("A.1.Foo").Do(ctx); err != nil {
+		t.Errorf("Expected wildcard fixture to match, got %v", err)
+	}
+
+	_, err := client.Flow.GetNFTHoldings().NFTType("A.1.Foo").Do(ctx)
+	apiErr, ok := err.(*findapi.APIError)
+	if !ok || apiErr.StatusCode != http.StatusTeapot {
+		t.Errorf("Expected more specific fixture to win with 418, got %v", err)
+	}
+}
+
+func TestReadFixture_Plain(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "plain.json")
+	os.WriteFile(file, []byte(`{"ok":true}`), 0o644)
+
+	body, err := ReadFixture(file)
+	if err != nil {
+		t.Fatalf("ReadFixture failed: %v", err)
+	}
+	if string(body) != `{"ok":true}` {
+		t.Errorf("Expected plain body, got %s", body)
+	}
+}
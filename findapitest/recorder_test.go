@@ -0,0 +1,78 @@
+package findapitest
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+
+	findapi "github.com/peterargue/find-api"
+	"github.com/peterargue/find-api/simple"
+)
+
+func TestRecorder_RecordAndReplay(t *testing.T) {
+	cassette := filepath.Join(t.TempDir(), "blocks.json.gz")
+	ctx := context.Background()
+
+	// Record against a live (fake) server
+	srv := NewServer()
+	srv.AddBlocks(simple.Block{Height: 100, ID: "block100"})
+
+	rec, err := NewRecorder(cassette, ModeAuto)
+	if err != nil {
+		t.Fatalf("NewRecorder failed: %v", err)
+	}
+	if !rec.Recording() {
+		t.Fatal("Expected ModeAuto to record when the cassette is missing")
+	}
+
+	client := findapi.NewClient(Username, Password, findapi.WithBaseURL(srv.URL), findapi.WithTransport(rec))
+	if _, err := client.Simple.GetBlocks().Height(100).Do(ctx); err != nil {
+		t.Fatalf("Recorded request failed: %v", err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	srv.Close()
+
+	data, err := ReadFixture(cassette)
+	if err != nil {
+		t.Fatalf("ReadFixture failed: %v", err)
+	}
+	if bytes.Contains(data, []byte(Token)) {
+		t.Error("Expected access token to be scrubbed from cassette")
+	}
+	if !bytes.Contains(data, []byte(Redacted)) {
+		t.Error("Expected redacted token in cassette")
+	}
+
+	// Replay with the server gone
+	rec, err = NewRecorder(cassette, ModeAuto)
+	if err != nil {
+		t.Fatalf("NewRecorder failed: %v", err)
+	}
+	if rec.Recording() {
+		t.Fatal("Expected ModeAuto to replay when the cassette exists")
+	}
+
+	client = findapi.NewClient("any", "any", findapi.WithBaseURL("http://replay.invalid"), findapi.WithTransport(rec))
+	for range 2 {
+		blocks, err := client.Simple.GetBlocks().Height(100).Do(ctx)
+		if err != nil {
+			t.Fatalf("Replayed request failed: %v", err)
+		}
+		if len(blocks.Blocks) != 1 || blocks.Blocks[0].ID != "block100" {
+			t.Errorf("Expected replayed block100, got %+v", blocks.Blocks)
+		}
+	}
+
+	if _, err := client.Simple.GetBlocks().Height(101).Do(ctx); err == nil {
+		t.Error("Expected error for unrecorded request")
+	}
+}
+
+func TestNewRecorder_ReplayMissingCassette(t *testing.T) {
+	if _, err := NewRecorder(filepath.Join(t.TempDir(), "missing.json"), ModeReplay); err == nil {
+		t.Error("Expected error replaying a missing cassette")
+	}
+}
@@ -0,0 +1,370 @@
+// Package findapitest provides an in-memory fake of the FindLabs API for
+// integration tests of applications built on the SDK.
+//
+// A Server implements the auth, simple and a subset of the flow routes backed
+// by seeded blocks, events, transactions and accounts. Any other route can be
+// served from (optionally gzip-compressed) fixture files with LoadFixture:
+//
+//	srv := findapitest.NewServer()
+//	defer srv.Close()
+//
+//	srv.AddBlocks(simple.Block{Height: 100, ID: "abc"})
+//	client := srv.Client()
+//	blocks, err := client.Simple.GetBlocks().Height(100).Do(ctx)
+package findapitest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	findapi "github.com/peterargue/find-api"
+	"github.com/peterargue/find-api/flow"
+	"github.com/peterargue/find-api/simple"
+)
+
+const (
+	// Username and Password are the credentials accepted by the fake server
+	Username = "findapitest"
+	Password = "findapitest"
+
+	// Token is the bearer token issued by the fake server
+	Token = "findapitest-token"
+
+	defaultLimit = 25
+	maxLimit     = 100
+)
+
+// Server is an in-memory fake of the FindLabs API. It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	mu           sync.RWMutex
+	blocks       map[uint64]simple.Block
+	events       []simple.Event
+	transactions map[string]simple.Transaction
+	accounts     map[string]flow.Account
+	fixtures     []fixture
+
+	mux *http.ServeMux
+}
+
+// NewServer starts a fake API server. Callers must Close it when done.
+func NewServer() *Server {
+	s := &Server{
+		blocks:       make(map[uint64]simple.Block),
+		transactions: make(map[string]simple.Transaction),
+		accounts:     make(map[string]flow.Account),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /auth/v1/generate", s.handleGenerateToken)
+	mux.HandleFunc("GET /simple/v1/blocks", s.authenticated(s.handleSimpleBlocks))
+	mux.HandleFunc("GET /simple/v1/events", s.authenticated(s.handleSimpleEvents))
+	mux.HandleFunc("GET /simple/v1/transaction", s.authenticated(s.handleSimpleTransaction))
+	mux.HandleFunc("GET /simple/v1/transaction/events", s.authenticated(s.handleSimpleTransactionEvents))
+	mux.HandleFunc("GET /flow/v1/block", s.authenticated(s.handleFlowBlocks))
+	mux.HandleFunc("GET /flow/v1/block/{height}", s.authenticated(s.handleFlowBlock))
+	mux.HandleFunc("GET /flow/v1/account", s.authenticated(s.handleFlowAccounts))
+	mux.HandleFunc("GET /flow/v1/account/{address}", s.authenticated(s.handleFlowAccount))
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("no fake route for %s %s", r.Method, r.URL.Path))
+	})
+
+	s.mux = mux
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// serveHTTP serves a matching fixture if there is one, otherwise the built-in routes
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if f, ok := s.matchFixture(r); ok {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(f.status)
+		w.Write(f.body)
+		return
+	}
+	s.mux.ServeHTTP(w, r)
+}
+
+// Client returns a findapi.Client configured to talk to the fake server
+func (s *Server) Client(opts ...findapi.ClientOption) *findapi.Client {
+	opts = append([]findapi.ClientOption{findapi.WithBaseURL(s.URL)}, opts...)
+	return findapi.NewClient(Username, Password, opts...)
+}
+
+// AddBlocks seeds blocks, replacing any existing block at the same height
+func (s *Server) AddBlocks(blocks ...simple.Block) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, b := range blocks {
+		s.blocks[b.Height] = b
+	}
+}
+
+// AddEvents seeds events
+func (s *Server) AddEvents(events ...simple.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, events...)
+	sort.SliceStable(s.events, func(i, j int) bool {
+		if s.events[i].BlockHeight != s.events[j].BlockHeight {
+			return s.events[i].BlockHeight < s.events[j].BlockHeight
+		}
+		return s.events[i].EventIndex < s.events[j].EventIndex
+	})
+}
+
+// AddTransactions seeds transactions, replacing any existing transaction with the same ID
+func (s *Server) AddTransactions(txs ...simple.Transaction) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, tx := range txs {
+		s.transactions[tx.ID] = tx
+	}
+}
+
+// AddAccounts seeds accounts, replacing any existing account with the same address
+func (s *Server) AddAccounts(accounts ...flow.Account) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, a := range accounts {
+		s.accounts[a.Address] = a
+	}
+}
+
+// authenticated rejects requests without the fake server's bearer token
+func (s *Server) authenticated(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+Token {
+			writeError(w, http.StatusUnauthorized, "missing or invalid bearer token")
+			return
+		}
+		next(w, r)
+	}
+}
+
+func (s *Server) handleGenerateToken(w http.ResponseWriter, r *http.Request) {
+	username, password, ok := r.BasicAuth()
+	if !ok || username != Username || password != Password {
+		writeError(w, http.StatusUnauthorized, "invalid credentials")
+		return
+	}
+
+	expiry := 10 * time.Minute
+	if v := r.URL.Query().Get("expiry"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid expiry")
+			return
+		}
+		expiry = d
+	}
+
+	now := time.Now()
+	writeJSON(w, map[string]any{
+		"access_token": Token,
+		"token_type":   "Bearer",
+		"expires_in":   int(expiry.Seconds()),
+		"exp":          now.Add(expiry).Unix(),
+		"iat":          now.Unix(),
+	})
+}
+
+func (s *Server) handleSimpleBlocks(w http.ResponseWriter, r *http.Request) {
+	height, err := strconv.ParseUint(r.URL.Query().Get("height"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Field 'Height' failed on the 'required' tag")
+		return
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	blocks := []simple.Block{}
+	if b, ok := s.blocks[height]; ok {
+		blocks = append(blocks, b)
+	}
+	writeJSON(w, simple.BlocksResponse{Blocks: blocks})
+}
+
+func (s *Server) handleSimpleEvents(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	name := q.Get("name")
+	from, errFrom := strconv.ParseUint(q.Get("from_height"), 10, 64)
+	to, errTo := strconv.ParseUint(q.Get("to_height"), 10, 64)
+	if name == "" || errFrom != nil || errTo != nil {
+		writeError(w, http.StatusBadRequest, "name, from_height and to_height are required")
+		return
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	events := []simple.Event{}
+	for _, e := range s.events {
+		if e.Name == name && e.BlockHeight >= from && e.BlockHeight <= to {
+			events = append(events, e)
+		}
+	}
+	writeJSON(w, simple.EventsResponse{Events: page(events, offsetParam(r), maxLimit)})
+}
+
+func (s *Server) handleSimpleTransaction(w http.ResponseWriter, r *http.Request) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	txs := []simple.Transaction{}
+	if tx, ok := s.transactions[r.URL.Query().Get("id")]; ok {
+		txs = append(txs, tx)
+	}
+	writeJSON(w, simple.TransactionsResponse{Transactions: txs})
+}
+
+func (s *Server) handleSimpleTransactionEvents(w http.ResponseWriter, r *http.Request) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	events := []simple.SimpleEvent{}
+	if tx, ok := s.transactions[r.URL.Query().Get("transaction_id")]; ok {
+		for _, e := range tx.Events {
+			events = append(events, simple.SimpleEvent{EventIndex: e.EventIndex, Name: e.Name, Fields: e.Fields})
+		}
+	}
+	writeJSON(w, simple.TransactionEventsResponse{Events: page(events, offsetParam(r), maxLimit)})
+}
+
+// handleFlowBlocks lists blocks from newest to oldest, starting at the height filter if set
+func (s *Server) handleFlowBlocks(w http.ResponseWriter, r *http.Request) {
+	var maxHeight *uint64
+	if v := r.URL.Query().Get("height"); v != "" {
+		h, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid height")
+			return
+		}
+		maxHeight = &h
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	blocks := []flow.Block{}
+	for _, b := range s.blocks {
+		if maxHeight == nil || b.Height <= *maxHeight {
+			blocks = append(blocks, flowBlock(b))
+		}
+	}
+	sort.Slice(blocks, func(i, j int) bool { return blocks[i].Height > blocks[j].Height })
+	writeJSON(w, flow.BlockResponse{Data: page(blocks, offsetParam(r), limitParam(r))})
+}
+
+func (s *Server) handleFlowBlock(w http.ResponseWriter, r *http.Request) {
+	height, err := strconv.ParseUint(r.PathValue("height"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid height")
+		return
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	b, ok := s.blocks[height]
+	if !ok {
+		writeError(w, http.StatusNotFound, "block not found")
+		return
+	}
+	writeJSON(w, flow.BlockResponse{Data: []flow.Block{flowBlock(b)}})
+}
+
+// handleFlowAccounts lists accounts sorted by address, or by descending balance when sort_by=flow_balance
+func (s *Server) handleFlowAccounts(w http.ResponseWriter, r *http.Request) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	accounts := make([]flow.Account, 0, len(s.accounts))
+	for _, a := range s.accounts {
+		accounts = append(accounts, a)
+	}
+	if r.URL.Query().Get("sort_by") == "flow_balance" {
+		sort.Slice(accounts, func(i, j int) bool { return accounts[i].FlowBalance > accounts[j].FlowBalance })
+	} else {
+		sort.Slice(accounts, func(i, j int) bool { return accounts[i].Address < accounts[j].Address })
+	}
+	writeJSON(w, flow.AccountsResponse{Data: page(accounts, offsetParam(r), limitParam(r))})
+}
+
+func (s *Server) handleFlowAccount(w http.ResponseWriter, r *http.Request) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	a, ok := s.accounts[r.PathValue("address")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "account not found")
+		return
+	}
+
+	details := flow.CombinedAccountDetails{
+		Address:          a.Address,
+		FlowBalance:      a.FlowBalance,
+		FlowStorage:      a.FlowStorage,
+		StorageAvailable: a.StorageAvailable,
+		StorageUsed:      a.StorageUsed,
+	}
+	if a.FindName != "" {
+		details.Find = &flow.Find{Name: a.FindName}
+		details.Domains = &flow.Domains{FindName: a.FindName}
+	}
+	writeJSON(w, flow.AccountDetailsResponse{Data: []flow.CombinedAccountDetails{details}})
+}
+
+// flowBlock converts a seeded block to the flow API representation
+func flowBlock(b simple.Block) flow.Block {
+	tx := b.TxCount
+	if tx == 0 {
+		tx = len(b.Transactions)
+	}
+	return flow.Block{Height: b.Height, ID: b.ID, Timestamp: b.Timestamp, Tx: tx}
+}
+
+// page returns one page of items, always as a non-nil slice
+func page[T any](items []T, offset, limit int) []T {
+	if offset >= len(items) {
+		return []T{}
+	}
+	end := min(offset+limit, len(items))
+	return items[offset:end]
+}
+
+func offsetParam(r *http.Request) int {
+	offset, err := strconv.Atoi(r.URL.Query().Get("offset"))
+	if err != nil || offset < 0 {
+		return 0
+	}
+	return offset
+}
+
+func limitParam(r *http.Request) int {
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || limit <= 0 {
+		return defaultLimit
+	}
+	return min(limit, maxLimit)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"error": strings.TrimSpace(message)})
+}
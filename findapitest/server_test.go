@@ -0,0 +1,131 @@
+package findapitest
+
+import (
+	"context"
+	"testing"
+
+	findapi "github.com/peterargue/find-api"
+	"github.com/peterargue/find-api/flow"
+	"github.com/peterargue/find-api/simple"
+)
+
+func TestServer_Simple(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	srv.AddBlocks(simple.Block{Height: 100, ID: "block100", TxCount: 2})
+	srv.AddEvents(
+		simple.Event{BlockHeight: 101, Name: "A.1.Foo.Bar"},
+		simple.Event{BlockHeight: 100, Name: "A.1.Foo.Bar"},
+		simple.Event{BlockHeight: 100, Name: "A.1.Foo.Other"},
+		simple.Event{BlockHeight: 200, Name: "A.1.Foo.Bar"},
+	)
+	srv.AddTransactions(simple.Transaction{
+		ID:     "tx1",
+		Events: []simple.TransactionEvent{{EventIndex: 0, Name: "A.1.Foo.Bar"}},
+	})
+
+	client := srv.Client()
+	ctx := context.Background()
+
+	blocks, err := client.Simple.GetBlocks().Height(100).Do(ctx)
+	if err != nil {
+		t.Fatalf("GetBlocks failed: %v", err)
+	}
+	if len(blocks.Blocks) != 1 || blocks.Blocks[0].ID != "block100" {
+		t.Errorf("Expected block100, got %+v", blocks.Blocks)
+	}
+
+	events, err := client.Simple.GetEvents().Name("A.1.Foo.Bar").FromHeight(100).ToHeight(150).Do(ctx)
+	if err != nil {
+		t.Fatalf("GetEvents failed: %v", err)
+	}
+	if len(events.Events) != 2 {
+		t.Fatalf("Expected 2 events, got %d", len(events.Events))
+	}
+	if events.Events[0].BlockHeight != 100 {
+		t.Errorf("Expected events ordered by height, got %d first", events.Events[0].BlockHeight)
+	}
+
+	txs, err := client.Simple.GetTransaction().ID("tx1").Do(ctx)
+	if err != nil {
+		t.Fatalf("GetTransaction failed: %v", err)
+	}
+	if len(txs.Transactions) != 1 {
+		t.Errorf("Expected 1 transaction, got %d", len(txs.Transactions))
+	}
+
+	txEvents, err := client.Simple.GetTransactionEvents().TransactionID("tx1").Do(ctx)
+	if err != nil {
+		t.Fatalf("GetTransactionEvents failed: %v", err)
+	}
+	if len(txEvents.Events) != 1 {
+		t.Errorf("Expected 1 transaction event, got %d", len(txEvents.Events))
+	}
+}
+
+func TestServer_Flow(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	srv.AddBlocks(
+		simple.Block{Height: 1, ID: "b1"},
+		simple.Block{Height: 2, ID: "b2"},
+		simple.Block{Height: 3, ID: "b3"},
+	)
+	srv.AddAccounts(
+		flow.Account{Address: "0x01", FlowBalance: 5},
+		flow.Account{Address: "0x02", FlowBalance: 50, FindName: "bob"},
+	)
+
+	client := srv.Client()
+	ctx := context.Background()
+
+	blocks, err := client.Flow.GetBlocks().Height(2).Limit(10).Do(ctx)
+	if err != nil {
+		t.Fatalf("GetBlocks failed: %v", err)
+	}
+	if len(blocks.Data) != 2 || blocks.Data[0].Height != 2 {
+		t.Errorf("Expected blocks 2 and 1 newest first, got %+v", blocks.Data)
+	}
+
+	block, err := client.Flow.GetBlock().Height(3).Do(ctx)
+	if err != nil {
+		t.Fatalf("GetBlock failed: %v", err)
+	}
+	if block.Data[0].ID != "b3" {
+		t.Errorf("Expected b3, got %s", block.Data[0].ID)
+	}
+
+	accounts, err := client.Flow.GetAccounts().SortBy("flow_balance").Do(ctx)
+	if err != nil {
+		t.Fatalf("GetAccounts failed: %v", err)
+	}
+	if len(accounts.Data) != 2 || accounts.Data[0].Address != "0x02" {
+		t.Errorf("Expected richest account first, got %+v", accounts.Data)
+	}
+
+	account, err := client.Flow.GetAccount().Address("0x02").Do(ctx)
+	if err != nil {
+		t.Fatalf("GetAccount failed: %v", err)
+	}
+	if account.Data[0].Find == nil || account.Data[0].Find.Name != "bob" {
+		t.Errorf("Expected find name bob, got %+v", account.Data[0].Find)
+	}
+
+	_, err = client.Flow.GetBlock().Height(99).Do(ctx)
+	if apiErr, ok := err.(*findapi.APIError); !ok || apiErr.StatusCode != 404 {
+		t.Errorf("Expected 404 APIError, got %v", err)
+	}
+}
+
+func TestServer_RejectsBadCredentials(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	client := findapi.NewClient("wrong", "wrong", findapi.WithBaseURL(srv.URL))
+	_, err := client.Simple.GetBlocks().Height(1).Do(context.Background())
+	if err == nil {
+		t.Fatal("Expected authentication error")
+	}
+}
@@ -6,6 +6,9 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"time"
+
+	"github.com/peterargue/find-api/filter"
 )
 
 // Account represents basic account information
@@ -24,12 +27,7 @@ type Account struct {
 }
 
 // AccountsResponse represents the response from the accounts list endpoint
-type AccountsResponse struct {
-	Data  []Account              `json:"data"`
-	Links map[string]string      `json:"_links"`
-	Meta  map[string]interface{} `json:"_meta"`
-	Error interface{}            `json:"error,omitempty"`
-}
+type AccountsResponse = Response[Account]
 
 // AccountInfo represents on-chain account information
 type AccountInfo struct {
@@ -69,6 +67,8 @@ type Find struct {
 type CombinedAccountDetails struct {
 	AccountInfo      *AccountInfo           `json:"accountInfo"`
 	Address          string                 `json:"address"`
+	// Contracts are the names of the deployed contracts; use GetAccountContracts
+	// for their code and deployment heights
 	Contracts        []string               `json:"contracts"`
 	Domains          *Domains               `json:"domains"`
 	Find             *Find                  `json:"find"`
@@ -81,29 +81,22 @@ type CombinedAccountDetails struct {
 }
 
 // AccountDetailsResponse represents the response from the account details endpoint
-type AccountDetailsResponse struct {
-	Data  []CombinedAccountDetails `json:"data"`
-	Links map[string]string        `json:"_links"`
-	Meta  map[string]interface{}   `json:"_meta"`
-	Error interface{}              `json:"error,omitempty"`
-}
+type AccountDetailsResponse = Response[CombinedAccountDetails]
+
+// AccountKeysResponse holds the keys of an account
+type AccountKeysResponse = Response[KeyInfo]
 
 // AccountFTCollection represents an FT collection in an account
 type AccountFTCollection struct {
 	Address string `json:"address"`
-	Balance string `json:"balance"`
+	Balance Number `json:"balance"`
 	Path    string `json:"path"`
 	Token   string `json:"token"`
 	VaultID int    `json:"vault_id"`
 }
 
 // AccountFTCollectionsResponse represents the response from the account FT collections endpoint
-type AccountFTCollectionsResponse struct {
-	Data  []AccountFTCollection  `json:"data"`
-	Links map[string]string      `json:"_links"`
-	Meta  map[string]interface{} `json:"_meta"`
-	Error interface{}            `json:"error,omitempty"`
-}
+type AccountFTCollectionsResponse = Response[AccountFTCollection]
 
 // AccountTransaction represents a transaction for an account
 type AccountTransaction struct {
@@ -129,12 +122,7 @@ type AccountTransaction struct {
 }
 
 // AccountTransactionsResponse represents the response from the account transactions endpoint
-type AccountTransactionsResponse struct {
-	Data  []AccountTransaction   `json:"data"`
-	Links map[string]string      `json:"_links"`
-	Meta  map[string]interface{} `json:"_meta"`
-	Error interface{}            `json:"error,omitempty"`
-}
+type AccountTransactionsResponse = Response[AccountTransaction]
 
 // TaxReportEntry represents a tax report entry
 type TaxReportEntry struct {
@@ -152,12 +140,7 @@ type TaxReportEntry struct {
 }
 
 // TaxReportResponse represents the response from the tax report endpoint
-type TaxReportResponse struct {
-	Data  []TaxReportEntry       `json:"data"`
-	Links map[string]string      `json:"_links"`
-	Meta  map[string]interface{} `json:"_meta"`
-	Error interface{}            `json:"error,omitempty"`
-}
+type TaxReportResponse = Response[TaxReportEntry]
 
 // AccountsRequestBuilder builds a request to get accounts list
 type AccountsRequestBuilder struct {
@@ -173,6 +156,12 @@ func (s *Service) GetAccounts() *AccountsRequestBuilder {
 	return &AccountsRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the accounts list request builder
+func (b *AccountsRequestBuilder) Clone() *AccountsRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Height sets the block height cursor for pagination (optional)
 func (b *AccountsRequestBuilder) Height(height uint64) *AccountsRequestBuilder {
 	b.height = &height
@@ -197,8 +186,17 @@ func (b *AccountsRequestBuilder) SortBy(sortBy string) *AccountsRequestBuilder {
 	return b
 }
 
+// Validate checks the accounts list request without making a network call
+func (b *AccountsRequestBuilder) Validate() error {
+	return validatePage(b.limit, b.offset)
+}
+
 // Do executes the accounts list request
 func (b *AccountsRequestBuilder) Do(ctx context.Context) (*AccountsResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
 	query := url.Values{}
 	if b.height != nil {
 		query.Set("height", strconv.FormatUint(*b.height, 10))
@@ -222,6 +220,7 @@ func (b *AccountsRequestBuilder) Do(ctx context.Context) (*AccountsResponse, err
 	if err := b.service.client.DecodeResponse(resp, &accountsResp); err != nil {
 		return nil, err
 	}
+	accountsResp.Data = emptyIfNil(accountsResp.Data)
 
 	return &accountsResp, nil
 }
@@ -237,16 +236,30 @@ func (s *Service) GetAccount() *AccountRequestBuilder {
 	return &AccountRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the account details request builder
+func (b *AccountRequestBuilder) Clone() *AccountRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Address sets the account address (required)
 func (b *AccountRequestBuilder) Address(address string) *AccountRequestBuilder {
 	b.address = address
 	return b
 }
 
+// Validate checks the account details request without making a network call
+func (b *AccountRequestBuilder) Validate() error {
+	if b.address == "" {
+		return fmt.Errorf("account address is required")
+	}
+	return validateAddress(b.address)
+}
+
 // Do executes the account details request
 func (b *AccountRequestBuilder) Do(ctx context.Context) (*AccountDetailsResponse, error) {
-	if b.address == "" {
-		return nil, fmt.Errorf("account address is required")
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	path := fmt.Sprintf("/flow/v1/account/%s", b.address)
@@ -259,10 +272,73 @@ func (b *AccountRequestBuilder) Do(ctx context.Context) (*AccountDetailsResponse
 	if err := b.service.client.DecodeResponse(resp, &accountResp); err != nil {
 		return nil, err
 	}
+	accountResp.Data = emptyIfNil(accountResp.Data)
 
 	return &accountResp, nil
 }
 
+// AccountKeysRequestBuilder builds a request to get account keys
+type AccountKeysRequestBuilder struct {
+	service *Service
+	address string
+	revoked *bool
+}
+
+// GetAccountKeys creates a new account keys request builder. Keys are read
+// from the GetAccount response, so no separate endpoint is needed.
+func (s *Service) GetAccountKeys() *AccountKeysRequestBuilder {
+	return &AccountKeysRequestBuilder{service: s}
+}
+
+// Clone returns an independent copy of the account keys request builder
+func (b *AccountKeysRequestBuilder) Clone() *AccountKeysRequestBuilder {
+	c := *b
+	return &c
+}
+
+// Address sets the account address (required)
+func (b *AccountKeysRequestBuilder) Address(address string) *AccountKeysRequestBuilder {
+	b.address = address
+	return b
+}
+
+// Revoked filters keys by whether they are revoked (optional, default all keys)
+func (b *AccountKeysRequestBuilder) Revoked(revoked bool) *AccountKeysRequestBuilder {
+	b.revoked = &revoked
+	return b
+}
+
+// Validate checks the account keys request without making a network call
+func (b *AccountKeysRequestBuilder) Validate() error {
+	if b.address == "" {
+		return fmt.Errorf("account address is required")
+	}
+	return validateAddress(b.address)
+}
+
+// Do executes the account keys request
+func (b *AccountKeysRequestBuilder) Do(ctx context.Context) (*AccountKeysResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	accountResp, err := b.service.GetAccount().Address(b.address).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keysResp := AccountKeysResponse{Data: []KeyInfo{}}
+	for _, account := range accountResp.Data {
+		for _, key := range account.Keys {
+			if b.revoked == nil || key.Revoked == *b.revoked {
+				keysResp.Data = append(keysResp.Data, key)
+			}
+		}
+	}
+
+	return &keysResp, nil
+}
+
 // AccountFTsRequestBuilder builds a request to get account FT collections
 type AccountFTsRequestBuilder struct {
 	service *Service
@@ -276,6 +352,12 @@ func (s *Service) GetAccountFTs() *AccountFTsRequestBuilder {
 	return &AccountFTsRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the account FT collections request builder
+func (b *AccountFTsRequestBuilder) Clone() *AccountFTsRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Address sets the account address (required)
 func (b *AccountFTsRequestBuilder) Address(address string) *AccountFTsRequestBuilder {
 	b.address = address
@@ -294,10 +376,21 @@ func (b *AccountFTsRequestBuilder) Offset(offset int) *AccountFTsRequestBuilder
 	return b
 }
 
+// Validate checks the account FT collections request without making a network call
+func (b *AccountFTsRequestBuilder) Validate() error {
+	if b.address == "" {
+		return fmt.Errorf("account address is required")
+	}
+	if err := validateAddress(b.address); err != nil {
+		return err
+	}
+	return validatePage(b.limit, b.offset)
+}
+
 // Do executes the account FT collections request
 func (b *AccountFTsRequestBuilder) Do(ctx context.Context) (*AccountFTCollectionsResponse, error) {
-	if b.address == "" {
-		return nil, fmt.Errorf("account address is required")
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	query := url.Values{}
@@ -318,6 +411,7 @@ func (b *AccountFTsRequestBuilder) Do(ctx context.Context) (*AccountFTCollection
 	if err := b.service.client.DecodeResponse(resp, &collectionsResp); err != nil {
 		return nil, err
 	}
+	collectionsResp.Data = emptyIfNil(collectionsResp.Data)
 
 	return &collectionsResp, nil
 }
@@ -335,6 +429,12 @@ func (s *Service) GetAccountFTHoldings() *AccountFTHoldingsRequestBuilder {
 	return &AccountFTHoldingsRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the account FT holdings request builder
+func (b *AccountFTHoldingsRequestBuilder) Clone() *AccountFTHoldingsRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Address sets the account address (required)
 func (b *AccountFTHoldingsRequestBuilder) Address(address string) *AccountFTHoldingsRequestBuilder {
 	b.address = address
@@ -353,10 +453,21 @@ func (b *AccountFTHoldingsRequestBuilder) Offset(offset int) *AccountFTHoldingsR
 	return b
 }
 
+// Validate checks the account FT holdings request without making a network call
+func (b *AccountFTHoldingsRequestBuilder) Validate() error {
+	if b.address == "" {
+		return fmt.Errorf("account address is required")
+	}
+	if err := validateAddress(b.address); err != nil {
+		return err
+	}
+	return validatePage(b.limit, b.offset)
+}
+
 // Do executes the account FT holdings request
 func (b *AccountFTHoldingsRequestBuilder) Do(ctx context.Context) (*FTHoldingResponse, error) {
-	if b.address == "" {
-		return nil, fmt.Errorf("account address is required")
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	query := url.Values{}
@@ -377,17 +488,26 @@ func (b *AccountFTHoldingsRequestBuilder) Do(ctx context.Context) (*FTHoldingRes
 	if err := b.service.client.DecodeResponse(resp, &holdingsResp); err != nil {
 		return nil, err
 	}
+	holdingsResp.Data = emptyIfNil(holdingsResp.Data)
 
 	return &holdingsResp, nil
 }
 
 // AccountFTTransfersRequestBuilder builds a request to get account FT transfers
 type AccountFTTransfersRequestBuilder struct {
-	service *Service
-	address string
-	height  *uint64
-	limit   *int
-	offset  *int
+	service   *Service
+	address   string
+	sender    *string
+	receiver  *string
+	direction *string
+	minAmount *float64
+	maxAmount *float64
+	height    *uint64
+	from      *string
+	to        *string
+	limit     *int
+	offset    *int
+	filterErr error
 }
 
 // GetAccountFTTransfers creates a new account FT transfers request builder
@@ -395,12 +515,75 @@ func (s *Service) GetAccountFTTransfers() *AccountFTTransfersRequestBuilder {
 	return &AccountFTTransfersRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the account FT transfers request builder
+func (b *AccountFTTransfersRequestBuilder) Clone() *AccountFTTransfersRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Address sets the account address (required)
 func (b *AccountFTTransfersRequestBuilder) Address(address string) *AccountFTTransfersRequestBuilder {
 	b.address = address
 	return b
 }
 
+// Sender limits results to transfers sent by an address (optional)
+func (b *AccountFTTransfersRequestBuilder) Sender(sender string) *AccountFTTransfersRequestBuilder {
+	b.sender = &sender
+	return b
+}
+
+// Receiver limits results to transfers received by an address (optional)
+func (b *AccountFTTransfersRequestBuilder) Receiver(receiver string) *AccountFTTransfersRequestBuilder {
+	b.receiver = &receiver
+	return b
+}
+
+// Direction limits transfers to those received (DirectionIn) or sent
+// (DirectionOut) by the account (optional)
+func (b *AccountFTTransfersRequestBuilder) Direction(direction string) *AccountFTTransfersRequestBuilder {
+	b.direction = &direction
+	return b
+}
+
+// MinAmount sets the inclusive lower bound on the transferred amount (optional)
+func (b *AccountFTTransfersRequestBuilder) MinAmount(amount float64) *AccountFTTransfersRequestBuilder {
+	b.minAmount = &amount
+	return b
+}
+
+// MaxAmount sets the inclusive upper bound on the transferred amount (optional)
+func (b *AccountFTTransfersRequestBuilder) MaxAmount(amount float64) *AccountFTTransfersRequestBuilder {
+	b.maxAmount = &amount
+	return b
+}
+
+// From sets the start time filter as an RFC 3339 timestamp (optional)
+func (b *AccountFTTransfersRequestBuilder) From(from string) *AccountFTTransfersRequestBuilder {
+	b.from = &from
+	return b
+}
+
+// FromTime sets the start time filter (optional)
+func (b *AccountFTTransfersRequestBuilder) FromTime(from time.Time) *AccountFTTransfersRequestBuilder {
+	s := filter.FormatTime(from)
+	b.from = &s
+	return b
+}
+
+// To sets the end time filter as an RFC 3339 timestamp (optional)
+func (b *AccountFTTransfersRequestBuilder) To(to string) *AccountFTTransfersRequestBuilder {
+	b.to = &to
+	return b
+}
+
+// ToTime sets the end time filter (optional)
+func (b *AccountFTTransfersRequestBuilder) ToTime(to time.Time) *AccountFTTransfersRequestBuilder {
+	s := filter.FormatTime(to)
+	b.to = &s
+	return b
+}
+
 // Height sets the block height filter (optional)
 func (b *AccountFTTransfersRequestBuilder) Height(height uint64) *AccountFTTransfersRequestBuilder {
 	b.height = &height
@@ -419,24 +602,75 @@ func (b *AccountFTTransfersRequestBuilder) Offset(offset int) *AccountFTTransfer
 	return b
 }
 
-// Do executes the account FT transfers request
-func (b *AccountFTTransfersRequestBuilder) Do(ctx context.Context) (*TransfersResponse, error) {
+// Apply applies reusable filters: address, height, time range and pagination (optional)
+func (b *AccountFTTransfersRequestBuilder) Apply(filters ...filter.Filter) *AccountFTTransfersRequestBuilder {
+	p := filter.Collect(filters...)
+	if err := p.Unsupported("GetAccountFTTransfers", filter.FieldHeightRange, filter.FieldTimeRange, filter.FieldAddress, filter.FieldPagination); err != nil {
+		b.filterErr = err
+		return b
+	}
+	if err := applySingleHeight(p, "GetAccountFTTransfers", &b.height); err != nil {
+		b.filterErr = err
+		return b
+	}
+	if p.Address != nil {
+		b.address = *p.Address
+	}
+	applyTimeRange(p, &b.from, &b.to)
+	applyPagination(p, &b.limit, &b.offset)
+	return b
+}
+
+// Validate checks the account FT transfers request without making a network call
+func (b *AccountFTTransfersRequestBuilder) Validate() error {
+	if b.filterErr != nil {
+		return b.filterErr
+	}
 	if b.address == "" {
-		return nil, fmt.Errorf("account address is required")
+		return fmt.Errorf("account address is required")
+	}
+	if err := validateAddress(b.address); err != nil {
+		return err
+	}
+	if err := b.clientFilter().validate(); err != nil {
+		return err
+	}
+	return validatePage(b.limit, b.offset)
+}
+
+// clientFilter returns the filters the transfers endpoint does not support
+func (b *AccountFTTransfersRequestBuilder) clientFilter() transferFilter {
+	return transferFilter{
+		account:   b.address,
+		sender:    b.sender,
+		receiver:  b.receiver,
+		direction: b.direction,
+		minAmount: b.minAmount,
+		maxAmount: b.maxAmount,
+		from:      b.from,
+		to:        b.to,
+	}
+}
+
+// Do executes the account FT transfers request. Sender, Receiver, Direction,
+// the amount range and the time range are applied on the client (see
+// transferFilter).
+func (b *AccountFTTransfersRequestBuilder) Do(ctx context.Context) (*TransfersResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	query := url.Values{}
 	if b.height != nil {
 		query.Set("height", strconv.FormatUint(*b.height, 10))
 	}
-	if b.limit != nil {
-		query.Set("limit", strconv.Itoa(*b.limit))
-	}
-	if b.offset != nil {
-		query.Set("offset", strconv.Itoa(*b.offset))
-	}
 
 	path := fmt.Sprintf("/flow/v1/account/%s/ft/transfer", b.address)
+	if f := b.clientFilter(); f.active() {
+		return filterPages(ctx, b.service.client, path, query, b.limit, b.offset, f.matchFT)
+	}
+
+	setPage(query, b.limit, b.offset)
 	resp, err := b.service.client.DoRequest(ctx, http.MethodGet, path, query)
 	if err != nil {
 		return nil, err
@@ -446,6 +680,7 @@ func (b *AccountFTTransfersRequestBuilder) Do(ctx context.Context) (*TransfersRe
 	if err := b.service.client.DecodeResponse(resp, &transfersResp); err != nil {
 		return nil, err
 	}
+	transfersResp.Data = emptyIfNil(transfersResp.Data)
 
 	return &transfersResp, nil
 }
@@ -464,6 +699,12 @@ func (s *Service) GetAccountFTToken() *AccountFTTokenRequestBuilder {
 	return &AccountFTTokenRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the account FT token request builder
+func (b *AccountFTTokenRequestBuilder) Clone() *AccountFTTokenRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Address sets the account address (required)
 func (b *AccountFTTokenRequestBuilder) Address(address string) *AccountFTTokenRequestBuilder {
 	b.address = address
@@ -488,13 +729,24 @@ func (b *AccountFTTokenRequestBuilder) Offset(offset int) *AccountFTTokenRequest
 	return b
 }
 
-// Do executes the account FT token request
-func (b *AccountFTTokenRequestBuilder) Do(ctx context.Context) (*AccountFungibleTokenResponse, error) {
+// Validate checks the account FT token request without making a network call
+func (b *AccountFTTokenRequestBuilder) Validate() error {
 	if b.address == "" {
-		return nil, fmt.Errorf("account address is required")
+		return fmt.Errorf("account address is required")
 	}
 	if b.token == "" {
-		return nil, fmt.Errorf("token identifier is required")
+		return fmt.Errorf("token identifier is required")
+	}
+	if err := validateAddress(b.address); err != nil {
+		return err
+	}
+	return validatePage(b.limit, b.offset)
+}
+
+// Do executes the account FT token request
+func (b *AccountFTTokenRequestBuilder) Do(ctx context.Context) (*AccountFungibleTokenResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	query := url.Values{}
@@ -515,18 +767,20 @@ func (b *AccountFTTokenRequestBuilder) Do(ctx context.Context) (*AccountFungible
 	if err := b.service.client.DecodeResponse(resp, &tokenResp); err != nil {
 		return nil, err
 	}
+	tokenResp.Data = emptyIfNil(tokenResp.Data)
 
 	return &tokenResp, nil
 }
 
 // AccountFTTokenTransfersRequestBuilder builds a request to get account's specific token transfers
 type AccountFTTokenTransfersRequestBuilder struct {
-	service *Service
-	address string
-	token   string
-	height  *uint64
-	limit   *int
-	offset  *int
+	service   *Service
+	address   string
+	token     string
+	height    *uint64
+	limit     *int
+	offset    *int
+	filterErr error
 }
 
 // GetAccountFTTokenTransfers creates a new account FT token transfers request builder
@@ -534,6 +788,12 @@ func (s *Service) GetAccountFTTokenTransfers() *AccountFTTokenTransfersRequestBu
 	return &AccountFTTokenTransfersRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the account FT token transfers request builder
+func (b *AccountFTTokenTransfersRequestBuilder) Clone() *AccountFTTokenTransfersRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Address sets the account address (required)
 func (b *AccountFTTokenTransfersRequestBuilder) Address(address string) *AccountFTTokenTransfersRequestBuilder {
 	b.address = address
@@ -564,13 +824,45 @@ func (b *AccountFTTokenTransfersRequestBuilder) Offset(offset int) *AccountFTTok
 	return b
 }
 
-// Do executes the account FT token transfers request
-func (b *AccountFTTokenTransfersRequestBuilder) Do(ctx context.Context) (*TransfersResponse, error) {
+// Apply applies reusable filters: address, height and pagination (optional)
+func (b *AccountFTTokenTransfersRequestBuilder) Apply(filters ...filter.Filter) *AccountFTTokenTransfersRequestBuilder {
+	p := filter.Collect(filters...)
+	if err := p.Unsupported("GetAccountFTTokenTransfers", filter.FieldHeightRange, filter.FieldAddress, filter.FieldPagination); err != nil {
+		b.filterErr = err
+		return b
+	}
+	if err := applySingleHeight(p, "GetAccountFTTokenTransfers", &b.height); err != nil {
+		b.filterErr = err
+		return b
+	}
+	if p.Address != nil {
+		b.address = *p.Address
+	}
+	applyPagination(p, &b.limit, &b.offset)
+	return b
+}
+
+// Validate checks the account FT token transfers request without making a network call
+func (b *AccountFTTokenTransfersRequestBuilder) Validate() error {
+	if b.filterErr != nil {
+		return b.filterErr
+	}
 	if b.address == "" {
-		return nil, fmt.Errorf("account address is required")
+		return fmt.Errorf("account address is required")
 	}
 	if b.token == "" {
-		return nil, fmt.Errorf("token identifier is required")
+		return fmt.Errorf("token identifier is required")
+	}
+	if err := validateAddress(b.address); err != nil {
+		return err
+	}
+	return validatePage(b.limit, b.offset)
+}
+
+// Do executes the account FT token transfers request
+func (b *AccountFTTokenTransfersRequestBuilder) Do(ctx context.Context) (*TransfersResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	query := url.Values{}
@@ -594,6 +886,7 @@ func (b *AccountFTTokenTransfersRequestBuilder) Do(ctx context.Context) (*Transf
 	if err := b.service.client.DecodeResponse(resp, &transfersResp); err != nil {
 		return nil, err
 	}
+	transfersResp.Data = emptyIfNil(transfersResp.Data)
 
 	return &transfersResp, nil
 }
@@ -612,6 +905,12 @@ func (s *Service) GetAccountTaxReport() *AccountTaxReportRequestBuilder {
 	return &AccountTaxReportRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the account tax report request builder
+func (b *AccountTaxReportRequestBuilder) Clone() *AccountTaxReportRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Address sets the account address (required)
 func (b *AccountTaxReportRequestBuilder) Address(address string) *AccountTaxReportRequestBuilder {
 	b.address = address
@@ -636,10 +935,21 @@ func (b *AccountTaxReportRequestBuilder) Offset(offset int) *AccountTaxReportReq
 	return b
 }
 
+// Validate checks the account tax report request without making a network call
+func (b *AccountTaxReportRequestBuilder) Validate() error {
+	if b.address == "" {
+		return fmt.Errorf("account address is required")
+	}
+	if err := validateAddress(b.address); err != nil {
+		return err
+	}
+	return validatePage(b.limit, b.offset)
+}
+
 // Do executes the account tax report request
 func (b *AccountTaxReportRequestBuilder) Do(ctx context.Context) (*TaxReportResponse, error) {
-	if b.address == "" {
-		return nil, fmt.Errorf("account address is required")
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	query := url.Values{}
@@ -663,6 +973,7 @@ func (b *AccountTaxReportRequestBuilder) Do(ctx context.Context) (*TaxReportResp
 	if err := b.service.client.DecodeResponse(resp, &taxResp); err != nil {
 		return nil, err
 	}
+	taxResp.Data = emptyIfNil(taxResp.Data)
 
 	return &taxResp, nil
 }
@@ -678,6 +989,7 @@ type AccountTransactionsRequestBuilder struct {
 	active        *bool
 	from          *string
 	to            *string
+	filterErr     error
 }
 
 // GetAccountTransactions creates a new account transactions request builder
@@ -685,6 +997,12 @@ func (s *Service) GetAccountTransactions() *AccountTransactionsRequestBuilder {
 	return &AccountTransactionsRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the account transactions request builder
+func (b *AccountTransactionsRequestBuilder) Clone() *AccountTransactionsRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Address sets the account address (required)
 func (b *AccountTransactionsRequestBuilder) Address(address string) *AccountTransactionsRequestBuilder {
 	b.address = address
@@ -721,22 +1039,69 @@ func (b *AccountTransactionsRequestBuilder) Active(active bool) *AccountTransact
 	return b
 }
 
-// From sets the start time filter (optional)
+// From sets the start time filter as a raw query value (optional)
 func (b *AccountTransactionsRequestBuilder) From(from string) *AccountTransactionsRequestBuilder {
 	b.from = &from
 	return b
 }
 
-// To sets the end time filter (optional)
+// FromTime sets the start time filter (optional)
+func (b *AccountTransactionsRequestBuilder) FromTime(from time.Time) *AccountTransactionsRequestBuilder {
+	s := filter.FormatTime(from)
+	b.from = &s
+	return b
+}
+
+// To sets the end time filter as a raw query value (optional)
 func (b *AccountTransactionsRequestBuilder) To(to string) *AccountTransactionsRequestBuilder {
 	b.to = &to
 	return b
 }
 
+// ToTime sets the end time filter (optional)
+func (b *AccountTransactionsRequestBuilder) ToTime(to time.Time) *AccountTransactionsRequestBuilder {
+	s := filter.FormatTime(to)
+	b.to = &s
+	return b
+}
+
+// Apply applies reusable filters: address, height, time range and pagination (optional)
+func (b *AccountTransactionsRequestBuilder) Apply(filters ...filter.Filter) *AccountTransactionsRequestBuilder {
+	p := filter.Collect(filters...)
+	if err := p.Unsupported("GetAccountTransactions", filter.FieldHeightRange, filter.FieldTimeRange, filter.FieldAddress, filter.FieldPagination); err != nil {
+		b.filterErr = err
+		return b
+	}
+	if err := applySingleHeight(p, "GetAccountTransactions", &b.height); err != nil {
+		b.filterErr = err
+		return b
+	}
+	if p.Address != nil {
+		b.address = *p.Address
+	}
+	applyTimeRange(p, &b.from, &b.to)
+	applyPagination(p, &b.limit, &b.offset)
+	return b
+}
+
+// Validate checks the account transactions request without making a network call
+func (b *AccountTransactionsRequestBuilder) Validate() error {
+	if b.filterErr != nil {
+		return b.filterErr
+	}
+	if b.address == "" {
+		return fmt.Errorf("account address is required")
+	}
+	if err := validateAddress(b.address); err != nil {
+		return err
+	}
+	return validatePage(b.limit, b.offset)
+}
+
 // Do executes the account transactions request
 func (b *AccountTransactionsRequestBuilder) Do(ctx context.Context) (*AccountTransactionsResponse, error) {
-	if b.address == "" {
-		return nil, fmt.Errorf("account address is required")
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	query := url.Values{}
@@ -772,6 +1137,7 @@ func (b *AccountTransactionsRequestBuilder) Do(ctx context.Context) (*AccountTra
 	if err := b.service.client.DecodeResponse(resp, &txResp); err != nil {
 		return nil, err
 	}
+	txResp.Data = emptyIfNil(txResp.Data)
 
 	return &txResp, nil
 }
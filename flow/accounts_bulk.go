@@ -0,0 +1,74 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// accountsConcurrency is the number of account lookups made in parallel
+const accountsConcurrency = 8
+
+// GetAccountsByAddresses fetches the details of several accounts. The API has
+// no batch endpoint, so lookups fan out with bounded concurrency; they share
+// the client's rate limit backoff, so a 429 slows every worker rather than
+// failing the batch. Results are keyed by address as given, and errs holds
+// the failure for each address that could not be fetched (nil if none
+// failed). Duplicate addresses are fetched once.
+func (s *Service) GetAccountsByAddresses(ctx context.Context, addresses []string) (accounts map[string]*CombinedAccountDetails, errs map[string]error) {
+	accounts = make(map[string]*CombinedAccountDetails, len(addresses))
+
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		sem  = make(chan struct{}, accountsConcurrency)
+		seen = make(map[string]bool, len(addresses))
+	)
+	fail := func(address string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if errs == nil {
+			errs = make(map[string]error)
+		}
+		errs[address] = err
+	}
+
+	for _, address := range addresses {
+		if seen[address] {
+			continue
+		}
+		seen[address] = true
+
+		if err := validateAddress(address); err != nil {
+			fail(address, err)
+			continue
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			fail(address, ctx.Err())
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			resp, err := s.GetAccount().Address(address).Do(ctx)
+			switch {
+			case err != nil:
+				fail(address, err)
+			case len(resp.Data) == 0:
+				fail(address, fmt.Errorf("account %s not found", address))
+			default:
+				mu.Lock()
+				accounts[address] = &resp.Data[0]
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return accounts, errs
+}
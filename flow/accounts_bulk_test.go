@@ -0,0 +1,63 @@
+package flow
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestFlowService_GetAccountsByAddresses(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		switch strings.TrimPrefix(r.URL.Path, "/flow/v1/account/") {
+		case "0x1654653399040a61":
+			w.Write([]byte(`{"data":[{"address":"0x1654653399040a61","flowBalance":12.5}]}`))
+		case "0xf233dcee88fe0abe":
+			w.Write([]byte(`{"data":[{"address":"0xf233dcee88fe0abe","flowBalance":3}]}`))
+		case "0x0000000000000001":
+			w.Write([]byte(`{"data":[]}`))
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := &mockClient{server: server}
+	service := NewService(client)
+
+	accounts, errs := service.GetAccountsByAddresses(context.Background(), []string{
+		"0x1654653399040a61",
+		"0xf233dcee88fe0abe",
+		"0x1654653399040a61",
+		"0x0000000000000001",
+		"0xnothex",
+	})
+
+	if len(accounts) != 2 {
+		t.Fatalf("Expected 2 accounts, got %d", len(accounts))
+	}
+	if got := accounts["0x1654653399040a61"].FlowBalance; got != 12.5 {
+		t.Errorf("Expected balance 12.5, got %v", got)
+	}
+	if got := accounts["0xf233dcee88fe0abe"].Address; got != "0xf233dcee88fe0abe" {
+		t.Errorf("Expected address 0xf233dcee88fe0abe, got %s", got)
+	}
+
+	if len(errs) != 2 {
+		t.Fatalf("Expected 2 errors, got %v", errs)
+	}
+	if err := errs["0x0000000000000001"]; err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+	if err := errs["0xnothex"]; err == nil || !strings.Contains(err.Error(), "invalid account address") {
+		t.Errorf("Expected invalid address error, got %v", err)
+	}
+	if got := requests.Load(); got != 3 {
+		t.Errorf("Expected 3 requests, got %d", got)
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"sync"
 	"testing"
 )
 
@@ -156,6 +158,49 @@ func TestFlowService_GetAccountFTs(t *testing.T) {
 	}
 }
 
+func TestFlowService_GetAccountKeys(t *testing.T) {
+	address := "0x1234"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		expectedPath := fmt.Sprintf("/flow/v1/account/%s", address)
+		if r.URL.Path != expectedPath {
+			t.Errorf("Expected path %s, got %s", expectedPath, r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[{"address":"0x1234","keys":[
+			{"index":"0","key":"abcd","weight":1000,"revoked":false},
+			{"index":"1","key":"ef01","weight":1000,"revoked":true}
+		]}]}`))
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+
+	result, err := service.GetAccountKeys().Address(address).Revoked(false).Do(context.Background())
+	if err != nil {
+		t.Fatalf("GetAccountKeys failed: %v", err)
+	}
+	if len(result.Data) != 1 {
+		t.Fatalf("Expected 1 key, got %d", len(result.Data))
+	}
+	if key := result.Data[0]; key.Key != "abcd" || key.Weight != 1000 {
+		t.Errorf("Expected key abcd with weight 1000, got %s and %d", key.Key, key.Weight)
+	}
+
+	result, err = service.GetAccountKeys().Address(address).Do(context.Background())
+	if err != nil {
+		t.Fatalf("GetAccountKeys failed: %v", err)
+	}
+	if len(result.Data) != 2 {
+		t.Errorf("Expected 2 keys, got %d", len(result.Data))
+	}
+
+	if _, err := service.GetAccountKeys().Do(context.Background()); err == nil {
+		t.Error("Expected error for missing address")
+	}
+}
+
 func TestFlowService_GetAccountFTHoldings(t *testing.T) {
 	address := "0x1234"
 
@@ -300,6 +345,53 @@ func TestFlowService_GetAccountFTToken(t *testing.T) {
 	}
 }
 
+func TestFlowService_GetAccountFTTransfersFilters(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		query := r.URL.Query()
+		for _, param := range []string{"sender", "receiver", "direction", "min_amount", "max_amount", "from", "to"} {
+			if query.Has(param) {
+				t.Errorf("Expected %s to be filtered on the client, got %s", param, query.Get(param))
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[
+			{"amount":1000,"sender":"0xf233dcee88fe0abe","receiver":"0x1654653399040a61"},
+			{"amount":100,"sender":"0xf233dcee88fe0abe","receiver":"0x1654653399040a61"},
+			{"amount":1000,"sender":"0x1654653399040a61","receiver":"0xf233dcee88fe0abe"}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := &mockClient{server: server}
+	service := NewService(client)
+
+	result, err := service.GetAccountFTTransfers().
+		Address("0x1654653399040a61").
+		Direction(DirectionIn).
+		Sender("0xf233dcee88fe0abe").
+		MinAmount(500).
+		Do(context.Background())
+	if err != nil {
+		t.Fatalf("GetAccountFTTransfers failed: %v", err)
+	}
+	if len(result.Data) != 1 || result.Data[0].Amount != 1000 || result.Data[0].Receiver != "0x1654653399040a61" {
+		t.Errorf("Expected one incoming transfer of 1000, got %+v", result.Data)
+	}
+
+	// Without client-side filters the page is requested directly
+	requests = 0
+	result, err = service.GetAccountFTTransfers().Address("0x1654653399040a61").Limit(3).Do(context.Background())
+	if err != nil {
+		t.Fatalf("GetAccountFTTransfers failed: %v", err)
+	}
+	if requests != 1 || len(result.Data) != 3 {
+		t.Errorf("Expected 3 transfers from 1 request, got %d from %d", len(result.Data), requests)
+	}
+}
+
 func TestFlowService_GetAccountFTTokenTransfers(t *testing.T) {
 	address := "0x1234"
 	token := "A.1654653399040a61.FlowToken.Vault"
@@ -484,3 +576,45 @@ func TestFlowService_AccountRequiredFields(t *testing.T) {
 }
 
 // mockClient is defined in ft_test.go
+
+func TestFlowService_AccountFTTransfersClone(t *testing.T) {
+	address := "0x1654653399040a61"
+	var (
+		mu      sync.Mutex
+		offsets []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if limit := r.URL.Query().Get("limit"); limit != "100" {
+			t.Errorf("Expected limit 100, got %s", limit)
+		}
+		mu.Lock()
+		offsets = append(offsets, r.URL.Query().Get("offset"))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(TransfersResponse{})
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	template := service.GetAccountFTTransfers().Address(address).Limit(100)
+
+	var wg sync.WaitGroup
+	for i := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := template.Clone().Offset(i * 100).Do(context.Background()); err != nil {
+				t.Errorf("GetAccountFTTransfers failed: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	slices.Sort(offsets)
+	if want := []string{"0", "100", "200", "300"}; !slices.Equal(offsets, want) {
+		t.Errorf("Expected offsets %v, got %v", want, offsets)
+	}
+	if template.offset != nil {
+		t.Errorf("Expected template offset to stay unset, got %d", *template.offset)
+	}
+}
@@ -0,0 +1,423 @@
+package flow
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// cadenceNumberRe matches the Cadence integer and fixed-point types, whose
+// JSON-Cadence values are decimal strings
+var cadenceNumberRe = regexp.MustCompile(`^(U?Int\d*|Word\d+|U?Fix\d+)$`)
+
+var bigIntType = reflect.TypeOf(big.Int{})
+
+// DecodeArguments decodes the transaction's Cadence arguments positionally
+// into targets, e.g. tx.DecodeArguments(&amount, &recipient). Targets must be
+// pointers; a nil target skips its argument and trailing arguments may be
+// left out.
+func (t *TransactionDetails) DecodeArguments(targets ...any) error {
+	return DecodeArguments(t.Argument, targets...)
+}
+
+// DecodeArguments decodes JSON-Cadence arguments positionally into targets.
+// See ArgumentItem.Decode for the supported conversions.
+func DecodeArguments(args []ArgumentItem, targets ...any) error {
+	if len(targets) > len(args) {
+		return fmt.Errorf("%d targets for %d arguments", len(targets), len(args))
+	}
+	for i, target := range targets {
+		if target == nil {
+			continue
+		}
+		if err := args[i].Decode(target); err != nil {
+			return fmt.Errorf("argument %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// Decode decodes a JSON-Cadence argument into v, which must be a pointer.
+//
+// Integer and fixed-point values (UFix64, UInt64, Int256, ...) decode into Go
+// integers and floats with range checks, *big.Int, or strings and Number to
+// keep their exact text. Address, String and Character decode into strings,
+// and Path and Type values into their string form. Arrays decode into slices
+// or arrays, dictionaries into maps, optionals into pointers or zero values,
+// and structs, resources, events and enums into structs whose json tags (or
+// field names) match the Cadence field names, or into map[string]T. An
+// interface{} target receives plain Go values: strings for numbers, []any,
+// map[string]any and nil.
+func (a ArgumentItem) Decode(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("decode target must be a non-nil pointer, got %T", v)
+	}
+	return decodeCadence(a.Type, a.Value, rv.Elem())
+}
+
+// decodeCadence assigns a JSON-Cadence value of a type to dst
+func decodeCadence(typ string, value any, dst reflect.Value) error {
+	if dst.Kind() == reflect.Interface && dst.NumMethod() == 0 {
+		plain, err := plainCadence(typ, value)
+		if err != nil {
+			return err
+		}
+		if plain == nil {
+			dst.SetZero()
+		} else {
+			dst.Set(reflect.ValueOf(plain))
+		}
+		return nil
+	}
+
+	if value == nil {
+		dst.SetZero()
+		return nil
+	}
+	if typ == "Optional" {
+		inner, err := cadenceItem(value)
+		if err != nil {
+			return err
+		}
+		return decodeCadence(inner.Type, inner.Value, dst)
+	}
+
+	if dst.Kind() == reflect.Pointer && dst.Type().Elem() != bigIntType {
+		elem := reflect.New(dst.Type().Elem())
+		if err := decodeCadence(typ, value, elem.Elem()); err != nil {
+			return err
+		}
+		dst.Set(elem)
+		return nil
+	}
+
+	switch {
+	case cadenceNumberRe.MatchString(typ):
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%s value %v is not a string", typ, value)
+		}
+		return decodeCadenceNumber(typ, s, dst)
+
+	case typ == "Array":
+		return decodeCadenceArray(value, dst)
+
+	case typ == "Dictionary":
+		return decodeCadenceDictionary(value, dst)
+
+	case isCadenceComposite(value):
+		return decodeCadenceComposite(typ, value, dst)
+
+	case typ == "Bool":
+		b, ok := value.(bool)
+		if !ok || dst.Kind() != reflect.Bool {
+			return cadenceMismatch(typ, dst)
+		}
+		dst.SetBool(b)
+		return nil
+	}
+
+	plain, err := plainCadence(typ, value)
+	if err != nil {
+		return err
+	}
+	s, ok := plain.(string)
+	if !ok || dst.Kind() != reflect.String {
+		return cadenceMismatch(typ, dst)
+	}
+	dst.SetString(s)
+	return nil
+}
+
+// decodeCadenceNumber parses the decimal text of a Cadence number into dst
+func decodeCadenceNumber(typ, s string, dst reflect.Value) error {
+	if dst.Type() == bigIntType || (dst.Kind() == reflect.Pointer && dst.Type().Elem() == bigIntType) {
+		n, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			return fmt.Errorf("%s value %q is not an integer", typ, s)
+		}
+		if dst.Kind() == reflect.Pointer {
+			dst.Set(reflect.ValueOf(n))
+		} else {
+			dst.Set(reflect.ValueOf(n).Elem())
+		}
+		return nil
+	}
+
+	switch dst.Kind() {
+	case reflect.String:
+		dst.SetString(s)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, dst.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%s value %q: %w", typ, s, err)
+		}
+		dst.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, dst.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%s value %q: %w", typ, s, err)
+		}
+		dst.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, dst.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%s value %q: %w", typ, s, err)
+		}
+		dst.SetFloat(f)
+	default:
+		return cadenceMismatch(typ, dst)
+	}
+	return nil
+}
+
+// decodeCadenceArray decodes the elements of a Cadence array into a slice or array
+func decodeCadenceArray(value any, dst reflect.Value) error {
+	elems, ok := value.([]any)
+	if !ok {
+		return fmt.Errorf("Array value %v is not a list", value)
+	}
+
+	switch dst.Kind() {
+	case reflect.Slice:
+		dst.Set(reflect.MakeSlice(dst.Type(), len(elems), len(elems)))
+	case reflect.Array:
+		if dst.Len() != len(elems) {
+			return fmt.Errorf("cannot decode %d elements into %s", len(elems), dst.Type())
+		}
+	default:
+		return cadenceMismatch("Array", dst)
+	}
+
+	for i, e := range elems {
+		item, err := cadenceItem(e)
+		if err != nil {
+			return err
+		}
+		if err := decodeCadence(item.Type, item.Value, dst.Index(i)); err != nil {
+			return fmt.Errorf("element %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// decodeCadenceDictionary decodes the entries of a Cadence dictionary into a map
+func decodeCadenceDictionary(value any, dst reflect.Value) error {
+	entries, ok := value.([]any)
+	if !ok {
+		return fmt.Errorf("Dictionary value %v is not a list", value)
+	}
+	if dst.Kind() != reflect.Map {
+		return cadenceMismatch("Dictionary", dst)
+	}
+
+	dst.Set(reflect.MakeMapWithSize(dst.Type(), len(entries)))
+	for _, e := range entries {
+		entry, ok := e.(map[string]any)
+		if !ok {
+			return fmt.Errorf("Dictionary entry %v is not an object", e)
+		}
+		key, err := cadenceItem(entry["key"])
+		if err != nil {
+			return err
+		}
+		val, err := cadenceItem(entry["value"])
+		if err != nil {
+			return err
+		}
+
+		k := reflect.New(dst.Type().Key()).Elem()
+		if err := decodeCadence(key.Type, key.Value, k); err != nil {
+			return fmt.Errorf("key: %w", err)
+		}
+		v := reflect.New(dst.Type().Elem()).Elem()
+		if err := decodeCadence(val.Type, val.Value, v); err != nil {
+			return fmt.Errorf("value of %v: %w", k, err)
+		}
+		dst.SetMapIndex(k, v)
+	}
+	return nil
+}
+
+// decodeCadenceComposite decodes the fields of a struct, resource, event or
+// enum into a Go struct or a map keyed by field name
+func decodeCadenceComposite(typ string, value any, dst reflect.Value) error {
+	fields, err := cadenceFields(value)
+	if err != nil {
+		return err
+	}
+
+	switch dst.Kind() {
+	case reflect.Map:
+		if dst.Type().Key().Kind() != reflect.String {
+			return cadenceMismatch(typ, dst)
+		}
+		dst.Set(reflect.MakeMapWithSize(dst.Type(), len(fields)))
+		for name, f := range fields {
+			v := reflect.New(dst.Type().Elem()).Elem()
+			if err := decodeCadence(f.Type, f.Value, v); err != nil {
+				return fmt.Errorf("field %s: %w", name, err)
+			}
+			dst.SetMapIndex(reflect.ValueOf(name).Convert(dst.Type().Key()), v)
+		}
+		return nil
+
+	case reflect.Struct:
+		t := dst.Type()
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			if !sf.IsExported() {
+				continue
+			}
+			name := sf.Name
+			if tag, _, _ := strings.Cut(sf.Tag.Get("json"), ","); tag == "-" {
+				continue
+			} else if tag != "" {
+				name = tag
+			}
+			f, ok := fields[name]
+			if !ok {
+				for n, candidate := range fields {
+					if strings.EqualFold(n, name) {
+						f, ok = candidate, true
+						break
+					}
+				}
+			}
+			if !ok {
+				continue
+			}
+			if err := decodeCadence(f.Type, f.Value, dst.Field(i)); err != nil {
+				return fmt.Errorf("field %s: %w", name, err)
+			}
+		}
+		return nil
+	}
+	return cadenceMismatch(typ, dst)
+}
+
+// plainCadence converts a JSON-Cadence value to plain Go values: numbers and
+// addresses as strings, []any, map[string]any and nil
+func plainCadence(typ string, value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	switch {
+	case typ == "Optional":
+		inner, err := cadenceItem(value)
+		if err != nil {
+			return nil, err
+		}
+		return plainCadence(inner.Type, inner.Value)
+
+	case typ == "Array":
+		elems, ok := value.([]any)
+		if !ok {
+			return nil, fmt.Errorf("Array value %v is not a list", value)
+		}
+		out := make([]any, len(elems))
+		for i, e := range elems {
+			item, err := cadenceItem(e)
+			if err != nil {
+				return nil, err
+			}
+			if out[i], err = plainCadence(item.Type, item.Value); err != nil {
+				return nil, err
+			}
+		}
+		return out, nil
+
+	case typ == "Dictionary":
+		var m map[string]any
+		if err := decodeCadenceDictionary(value, reflect.ValueOf(&m).Elem()); err != nil {
+			return nil, err
+		}
+		return m, nil
+
+	case typ == "Path":
+		p, _ := value.(map[string]any)
+		domain, _ := p["domain"].(string)
+		identifier, _ := p["identifier"].(string)
+		return "/" + domain + "/" + identifier, nil
+
+	case typ == "Type":
+		t, _ := value.(map[string]any)
+		switch static := t["staticType"].(type) {
+		case string:
+			return static, nil
+		case map[string]any:
+			if id, ok := static["typeID"].(string); ok {
+				return id, nil
+			}
+		}
+		return "", nil
+
+	case isCadenceComposite(value):
+		fields, err := cadenceFields(value)
+		if err != nil {
+			return nil, err
+		}
+		out := make(map[string]any, len(fields))
+		for name, f := range fields {
+			if out[name], err = plainCadence(f.Type, f.Value); err != nil {
+				return nil, err
+			}
+		}
+		return out, nil
+	}
+	return value, nil
+}
+
+// cadenceItem reads a nested {"type", "value"} pair
+func cadenceItem(v any) (ArgumentItem, error) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return ArgumentItem{}, fmt.Errorf("value %v is not a JSON-Cadence object", v)
+	}
+	typ, _ := m["type"].(string)
+	return ArgumentItem{Type: typ, Value: m["value"]}, nil
+}
+
+// isCadenceComposite reports whether a value is a composite's {"id", "fields"} object
+func isCadenceComposite(value any) bool {
+	m, ok := value.(map[string]any)
+	if !ok {
+		return false
+	}
+	_, ok = m["fields"]
+	return ok
+}
+
+// cadenceFields returns a composite's fields by name
+func cadenceFields(value any) (map[string]ArgumentItem, error) {
+	m, _ := value.(map[string]any)
+	list, ok := m["fields"].([]any)
+	if !ok && m["fields"] != nil {
+		return nil, fmt.Errorf("composite fields %v are not a list", m["fields"])
+	}
+
+	fields := make(map[string]ArgumentItem, len(list))
+	for _, f := range list {
+		fm, ok := f.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("composite field %v is not an object", f)
+		}
+		name, _ := fm["name"].(string)
+		item, err := cadenceItem(fm["value"])
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", name, err)
+		}
+		fields[name] = item
+	}
+	return fields, nil
+}
+
+// cadenceMismatch reports a Cadence type that cannot be decoded into dst
+func cadenceMismatch(typ string, dst reflect.Value) error {
+	return fmt.Errorf("cannot decode Cadence %s into %s", typ, dst.Type())
+}
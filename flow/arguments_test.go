@@ -0,0 +1,109 @@
+package flow
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+)
+
+const testArguments = `[
+	{"type": "UFix64", "value": "12.50000000"},
+	{"type": "Address", "value": "0x1654653399040a61"},
+	{"type": "Array", "value": [{"type": "UInt64", "value": "1"}, {"type": "UInt64", "value": "2"}]},
+	{"type": "Dictionary", "value": [{"key": {"type": "String", "value": "a"}, "value": {"type": "Int", "value": "-3"}}]},
+	{"type": "Struct", "value": {"id": "A.1654653399040a61.Market.Listing", "fields": [
+		{"name": "price", "value": {"type": "UFix64", "value": "1.00000000"}},
+		{"name": "seller", "value": {"type": "Address", "value": "0xf233dcee88fe0abe"}},
+		{"name": "tags", "value": {"type": "Array", "value": [{"type": "String", "value": "rare"}]}}
+	]}},
+	{"type": "Optional", "value": null},
+	{"type": "Optional", "value": {"type": "UInt256", "value": "115792089237316195423570985008687907853269984665640564039457584007913129639935"}},
+	{"type": "Path", "value": {"domain": "storage", "identifier": "flowTokenVault"}}
+]`
+
+func TestTransactionDetails_DecodeArguments(t *testing.T) {
+	var tx TransactionDetails
+	if err := json.Unmarshal([]byte(`{"argument":`+testArguments+`}`), &tx); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	type listing struct {
+		Price  float64 `json:"price"`
+		Seller string  `json:"seller"`
+		Tags   []string
+	}
+
+	var (
+		amount  float64
+		to      string
+		ids     []uint64
+		weights map[string]int
+		l       listing
+		missing *string
+		big256  *big.Int
+		path    string
+	)
+	if err := tx.DecodeArguments(&amount, &to, &ids, &weights, &l, &missing, &big256, &path); err != nil {
+		t.Fatalf("DecodeArguments failed: %v", err)
+	}
+
+	if amount != 12.5 {
+		t.Errorf("Expected amount 12.5, got %v", amount)
+	}
+	if to != "0x1654653399040a61" {
+		t.Errorf("Expected address 0x1654653399040a61, got %s", to)
+	}
+	if len(ids) != 2 || ids[1] != 2 {
+		t.Errorf("Expected ids [1 2], got %v", ids)
+	}
+	if weights["a"] != -3 {
+		t.Errorf("Expected weight -3, got %v", weights)
+	}
+	if l.Price != 1 || l.Seller != "0xf233dcee88fe0abe" || len(l.Tags) != 1 || l.Tags[0] != "rare" {
+		t.Errorf("Unexpected listing %+v", l)
+	}
+	if missing != nil {
+		t.Errorf("Expected nil optional, got %v", *missing)
+	}
+	if big256 == nil || big256.BitLen() != 256 {
+		t.Errorf("Expected max UInt256, got %v", big256)
+	}
+	if path != "/storage/flowTokenVault" {
+		t.Errorf("Expected path /storage/flowTokenVault, got %s", path)
+	}
+
+	// nil targets skip arguments; plain values go into interface{}
+	var listingAny any
+	if err := tx.DecodeArguments(nil, nil, nil, nil, &listingAny); err != nil {
+		t.Fatalf("DecodeArguments failed: %v", err)
+	}
+	m, ok := listingAny.(map[string]any)
+	if !ok || m["price"] != "1.00000000" {
+		t.Errorf("Expected plain listing map, got %#v", listingAny)
+	}
+}
+
+func TestTransactionDetails_DecodeArgumentsErrors(t *testing.T) {
+	var tx TransactionDetails
+	if err := json.Unmarshal([]byte(`{"argument":`+testArguments+`}`), &tx); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	var (
+		n     uint8
+		s     string
+		extra = make([]any, len(tx.Argument)+1)
+	)
+	if err := tx.DecodeArguments(&n); err == nil {
+		t.Error("Expected error decoding UFix64 into uint8")
+	}
+	if err := tx.DecodeArguments(nil, nil, &s); err == nil {
+		t.Error("Expected error decoding Array into string")
+	}
+	if err := tx.DecodeArguments(s); err == nil {
+		t.Error("Expected error for non-pointer target")
+	}
+	if err := tx.DecodeArguments(extra...); err == nil {
+		t.Error("Expected error for more targets than arguments")
+	}
+}
@@ -0,0 +1,136 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// BalancePoint is an account's token balance after all transfers at a block height
+type BalancePoint struct {
+	Height    uint64  `json:"height"`
+	Timestamp string  `json:"timestamp"`
+	Balance   float64 `json:"balance"`
+	// Change is the net amount transferred at this height
+	Change    float64 `json:"change"`
+	Transfers int     `json:"transfers"`
+}
+
+// BalanceSeries is a height-indexed balance history for one account and token
+type BalanceSeries struct {
+	Address    string `json:"address"`
+	Token      string `json:"token"`
+	FromHeight uint64 `json:"from_height"`
+	ToHeight   uint64 `json:"to_height"`
+	// Opening is the balance before FromHeight
+	Opening float64 `json:"opening"`
+	// Closing is the balance at ToHeight
+	Closing float64 `json:"closing"`
+	// Points has one entry per height in the range with at least one transfer, ascending
+	Points []BalancePoint `json:"points"`
+}
+
+// BalanceHistory pulls every transfer of token for address and reconstructs the
+// balance at each height between fromHeight and toHeight (inclusive; 0 means no
+// upper bound). Balances are accumulated from the account's first transfer, so
+// the series is only as complete as the transfer history the API holds.
+func (s *Service) BalanceHistory(ctx context.Context, address, token string, fromHeight, toHeight uint64) (*BalanceSeries, error) {
+	if address == "" {
+		return nil, fmt.Errorf("account address is required")
+	}
+	if token == "" {
+		return nil, fmt.Errorf("token is required")
+	}
+	if toHeight != 0 && toHeight < fromHeight {
+		return nil, fmt.Errorf("to height %d is before from height %d", toHeight, fromHeight)
+	}
+
+	transfers, err := collectPages(func(offset int) ([]FTTransfer, error) {
+		resp, err := s.GetAccountFTTokenTransfers().Address(address).Token(token).Limit(maxPageSize).Offset(offset).Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return balanceSeries(address, token, fromHeight, toHeight, transfers), nil
+}
+
+// balanceSeries accumulates transfers in height order into a BalanceSeries
+func balanceSeries(address, token string, fromHeight, toHeight uint64, transfers []FTTransfer) *BalanceSeries {
+	sort.SliceStable(transfers, func(i, j int) bool {
+		return transfers[i].BlockHeight < transfers[j].BlockHeight
+	})
+
+	series := &BalanceSeries{
+		Address:    address,
+		Token:      token,
+		FromHeight: fromHeight,
+		ToHeight:   toHeight,
+		Points:     []BalancePoint{},
+	}
+
+	balance := 0.0
+	for _, t := range transfers {
+		if toHeight != 0 && t.BlockHeight > toHeight {
+			break
+		}
+		change := transferChange(address, t)
+		balance += change
+		if t.BlockHeight < fromHeight {
+			series.Opening = balance
+			continue
+		}
+
+		if n := len(series.Points); n > 0 && series.Points[n-1].Height == t.BlockHeight {
+			p := &series.Points[n-1]
+			p.Balance = balance
+			p.Change += change
+			p.Transfers++
+			continue
+		}
+		series.Points = append(series.Points, BalancePoint{
+			Height:    t.BlockHeight,
+			Timestamp: t.Timestamp,
+			Balance:   balance,
+			Change:    change,
+			Transfers: 1,
+		})
+	}
+	series.Closing = balance
+
+	return series
+}
+
+// transferChange returns the signed amount a transfer moves into address.
+// Sender and receiver are authoritative; Direction is used when neither matches.
+func transferChange(address string, t FTTransfer) float64 {
+	address = normalizeAddress(address)
+	in := normalizeAddress(t.Receiver) == address
+	out := normalizeAddress(t.Sender) == address
+	switch {
+	case in && out:
+		return 0
+	case in:
+		return t.Amount
+	case out:
+		return -t.Amount
+	}
+
+	switch strings.ToLower(t.Direction) {
+	case "in", "deposit", "received":
+		return t.Amount
+	case "out", "withdraw", "withdrawal", "sent":
+		return -t.Amount
+	}
+	return 0
+}
+
+// normalizeAddress lowercases an address and strips its 0x prefix
+func normalizeAddress(address string) string {
+	return strings.TrimPrefix(strings.ToLower(address), "0x")
+}
@@ -0,0 +1,86 @@
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestFlowService_BalanceHistory(t *testing.T) {
+	const (
+		address = "0x1234567890abcdef"
+		token   = "A.1654653399040a61.FlowToken"
+	)
+
+	// Newest first, as returned by the API; 120 transfers span two pages
+	var transfers []FTTransfer
+	for h := uint64(120); h >= 1; h-- {
+		tr := FTTransfer{BlockHeight: h, Amount: 1, Receiver: address, Sender: "0xaaaa"}
+		if h%4 == 0 {
+			tr.Receiver, tr.Sender = "0xaaaa", "1234567890ABCDEF"
+		}
+		transfers = append(transfers, tr)
+	}
+	// A second transfer at height 10 and one via Direction only
+	transfers = append(transfers,
+		FTTransfer{BlockHeight: 10, Amount: 5, Receiver: address},
+		FTTransfer{BlockHeight: 11, Amount: 2, Direction: "out"},
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		expected := "/flow/v1/account/" + address + "/ft/" + token + "/transfer"
+		if r.URL.Path != expected {
+			t.Errorf("Expected path %s, got %s", expected, r.URL.Path)
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		end := min(offset+maxPageSize, len(transfers))
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(TransfersResponse{Data: transfers[offset:end]})
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	series, err := service.BalanceHistory(context.Background(), address, token, 10, 12)
+	if err != nil {
+		t.Fatalf("BalanceHistory failed: %v", err)
+	}
+
+	// Heights 1-9: +1 each except -1 at 4 and 8 -> 5
+	if series.Opening != 5 {
+		t.Errorf("Expected opening 5, got %g", series.Opening)
+	}
+	expected := []BalancePoint{
+		{Height: 10, Balance: 11, Change: 6, Transfers: 2},
+		{Height: 11, Balance: 10, Change: -1, Transfers: 2},
+		{Height: 12, Balance: 9, Change: -1, Transfers: 1},
+	}
+	if len(series.Points) != len(expected) {
+		t.Fatalf("Expected %d points, got %+v", len(expected), series.Points)
+	}
+	for i, p := range series.Points {
+		if p != expected[i] {
+			t.Errorf("Point %d: expected %+v, got %+v", i, expected[i], p)
+		}
+	}
+	if series.Closing != 9 {
+		t.Errorf("Expected closing 9, got %g", series.Closing)
+	}
+}
+
+func TestFlowService_BalanceHistory_Validation(t *testing.T) {
+	service := NewService(&mockClient{})
+	ctx := context.Background()
+
+	if _, err := service.BalanceHistory(ctx, "", "FLOW", 0, 0); err == nil {
+		t.Error("Expected error for missing address")
+	}
+	if _, err := service.BalanceHistory(ctx, "0x1", "", 0, 0); err == nil {
+		t.Error("Expected error for missing token")
+	}
+	if _, err := service.BalanceHistory(ctx, "0x1", "FLOW", 10, 5); err == nil {
+		t.Error("Expected error for inverted range")
+	}
+}
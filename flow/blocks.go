@@ -28,12 +28,7 @@ type Block struct {
 }
 
 // BlockResponse represents the response from the blocks list endpoint
-type BlockResponse struct {
-	Data  []Block                `json:"data"`
-	Links map[string]string      `json:"_links"`
-	Meta  map[string]interface{} `json:"_meta"`
-	Error interface{}            `json:"error,omitempty"`
-}
+type BlockResponse = Response[Block]
 
 // BlockServiceEvent represents a block service event
 type BlockServiceEvent struct {
@@ -44,12 +39,7 @@ type BlockServiceEvent struct {
 }
 
 // BlockServiceEventResponse represents the response from the block service events endpoint
-type BlockServiceEventResponse struct {
-	Data  []BlockServiceEvent    `json:"data"`
-	Links map[string]string      `json:"_links"`
-	Meta  map[string]interface{} `json:"_meta"`
-	Error interface{}            `json:"error,omitempty"`
-}
+type BlockServiceEventResponse = Response[BlockServiceEvent]
 
 // BlockTransaction represents a transaction in a block
 type BlockTransaction struct {
@@ -76,12 +66,7 @@ type BlockTransaction struct {
 }
 
 // BlockTransactionsResponse represents the response from the block transactions endpoint
-type BlockTransactionsResponse struct {
-	Data  []BlockTransaction     `json:"data"`
-	Links map[string]string      `json:"_links"`
-	Meta  map[string]interface{} `json:"_meta"`
-	Error interface{}            `json:"error,omitempty"`
-}
+type BlockTransactionsResponse = Response[BlockTransaction]
 
 // BlocksRequestBuilder builds a request to get blocks list
 type BlocksRequestBuilder struct {
@@ -96,6 +81,12 @@ func (s *Service) GetBlocks() *BlocksRequestBuilder {
 	return &BlocksRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the blocks list request builder
+func (b *BlocksRequestBuilder) Clone() *BlocksRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Height sets the block height to start from (optional, descending)
 func (b *BlocksRequestBuilder) Height(height uint64) *BlocksRequestBuilder {
 	b.height = &height
@@ -114,8 +105,17 @@ func (b *BlocksRequestBuilder) Offset(offset int) *BlocksRequestBuilder {
 	return b
 }
 
+// Validate checks the blocks list request without making a network call
+func (b *BlocksRequestBuilder) Validate() error {
+	return validatePage(b.limit, b.offset)
+}
+
 // Do executes the blocks list request
 func (b *BlocksRequestBuilder) Do(ctx context.Context) (*BlockResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
 	query := url.Values{}
 	if b.height != nil {
 		query.Set("height", strconv.FormatUint(*b.height, 10))
@@ -136,6 +136,7 @@ func (b *BlocksRequestBuilder) Do(ctx context.Context) (*BlockResponse, error) {
 	if err := b.service.client.DecodeResponse(resp, &blockResp); err != nil {
 		return nil, err
 	}
+	blockResp.Data = emptyIfNil(blockResp.Data)
 
 	return &blockResp, nil
 }
@@ -151,16 +152,30 @@ func (s *Service) GetBlock() *BlockRequestBuilder {
 	return &BlockRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the block request builder
+func (b *BlockRequestBuilder) Clone() *BlockRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Height sets the block height (required)
 func (b *BlockRequestBuilder) Height(height uint64) *BlockRequestBuilder {
 	b.height = height
 	return b
 }
 
+// Validate checks the block request without making a network call
+func (b *BlockRequestBuilder) Validate() error {
+	if b.height == 0 {
+		return fmt.Errorf("block height is required")
+	}
+	return nil
+}
+
 // Do executes the block request
 func (b *BlockRequestBuilder) Do(ctx context.Context) (*BlockResponse, error) {
-	if b.height == 0 {
-		return nil, fmt.Errorf("block height is required")
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	path := fmt.Sprintf("/flow/v1/block/%d", b.height)
@@ -173,6 +188,7 @@ func (b *BlockRequestBuilder) Do(ctx context.Context) (*BlockResponse, error) {
 	if err := b.service.client.DecodeResponse(resp, &blockResp); err != nil {
 		return nil, err
 	}
+	blockResp.Data = emptyIfNil(blockResp.Data)
 
 	return &blockResp, nil
 }
@@ -190,6 +206,12 @@ func (s *Service) GetBlockServiceEvents() *BlockServiceEventsRequestBuilder {
 	return &BlockServiceEventsRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the block service events request builder
+func (b *BlockServiceEventsRequestBuilder) Clone() *BlockServiceEventsRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Height sets the block height (required)
 func (b *BlockServiceEventsRequestBuilder) Height(height uint64) *BlockServiceEventsRequestBuilder {
 	b.height = height
@@ -208,10 +230,18 @@ func (b *BlockServiceEventsRequestBuilder) Offset(offset int) *BlockServiceEvent
 	return b
 }
 
+// Validate checks the block service events request without making a network call
+func (b *BlockServiceEventsRequestBuilder) Validate() error {
+	if b.height == 0 {
+		return fmt.Errorf("block height is required")
+	}
+	return validatePage(b.limit, b.offset)
+}
+
 // Do executes the block service events request
 func (b *BlockServiceEventsRequestBuilder) Do(ctx context.Context) (*BlockServiceEventResponse, error) {
-	if b.height == 0 {
-		return nil, fmt.Errorf("block height is required")
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	query := url.Values{}
@@ -233,6 +263,7 @@ func (b *BlockServiceEventsRequestBuilder) Do(ctx context.Context) (*BlockServic
 	if err := b.service.client.DecodeResponse(resp, &eventsResp); err != nil {
 		return nil, err
 	}
+	eventsResp.Data = emptyIfNil(eventsResp.Data)
 
 	return &eventsResp, nil
 }
@@ -242,6 +273,7 @@ type BlockTransactionsRequestBuilder struct {
 	service       *Service
 	height        uint64
 	includeEvents *bool
+	system        *bool
 }
 
 // GetBlockTransactions creates a new block transactions request builder
@@ -249,6 +281,12 @@ func (s *Service) GetBlockTransactions() *BlockTransactionsRequestBuilder {
 	return &BlockTransactionsRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the block transactions request builder
+func (b *BlockTransactionsRequestBuilder) Clone() *BlockTransactionsRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Height sets the block height (required)
 func (b *BlockTransactionsRequestBuilder) Height(height uint64) *BlockTransactionsRequestBuilder {
 	b.height = height
@@ -261,10 +299,25 @@ func (b *BlockTransactionsRequestBuilder) IncludeEvents(include bool) *BlockTran
 	return b
 }
 
+// System limits results to system transactions (true) or user transactions
+// (false), filtered client-side (optional, default both)
+func (b *BlockTransactionsRequestBuilder) System(system bool) *BlockTransactionsRequestBuilder {
+	b.system = &system
+	return b
+}
+
+// Validate checks the block transactions request without making a network call
+func (b *BlockTransactionsRequestBuilder) Validate() error {
+	if b.height == 0 {
+		return fmt.Errorf("block height is required")
+	}
+	return nil
+}
+
 // Do executes the block transactions request
 func (b *BlockTransactionsRequestBuilder) Do(ctx context.Context) (*BlockTransactionsResponse, error) {
-	if b.height == 0 {
-		return nil, fmt.Errorf("block height is required")
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	query := url.Values{}
@@ -282,6 +335,20 @@ func (b *BlockTransactionsRequestBuilder) Do(ctx context.Context) (*BlockTransac
 	if err := b.service.client.DecodeResponse(resp, &txResp); err != nil {
 		return nil, err
 	}
+	txResp.Data = emptyIfNil(filterSystem(txResp.Data, b.system, (*BlockTransaction).IsSystem))
 
 	return &txResp, nil
 }
+
+// GetLatestBlock returns the newest indexed block, e.g. to discover the chain
+// head before polling
+func (s *Service) GetLatestBlock(ctx context.Context) (*Block, error) {
+	resp, err := s.GetBlocks().Limit(1).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Data) == 0 {
+		return nil, fmt.Errorf("no blocks indexed")
+	}
+	return &resp.Data[0], nil
+}
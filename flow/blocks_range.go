@@ -0,0 +1,114 @@
+package flow
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// BlocksRangeRequestBuilder builds an ascending walk over a range of blocks
+type BlocksRangeRequestBuilder struct {
+	service *Service
+	from    *uint64
+	to      *uint64
+}
+
+// GetBlocksRange creates a new block range request builder
+func (s *Service) GetBlocksRange() *BlocksRangeRequestBuilder {
+	return &BlocksRangeRequestBuilder{service: s}
+}
+
+// Clone returns an independent copy of the block range request builder
+func (b *BlocksRangeRequestBuilder) Clone() *BlocksRangeRequestBuilder {
+	c := *b
+	return &c
+}
+
+// From sets the first height of the range, inclusive (required)
+func (b *BlocksRangeRequestBuilder) From(height uint64) *BlocksRangeRequestBuilder {
+	b.from = &height
+	return b
+}
+
+// To sets the last height of the range, inclusive (optional, defaults to the
+// latest block when iteration starts)
+func (b *BlocksRangeRequestBuilder) To(height uint64) *BlocksRangeRequestBuilder {
+	b.to = &height
+	return b
+}
+
+// Validate checks the block range request without making a network call
+func (b *BlocksRangeRequestBuilder) Validate() error {
+	if b.from == nil {
+		return fmt.Errorf("from height is required")
+	}
+	return nil
+}
+
+// All iterates over the blocks of the range in ascending height order, fetching
+// a page of up to 100 blocks at a time. If a request fails the error is yielded
+// once and iteration stops.
+func (b *BlocksRangeRequestBuilder) All(ctx context.Context) iter.Seq2[Block, error] {
+	return func(yield func(Block, error) bool) {
+		if err := b.Validate(); err != nil {
+			yield(Block{}, err)
+			return
+		}
+		from := *b.from
+
+		var to uint64
+		if b.to != nil {
+			to = *b.to
+		} else {
+			latest, err := b.service.GetLatestBlock(ctx)
+			if err != nil {
+				yield(Block{}, fmt.Errorf("fetch latest block: %w", err))
+				return
+			}
+			to = latest.Height
+		}
+		if to < from {
+			return
+		}
+
+		// The endpoint walks down from a height, so each window is fetched from
+		// its top and reversed
+		for lo := from; lo <= to; lo += maxPageSize {
+			hi := min(lo+maxPageSize-1, to)
+			resp, err := b.service.GetBlocks().Height(hi).Limit(int(hi - lo + 1)).Do(ctx)
+			if err != nil {
+				yield(Block{}, fmt.Errorf("fetch blocks %d-%d: %w", lo, hi, err))
+				return
+			}
+
+			page := slices.DeleteFunc(resp.Data, func(blk Block) bool {
+				return blk.Height < lo || blk.Height > hi
+			})
+			slices.SortFunc(page, func(a, b Block) int { return cmp.Compare(a.Height, b.Height) })
+			for _, blk := range page {
+				if !yield(blk, nil) {
+					return
+				}
+			}
+
+			// Guard against wrapping at the top of the height range
+			if hi == to {
+				return
+			}
+		}
+	}
+}
+
+// Do collects every block of the range in ascending height order
+func (b *BlocksRangeRequestBuilder) Do(ctx context.Context) ([]Block, error) {
+	blocks := []Block{}
+	for blk, err := range b.All(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, blk)
+	}
+	return blocks, nil
+}
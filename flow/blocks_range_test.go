@@ -0,0 +1,97 @@
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// newBlocksServer serves blocks 1..head, walking down from the height parameter
+func newBlocksServer(t *testing.T, head uint64, requests *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/flow/v1/block" {
+			t.Errorf("Expected path /flow/v1/block, got %s", r.URL.Path)
+		}
+		*requests++
+		top := head
+		if h := r.URL.Query().Get("height"); h != "" {
+			top, _ = strconv.ParseUint(h, 10, 64)
+		}
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		blocks := []Block{}
+		for h := min(top, head); h >= 1 && len(blocks) < limit; h-- {
+			blocks = append(blocks, Block{Height: h})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(BlockResponse{Data: blocks})
+	}))
+}
+
+func TestFlowService_GetBlocksRange(t *testing.T) {
+	var requests int
+	server := newBlocksServer(t, 1000, &requests)
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	blocks, err := service.GetBlocksRange().From(10).To(260).Do(context.Background())
+	if err != nil {
+		t.Fatalf("GetBlocksRange failed: %v", err)
+	}
+
+	if len(blocks) != 251 {
+		t.Fatalf("Expected 251 blocks, got %d", len(blocks))
+	}
+	for i, b := range blocks {
+		if b.Height != uint64(10+i) {
+			t.Fatalf("Expected ascending heights, got %d at %d", b.Height, i)
+		}
+	}
+	if requests != 3 {
+		t.Errorf("Expected 3 page requests, got %d", requests)
+	}
+}
+
+func TestFlowService_GetBlocksRange_ToLatest(t *testing.T) {
+	var requests int
+	server := newBlocksServer(t, 150, &requests)
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+
+	var last uint64
+	n := 0
+	for b, err := range service.GetBlocksRange().From(101).All(context.Background()) {
+		if err != nil {
+			t.Fatalf("Iteration failed: %v", err)
+		}
+		last = b.Height
+		n++
+	}
+	if n != 50 || last != 150 {
+		t.Errorf("Expected 50 blocks ending at 150, got %d ending at %d", n, last)
+	}
+
+	// Breaking early stops fetching
+	requests = 0
+	for range service.GetBlocksRange().From(1).To(150).All(context.Background()) {
+		break
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 request after breaking, got %d", requests)
+	}
+}
+
+func TestFlowService_GetBlocksRange_Validation(t *testing.T) {
+	service := NewService(&mockClient{})
+	if _, err := service.GetBlocksRange().To(10).Do(context.Background()); err == nil {
+		t.Error("Expected error for missing from height")
+	}
+
+	blocks, err := service.GetBlocksRange().From(10).To(5).Do(context.Background())
+	if err != nil || len(blocks) != 0 {
+		t.Errorf("Expected empty result for an inverted range, got %v %v", blocks, err)
+	}
+}
@@ -296,3 +296,26 @@ func TestFlowService_BlockRequiredFields(t *testing.T) {
 		t.Error("Expected error when height is not provided")
 	}
 }
+
+func TestFlowService_GetLatestBlock(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/flow/v1/block" {
+			t.Errorf("Expected path /flow/v1/block, got %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("limit") != "1" {
+			t.Errorf("Expected limit 1, got %s", r.URL.Query().Get("limit"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(BlockResponse{Data: []Block{{Height: 98765}}})
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	block, err := service.GetLatestBlock(context.Background())
+	if err != nil {
+		t.Fatalf("GetLatestBlock failed: %v", err)
+	}
+	if block.Height != 98765 {
+		t.Errorf("Expected height 98765, got %d", block.Height)
+	}
+}
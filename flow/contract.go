@@ -1,29 +1,39 @@
 package flow
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 )
 
 // Contract represents a contract
 type Contract struct {
-	Address      string `json:"address"`
-	BlockHeight  uint64 `json:"block_height"`
-	ContractName string `json:"name"`
-	ID           string `json:"id"`
-	Identifier   string `json:"identifier"`
+	Address          string   `json:"address"`
+	BlockHeight      uint64   `json:"block_height"`
+	Body             string   `json:"body,omitempty"`
+	ContractName     string   `json:"name"`
+	CreatedAt        string   `json:"created_at,omitempty"`
+	Deployments      int      `json:"deployments,omitempty"`
+	Diff             string   `json:"diff,omitempty"`
+	ID               string   `json:"id"`
+	Identifier       string   `json:"identifier"`
+	ImportCount      int      `json:"import_count,omitempty"`
+	ImportedBy       []string `json:"imported_by,omitempty"`
+	ImportedCount    int      `json:"imported_count,omitempty"`
+	ParentContractID string   `json:"parent_contract_id,omitempty"`
+	Status           string   `json:"status,omitempty"`
+	Tags             []string `json:"tags,omitempty"`
+	TransactionHash  string   `json:"transaction_hash,omitempty"`
+	ValidFrom        uint64   `json:"valid_from,omitempty"`
+	ValidTo          uint64   `json:"valid_to,omitempty"`
 }
 
 // ContractResponse represents the response from the contracts endpoint
-type ContractResponse struct {
-	Data  []Contract             `json:"data"`
-	Links map[string]string      `json:"_links"`
-	Meta  map[string]interface{} `json:"_meta"`
-	Error interface{}            `json:"error,omitempty"`
-}
+type ContractResponse = Response[Contract]
 
 // ContractsRequestBuilder builds a request to get contracts
 type ContractsRequestBuilder struct {
@@ -37,6 +47,12 @@ func (s *Service) GetContracts() *ContractsRequestBuilder {
 	return &ContractsRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the contracts request builder
+func (b *ContractsRequestBuilder) Clone() *ContractsRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Limit sets the number of records to return (optional, default 25, max 100)
 func (b *ContractsRequestBuilder) Limit(limit int) *ContractsRequestBuilder {
 	b.limit = &limit
@@ -49,8 +65,17 @@ func (b *ContractsRequestBuilder) Offset(offset int) *ContractsRequestBuilder {
 	return b
 }
 
+// Validate checks the contracts request without making a network call
+func (b *ContractsRequestBuilder) Validate() error {
+	return validatePage(b.limit, b.offset)
+}
+
 // Do executes the contracts request
 func (b *ContractsRequestBuilder) Do(ctx context.Context) (*ContractResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
 	query := url.Values{}
 	if b.limit != nil {
 		query.Set("limit", strconv.Itoa(*b.limit))
@@ -68,6 +93,7 @@ func (b *ContractsRequestBuilder) Do(ctx context.Context) (*ContractResponse, er
 	if err := b.service.client.DecodeResponse(resp, &contractResp); err != nil {
 		return nil, err
 	}
+	contractResp.Data = emptyIfNil(contractResp.Data)
 
 	return &contractResp, nil
 }
@@ -85,6 +111,12 @@ func (s *Service) GetContractsByIdentifier() *ContractsByIdentifierRequestBuilde
 	return &ContractsByIdentifierRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the contracts by identifier request builder
+func (b *ContractsByIdentifierRequestBuilder) Clone() *ContractsByIdentifierRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Identifier sets the contract identifier (required)
 func (b *ContractsByIdentifierRequestBuilder) Identifier(identifier string) *ContractsByIdentifierRequestBuilder {
 	b.identifier = identifier
@@ -103,10 +135,18 @@ func (b *ContractsByIdentifierRequestBuilder) Offset(offset int) *ContractsByIde
 	return b
 }
 
+// Validate checks the contracts by identifier request without making a network call
+func (b *ContractsByIdentifierRequestBuilder) Validate() error {
+	if b.identifier == "" {
+		return fmt.Errorf("contract identifier is required")
+	}
+	return validatePage(b.limit, b.offset)
+}
+
 // Do executes the contracts by identifier request
 func (b *ContractsByIdentifierRequestBuilder) Do(ctx context.Context) (*ContractResponse, error) {
-	if b.identifier == "" {
-		return nil, fmt.Errorf("contract identifier is required")
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	query := url.Values{}
@@ -127,6 +167,7 @@ func (b *ContractsByIdentifierRequestBuilder) Do(ctx context.Context) (*Contract
 	if err := b.service.client.DecodeResponse(resp, &contractResp); err != nil {
 		return nil, err
 	}
+	contractResp.Data = emptyIfNil(contractResp.Data)
 
 	return &contractResp, nil
 }
@@ -143,6 +184,12 @@ func (s *Service) GetContract() *ContractRequestBuilder {
 	return &ContractRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the contract request builder
+func (b *ContractRequestBuilder) Clone() *ContractRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Identifier sets the contract identifier (required)
 func (b *ContractRequestBuilder) Identifier(identifier string) *ContractRequestBuilder {
 	b.identifier = identifier
@@ -155,13 +202,21 @@ func (b *ContractRequestBuilder) ID(id string) *ContractRequestBuilder {
 	return b
 }
 
-// Do executes the contract request
-func (b *ContractRequestBuilder) Do(ctx context.Context) (*ContractResponse, error) {
+// Validate checks the contract request without making a network call
+func (b *ContractRequestBuilder) Validate() error {
 	if b.identifier == "" {
-		return nil, fmt.Errorf("contract identifier is required")
+		return fmt.Errorf("contract identifier is required")
 	}
 	if b.id == "" {
-		return nil, fmt.Errorf("contract ID is required")
+		return fmt.Errorf("contract ID is required")
+	}
+	return nil
+}
+
+// Do executes the contract request
+func (b *ContractRequestBuilder) Do(ctx context.Context) (*ContractResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	path := fmt.Sprintf("/flow/v1/contract/%s/%s", b.identifier, b.id)
@@ -174,6 +229,96 @@ func (b *ContractRequestBuilder) Do(ctx context.Context) (*ContractResponse, err
 	if err := b.service.client.DecodeResponse(resp, &contractResp); err != nil {
 		return nil, err
 	}
+	contractResp.Data = emptyIfNil(contractResp.Data)
 
 	return &contractResp, nil
 }
+
+// AccountContractsRequestBuilder builds a request to get the contracts deployed on an account
+type AccountContractsRequestBuilder struct {
+	service *Service
+	address string
+}
+
+// GetAccountContracts creates a new account contracts request builder. Only
+// the latest version of each contract is returned, with its code, sorted by name.
+func (s *Service) GetAccountContracts() *AccountContractsRequestBuilder {
+	return &AccountContractsRequestBuilder{service: s}
+}
+
+// Clone returns an independent copy of the account contracts request builder
+func (b *AccountContractsRequestBuilder) Clone() *AccountContractsRequestBuilder {
+	c := *b
+	return &c
+}
+
+// Address sets the account address (required)
+func (b *AccountContractsRequestBuilder) Address(address string) *AccountContractsRequestBuilder {
+	b.address = address
+	return b
+}
+
+// Validate checks the account contracts request without making a network call
+func (b *AccountContractsRequestBuilder) Validate() error {
+	return validateAddress(b.address)
+}
+
+// Do executes the account contracts request
+func (b *AccountContractsRequestBuilder) Do(ctx context.Context) (*ContractResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	all, err := collectPages(func(offset int) ([]Contract, error) {
+		query := url.Values{}
+		query.Set("address", b.address)
+		query.Set("limit", strconv.Itoa(maxPageSize))
+		if offset > 0 {
+			query.Set("offset", strconv.Itoa(offset))
+		}
+
+		resp, err := b.service.client.DoRequest(ctx, http.MethodGet, "/flow/v1/contract", query)
+		if err != nil {
+			return nil, err
+		}
+
+		var contractResp ContractResponse
+		if err := b.service.client.DecodeResponse(resp, &contractResp); err != nil {
+			return nil, err
+		}
+		return contractResp.Data, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the endpoint lists every deployed version, so keep the latest of each
+	latest := map[string]Contract{}
+	for _, c := range all {
+		if normalizeAddress(c.Address) != normalizeAddress(b.address) {
+			continue
+		}
+		if prev, ok := latest[c.Identifier]; !ok || c.BlockHeight > prev.BlockHeight {
+			latest[c.Identifier] = c
+		}
+	}
+
+	contracts := make([]Contract, 0, len(latest))
+	for _, c := range latest {
+		if c.Body == "" && c.ID != "" {
+			full, err := b.service.GetContract().Identifier(c.Identifier).ID(c.ID).Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if len(full.Data) > 0 {
+				c = full.Data[0]
+			}
+		}
+		contracts = append(contracts, c)
+	}
+	slices.SortFunc(contracts, func(a, b Contract) int {
+		return cmp.Compare(a.ContractName, b.ContractName)
+	})
+
+	return &ContractResponse{Data: contracts}, nil
+}
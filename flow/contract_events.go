@@ -0,0 +1,294 @@
+package flow
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ContractEvent is an event emitted by a contract
+type ContractEvent struct {
+	BlockHeight     uint64                 `json:"block_height"`
+	EventIndex      int                    `json:"event_index"`
+	Name            string                 `json:"name"`
+	Timestamp       string                 `json:"timestamp"`
+	TransactionHash string                 `json:"transaction_hash"`
+	Fields          map[string]interface{} `json:"fields"`
+	// RawFields holds the fields exactly as received, for archival or
+	// re-decoding into typed structs with DecodeFields
+	RawFields json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes an event, keeping the raw bytes of its fields
+func (e *ContractEvent) UnmarshalJSON(b []byte) error {
+	type event ContractEvent
+	var v struct {
+		event
+		Fields json.RawMessage `json:"fields"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*e = ContractEvent(v.event)
+	e.RawFields = v.Fields
+	return unmarshalFields(v.Fields, &e.Fields)
+}
+
+// DecodeFields decodes the event fields into v, a struct whose json tags
+// match the Cadence field names
+func (e *ContractEvent) DecodeFields(v any) error {
+	return decodeFields(e.RawFields, e.Fields, v)
+}
+
+// ContractEventsResponse represents the events emitted by a contract in a height range
+type ContractEventsResponse struct {
+	Data []ContractEvent `json:"data"`
+	// EventTypes are the fully qualified event types declared by the contract
+	// that were queried
+	EventTypes []string `json:"event_types"`
+	// Total is the number of events in the range, before Limit and Offset
+	Total int `json:"total"`
+}
+
+// contractEventsResponse is the payload of the events-by-name endpoint
+type contractEventsResponse struct {
+	Events []ContractEvent `json:"events"`
+}
+
+// ContractEventsRequestBuilder builds a request to get the events emitted by a contract
+type ContractEventsRequestBuilder struct {
+	service    *Service
+	identifier string
+	fromHeight uint64
+	toHeight   uint64
+	limit      *int
+	offset     *int
+}
+
+// GetContractEvents creates a new contract events request builder. The API
+// only queries events by name, so the contract's declared events are read
+// from its latest source and each is queried over the height range; the
+// results are merged in chain order.
+func (s *Service) GetContractEvents() *ContractEventsRequestBuilder {
+	return &ContractEventsRequestBuilder{service: s}
+}
+
+// Clone returns an independent copy of the contract events request builder
+func (b *ContractEventsRequestBuilder) Clone() *ContractEventsRequestBuilder {
+	c := *b
+	return &c
+}
+
+// Identifier sets the contract identifier, e.g. A.1654653399040a61.FlowToken (required)
+func (b *ContractEventsRequestBuilder) Identifier(identifier string) *ContractEventsRequestBuilder {
+	b.identifier = identifier
+	return b
+}
+
+// FromHeight sets the first block height of the range, inclusive (required)
+func (b *ContractEventsRequestBuilder) FromHeight(height uint64) *ContractEventsRequestBuilder {
+	b.fromHeight = height
+	return b
+}
+
+// ToHeight sets the last block height of the range, inclusive (required)
+func (b *ContractEventsRequestBuilder) ToHeight(height uint64) *ContractEventsRequestBuilder {
+	b.toHeight = height
+	return b
+}
+
+// Limit sets the number of merged events to return (optional, default all)
+func (b *ContractEventsRequestBuilder) Limit(limit int) *ContractEventsRequestBuilder {
+	b.limit = &limit
+	return b
+}
+
+// Offset sets the number of merged events to skip (optional)
+func (b *ContractEventsRequestBuilder) Offset(offset int) *ContractEventsRequestBuilder {
+	b.offset = &offset
+	return b
+}
+
+// Validate checks the contract events request without making a network call
+func (b *ContractEventsRequestBuilder) Validate() error {
+	if b.identifier == "" {
+		return fmt.Errorf("contract identifier is required")
+	}
+	if err := checkHeightRange(b.fromHeight, b.toHeight); err != nil {
+		return err
+	}
+	if b.limit != nil && *b.limit < 0 {
+		return fmt.Errorf("limit %d is negative", *b.limit)
+	}
+	return validatePage(nil, b.offset)
+}
+
+// Do executes the contract events request
+func (b *ContractEventsRequestBuilder) Do(ctx context.Context) (*ContractEventsResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	types, err := b.service.ContractEventTypes(ctx, b.identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := b.service.eventsByNames(ctx, types, b.fromHeight, b.toHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	total := len(events)
+	events = paginate(events, b.limit, b.offset)
+
+	return &ContractEventsResponse{Data: events, EventTypes: types, Total: total}, nil
+}
+
+// eventsByName fetches one page of events of a type from the events endpoint
+func (s *Service) eventsByName(ctx context.Context, name string, from, to uint64, offset int) ([]ContractEvent, error) {
+	query := url.Values{}
+	query.Set("name", name)
+	query.Set("from_height", strconv.FormatUint(from, 10))
+	query.Set("to_height", strconv.FormatUint(to, 10))
+	if offset > 0 {
+		query.Set("offset", strconv.Itoa(offset))
+	}
+
+	resp, err := s.client.DoRequest(ctx, http.MethodGet, "/simple/v1/events", query)
+	if err != nil {
+		return nil, err
+	}
+
+	var eventsResp contractEventsResponse
+	if err := s.client.DecodeResponse(resp, &eventsResp); err != nil {
+		return nil, err
+	}
+	return eventsResp.Events, nil
+}
+
+// ContractEventTypes returns the fully qualified types of the events declared
+// in the latest version of a contract, e.g. A.1654653399040a61.FlowToken.TokensDeposited
+func (s *Service) ContractEventTypes(ctx context.Context, identifier string) ([]string, error) {
+	latest, err := s.latestContract(ctx, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	var types []string
+	for _, name := range declaredEvents(latest.Body) {
+		types = append(types, identifier+"."+name)
+	}
+	return types, nil
+}
+
+// latestContract returns the most recently deployed version of a contract,
+// including its body
+func (s *Service) latestContract(ctx context.Context, identifier string) (Contract, error) {
+	resp, err := s.GetContractsByIdentifier().Identifier(identifier).Limit(maxPageSize).Do(ctx)
+	if err != nil {
+		return Contract{}, err
+	}
+	if len(resp.Data) == 0 {
+		return Contract{}, fmt.Errorf("contract %s not found", identifier)
+	}
+
+	latest := resp.Data[0]
+	for _, c := range resp.Data[1:] {
+		if c.BlockHeight > latest.BlockHeight {
+			latest = c
+		}
+	}
+
+	if latest.Body == "" && latest.ID != "" {
+		full, err := s.GetContract().Identifier(identifier).ID(latest.ID).Do(ctx)
+		if err != nil {
+			return Contract{}, err
+		}
+		if len(full.Data) > 0 {
+			latest = full.Data[0]
+		}
+	}
+	return latest, nil
+}
+
+var (
+	cadenceCommentRe   = regexp.MustCompile(`(?s)//[^\n]*|/\*.*?\*/`)
+	cadenceStringRe    = regexp.MustCompile(`"(?:[^"\\]|\\.)*"`)
+	cadenceCompositeRe = regexp.MustCompile(`\b(?:resource|struct|attachment|contract)\s+(?:interface\s+)?([A-Za-z_][A-Za-z0-9_]*)`)
+	cadenceEventRe     = regexp.MustCompile(`\bevent\s+([A-Za-z_][A-Za-z0-9_]*)\s*\(`)
+)
+
+// declaredEvents returns the events declared in Cadence source, qualified by
+// any nested composite they are declared in (e.g. NFT.ResourceDestroyed) and
+// relative to the contract itself
+func declaredEvents(body string) []string {
+	body = cadenceCommentRe.ReplaceAllStringFunc(body, blankOut)
+	body = cadenceStringRe.ReplaceAllStringFunc(body, blankOut)
+
+	type mark struct {
+		pos   int
+		name  string
+		event bool
+	}
+	var marks []mark
+	for _, m := range cadenceCompositeRe.FindAllStringSubmatchIndex(body, -1) {
+		marks = append(marks, mark{pos: m[0], name: body[m[2]:m[3]]})
+	}
+	for _, m := range cadenceEventRe.FindAllStringSubmatchIndex(body, -1) {
+		marks = append(marks, mark{pos: m[0], name: body[m[2]:m[3]], event: true})
+	}
+	slices.SortFunc(marks, func(a, b mark) int { return cmp.Compare(a.pos, b.pos) })
+
+	var (
+		events  []string
+		seen    = map[string]bool{}
+		scopes  []string
+		pending string
+		next    int
+	)
+	for i := 0; i < len(body); i++ {
+		for next < len(marks) && marks[next].pos == i {
+			m := marks[next]
+			next++
+			if !m.event {
+				pending = m.name
+				continue
+			}
+			// scopes[0] is the contract itself
+			path := []string{}
+			for _, s := range scopes[min(1, len(scopes)):] {
+				if s != "" {
+					path = append(path, s)
+				}
+			}
+			name := strings.Join(append(path, m.name), ".")
+			if !seen[name] {
+				seen[name] = true
+				events = append(events, name)
+			}
+		}
+		switch body[i] {
+		case '{':
+			scopes = append(scopes, pending)
+			pending = ""
+		case '}':
+			if len(scopes) > 0 {
+				scopes = scopes[:len(scopes)-1]
+			}
+		}
+	}
+	return events
+}
+
+// blankOut replaces s with spaces, preserving offsets
+func blankOut(s string) string {
+	return strings.Repeat(" ", len(s))
+}
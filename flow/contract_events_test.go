@@ -0,0 +1,109 @@
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+const testContractBody = `
+access(all) contract ExampleNFT {
+    // event Commented(id: UInt64)
+    access(all) event Withdraw(id: UInt64, from: Address?)
+    access(all) event Deposit(id: UInt64, to: Address?)
+
+    access(all) resource NFT {
+        access(all) event ResourceDestroyed(id: UInt64 = self.id)
+        access(all) let id: UInt64
+        init() {
+            self.id = 1
+            log("event Fake(")
+        }
+    }
+
+    access(all) fun mint(): @NFT {
+        return <- create NFT()
+    }
+}
+`
+
+func TestDeclaredEvents(t *testing.T) {
+	got := declaredEvents(testContractBody)
+	want := []string{"Withdraw", "Deposit", "NFT.ResourceDestroyed"}
+	if !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestFlowService_GetContractEvents(t *testing.T) {
+	identifier := "A.0000000000000001.ExampleNFT"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/flow/v1/contract/" + identifier:
+			json.NewEncoder(w).Encode(ContractResponse{Data: []Contract{
+				{ID: "old", Identifier: identifier, BlockHeight: 10, Body: "access(all) contract ExampleNFT { access(all) event Old() }"},
+				{ID: "new", Identifier: identifier, BlockHeight: 20, Body: testContractBody},
+			}})
+		case "/simple/v1/events":
+			q := r.URL.Query()
+			if q.Get("from_height") != "100" || q.Get("to_height") != "200" {
+				t.Errorf("Expected range 100-200, got %s-%s", q.Get("from_height"), q.Get("to_height"))
+			}
+			var events []ContractEvent
+			switch q.Get("name") {
+			case identifier + ".Withdraw":
+				events = []ContractEvent{{Name: q.Get("name"), BlockHeight: 150, EventIndex: 0, TransactionHash: "b"}}
+			case identifier + ".Deposit":
+				events = []ContractEvent{
+					{Name: q.Get("name"), BlockHeight: 150, EventIndex: 1, TransactionHash: "b"},
+					{Name: q.Get("name"), BlockHeight: 120, EventIndex: 3, TransactionHash: "a"},
+				}
+			case identifier + ".NFT.ResourceDestroyed":
+			default:
+				t.Errorf("Unexpected event name %s", q.Get("name"))
+			}
+			json.NewEncoder(w).Encode(contractEventsResponse{Events: events})
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+
+	ctx := context.Background()
+	result, err := service.GetContractEvents().Identifier(identifier).FromHeight(100).ToHeight(200).Do(ctx)
+	if err != nil {
+		t.Fatalf("GetContractEvents failed: %v", err)
+	}
+
+	if len(result.EventTypes) != 3 {
+		t.Errorf("Expected 3 event types, got %v", result.EventTypes)
+	}
+	if result.Total != 3 || len(result.Data) != 3 {
+		t.Fatalf("Expected 3 events, got %d (total %d)", len(result.Data), result.Total)
+	}
+	heights := []uint64{result.Data[0].BlockHeight, result.Data[1].BlockHeight, result.Data[2].BlockHeight}
+	if !slices.Equal(heights, []uint64{120, 150, 150}) {
+		t.Errorf("Expected events ordered by height, got %v", heights)
+	}
+	if result.Data[1].EventIndex != 0 || result.Data[2].EventIndex != 1 {
+		t.Errorf("Expected events ordered by event index within a transaction, got %+v", result.Data)
+	}
+
+	paged, err := service.GetContractEvents().Identifier(identifier).FromHeight(100).ToHeight(200).Offset(1).Limit(1).Do(ctx)
+	if err != nil {
+		t.Fatalf("GetContractEvents failed: %v", err)
+	}
+	if paged.Total != 3 || len(paged.Data) != 1 || paged.Data[0].EventIndex != 0 {
+		t.Errorf("Expected second event only, got %+v", paged.Data)
+	}
+
+	if _, err := service.GetContractEvents().Identifier(identifier).FromHeight(200).ToHeight(100).Do(ctx); err == nil {
+		t.Error("Expected error for inverted range")
+	}
+}
@@ -0,0 +1,162 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ContractDependencies lists the contracts a contract imports and the
+// contracts that import it
+type ContractDependencies struct {
+	Identifier string `json:"identifier"`
+	// Imports are the identifiers of the contracts imported by the latest
+	// version of the contract, parsed from its source. String imports
+	// (import "Name") have no address and are reported by name.
+	Imports []string `json:"imports"`
+	// ImportedBy are the identifiers of the contracts that import it, as
+	// reported by the API
+	ImportedBy []string `json:"imported_by"`
+}
+
+// GetContractDependencies returns the imports and importers of the latest
+// version of a contract
+func (s *Service) GetContractDependencies(ctx context.Context, identifier string) (*ContractDependencies, error) {
+	if identifier == "" {
+		return nil, fmt.Errorf("contract identifier is required")
+	}
+
+	c, err := s.latestContract(ctx, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	importedBy := slices.Clone(c.ImportedBy)
+	slices.Sort(importedBy)
+	return &ContractDependencies{
+		Identifier: identifier,
+		Imports:    contractImports(c.Body),
+		ImportedBy: emptyIfNil(slices.Compact(importedBy)),
+	}, nil
+}
+
+// ContractGraph is a directed graph of contract imports. An edge A -> B means
+// contract A imports contract B.
+type ContractGraph struct {
+	// Root is the contract the graph was built from
+	Root string `json:"root"`
+	// Nodes holds the dependencies of every contract that was visited
+	Nodes map[string]*ContractDependencies `json:"nodes"`
+}
+
+// Imports returns the contracts imported by a contract in the graph
+func (g *ContractGraph) Imports(identifier string) []string {
+	if n, ok := g.Nodes[identifier]; ok {
+		return n.Imports
+	}
+	return nil
+}
+
+// ImportedBy returns the contracts that import a contract, combining the
+// importers reported by the API with edges found in the graph
+func (g *ContractGraph) ImportedBy(identifier string) []string {
+	var out []string
+	if n, ok := g.Nodes[identifier]; ok {
+		out = append(out, n.ImportedBy...)
+	}
+	for id, n := range g.Nodes {
+		if slices.Contains(n.Imports, identifier) {
+			out = append(out, id)
+		}
+	}
+	slices.Sort(out)
+	return slices.Compact(out)
+}
+
+// Dependencies returns every contract the root depends on, directly or
+// transitively, in breadth-first order
+func (g *ContractGraph) Dependencies() []string {
+	var out []string
+	seen := map[string]bool{g.Root: true}
+	queue := []string{g.Root}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, dep := range g.Imports(id) {
+			if !seen[dep] {
+				seen[dep] = true
+				out = append(out, dep)
+				queue = append(queue, dep)
+			}
+		}
+	}
+	return out
+}
+
+// GetContractGraph builds the import graph of a contract by following its
+// imports breadth-first, up to depth levels (0 fetches only the root).
+// Imports that cannot be resolved to a deployed contract, such as string
+// imports, are kept as edges but not visited.
+func (s *Service) GetContractGraph(ctx context.Context, identifier string, depth int) (*ContractGraph, error) {
+	if identifier == "" {
+		return nil, fmt.Errorf("contract identifier is required")
+	}
+
+	g := &ContractGraph{Root: identifier, Nodes: map[string]*ContractDependencies{}}
+	level := []string{identifier}
+	for d := 0; len(level) > 0 && d <= depth; d++ {
+		var next []string
+		for _, id := range level {
+			if _, ok := g.Nodes[id]; ok {
+				continue
+			}
+			deps, err := s.GetContractDependencies(ctx, id)
+			if err != nil {
+				return nil, fmt.Errorf("fetch %s: %w", id, err)
+			}
+			g.Nodes[id] = deps
+			for _, imp := range deps.Imports {
+				if _, ok := g.Nodes[imp]; !ok && strings.HasPrefix(imp, "A.") {
+					next = append(next, imp)
+				}
+			}
+		}
+		level = next
+	}
+	return g, nil
+}
+
+var (
+	cadenceImportRe       = regexp.MustCompile(`(?m)^\s*import\s+([A-Za-z_]\w*(?:\s*,\s*[A-Za-z_]\w*)*)\s+from\s+0x([0-9a-fA-F]+)`)
+	cadenceStringImportRe = regexp.MustCompile(`(?m)^\s*import\s+"([A-Za-z_]\w*)"`)
+)
+
+// contractImports returns the identifiers of the contracts imported by Cadence
+// source, in order of appearance
+func contractImports(body string) []string {
+	body = cadenceCommentRe.ReplaceAllStringFunc(body, blankOut)
+
+	imports := []string{}
+	seen := map[string]bool{}
+	add := func(id string) {
+		if !seen[id] {
+			seen[id] = true
+			imports = append(imports, id)
+		}
+	}
+	for _, m := range cadenceImportRe.FindAllStringSubmatch(body, -1) {
+		addr := strings.ToLower(m[2])
+		if len(addr) < 16 {
+			addr = strings.Repeat("0", 16-len(addr)) + addr
+		}
+		for _, name := range strings.Split(m[1], ",") {
+			add("A." + addr + "." + strings.TrimSpace(name))
+		}
+	}
+	for _, m := range cadenceStringImportRe.FindAllStringSubmatch(body, -1) {
+		add(m[1])
+	}
+	return imports
+}
@@ -0,0 +1,105 @@
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestContractImports(t *testing.T) {
+	body := `
+import FungibleToken from 0xf233dcee88fe0abe
+import NonFungibleToken, MetadataViews from 0x1d7e57aa55817448
+// import Ignored from 0x01
+import "ViewResolver"
+import Crypto from 0x01
+
+access(all) contract Example {}
+`
+	got := contractImports(body)
+	want := []string{
+		"A.f233dcee88fe0abe.FungibleToken",
+		"A.1d7e57aa55817448.NonFungibleToken",
+		"A.1d7e57aa55817448.MetadataViews",
+		"A.0000000000000001.Crypto",
+		"ViewResolver",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestFlowService_GetContractGraph(t *testing.T) {
+	contracts := map[string]Contract{
+		"A.0000000000000003.Market": {
+			Body:       "import NFT from 0x02\nimport Token from 0x01\naccess(all) contract Market {}",
+			ImportedBy: []string{"A.0000000000000004.Aggregator"},
+		},
+		"A.0000000000000002.NFT": {
+			Body:       "import Token from 0x01\naccess(all) contract NFT {}",
+			ImportedBy: []string{"A.0000000000000003.Market"},
+		},
+		"A.0000000000000001.Token": {
+			Body: "access(all) contract Token {}",
+		},
+	}
+
+	var fetched []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/flow/v1/contract/")
+		fetched = append(fetched, id)
+		c, ok := contracts[id]
+		if !ok {
+			t.Errorf("Unexpected contract %s", id)
+		}
+		c.Identifier = id
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ContractResponse{Data: []Contract{c}})
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+
+	ctx := context.Background()
+	deps, err := service.GetContractDependencies(ctx, "A.0000000000000003.Market")
+	if err != nil {
+		t.Fatalf("GetContractDependencies failed: %v", err)
+	}
+	if !slices.Equal(deps.Imports, []string{"A.0000000000000002.NFT", "A.0000000000000001.Token"}) {
+		t.Errorf("Unexpected imports %v", deps.Imports)
+	}
+	if !slices.Equal(deps.ImportedBy, []string{"A.0000000000000004.Aggregator"}) {
+		t.Errorf("Unexpected importers %v", deps.ImportedBy)
+	}
+
+	fetched = nil
+	graph, err := service.GetContractGraph(ctx, "A.0000000000000003.Market", 2)
+	if err != nil {
+		t.Fatalf("GetContractGraph failed: %v", err)
+	}
+	if len(graph.Nodes) != 3 {
+		t.Errorf("Expected 3 nodes, got %d", len(graph.Nodes))
+	}
+	if len(fetched) != 3 {
+		t.Errorf("Expected each contract fetched once, got %v", fetched)
+	}
+	if got := graph.Dependencies(); !slices.Equal(got, []string{"A.0000000000000002.NFT", "A.0000000000000001.Token"}) {
+		t.Errorf("Unexpected dependencies %v", got)
+	}
+	want := []string{"A.0000000000000002.NFT", "A.0000000000000003.Market"}
+	if got := graph.ImportedBy("A.0000000000000001.Token"); !slices.Equal(got, want) {
+		t.Errorf("Expected importers %v, got %v", want, got)
+	}
+
+	shallow, err := service.GetContractGraph(ctx, "A.0000000000000003.Market", 0)
+	if err != nil {
+		t.Fatalf("GetContractGraph failed: %v", err)
+	}
+	if len(shallow.Nodes) != 1 {
+		t.Errorf("Expected only the root at depth 0, got %d nodes", len(shallow.Nodes))
+	}
+}
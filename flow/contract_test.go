@@ -224,3 +224,54 @@ func TestFlowService_ContractRequiredFields(t *testing.T) {
 		t.Error("Expected error when ID is not provided")
 	}
 }
+
+func TestFlowService_GetAccountContracts(t *testing.T) {
+	address := "0x1654653399040a61"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var resp ContractResponse
+		switch r.URL.Path {
+		case "/flow/v1/contract":
+			if got := r.URL.Query().Get("address"); got != address {
+				t.Errorf("Expected address %s, got %s", address, got)
+			}
+			resp.Data = []Contract{
+				{Address: address, BlockHeight: 100, ContractName: "FlowToken", ID: "1", Identifier: "A.1654653399040a61.FlowToken", Body: "old"},
+				{Address: address, BlockHeight: 200, ContractName: "FlowToken", ID: "2", Identifier: "A.1654653399040a61.FlowToken"},
+				{Address: address, BlockHeight: 150, ContractName: "Burner", ID: "3", Identifier: "A.1654653399040a61.Burner", Body: "burner"},
+			}
+		case "/flow/v1/contract/A.1654653399040a61.FlowToken/2":
+			resp.Data = []Contract{
+				{Address: address, BlockHeight: 200, ContractName: "FlowToken", ID: "2", Identifier: "A.1654653399040a61.FlowToken", Body: "new"},
+			}
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := &mockClient{server: server}
+	service := NewService(client)
+
+	if err := service.GetAccountContracts().Validate(); err == nil {
+		t.Error("Expected error for missing address")
+	}
+
+	result, err := service.GetAccountContracts().Address(address).Do(context.Background())
+	if err != nil {
+		t.Fatalf("GetAccountContracts failed: %v", err)
+	}
+
+	if len(result.Data) != 2 {
+		t.Fatalf("Expected 2 contracts, got %d", len(result.Data))
+	}
+	if result.Data[0].ContractName != "Burner" || result.Data[0].Body != "burner" {
+		t.Errorf("Expected Burner with its body first, got %+v", result.Data[0])
+	}
+	if result.Data[1].BlockHeight != 200 || result.Data[1].Body != "new" {
+		t.Errorf("Expected latest FlowToken with its body, got %+v", result.Data[1])
+	}
+}
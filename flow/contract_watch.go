@@ -0,0 +1,166 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// maxContractWatchPages bounds how far back a single poll pages to find the previous window
+const maxContractWatchPages = 10
+
+// ContractChangeKind describes what happened to a contract
+type ContractChangeKind string
+
+const (
+	ContractDeployed ContractChangeKind = "deployed"
+	ContractUpdated  ContractChangeKind = "updated"
+	ContractRemoved  ContractChangeKind = "removed"
+)
+
+// ContractChange is emitted by WatchContracts for each deployed, updated or removed contract
+type ContractChange struct {
+	Kind     ContractChangeKind `json:"kind"`
+	Contract Contract           `json:"contract"`
+	Time     time.Time          `json:"time"`
+
+	// Err is set when a poll failed; the watcher keeps polling
+	Err error `json:"-"`
+}
+
+// WatchContracts polls the contracts endpoint every interval and emits a change
+// for every contract deployed, updated or removed since the previous poll, with
+// the contract code in Contract.Body. The first poll is used as the baseline and
+// is not emitted. Each poll pages back until it overlaps the previous one (up to
+// 1000 contracts), so bursts of deployments between polls are not missed.
+// Poll failures are emitted as changes with Err set. The returned channel is
+// closed when ctx is done.
+func (s *Service) WatchContracts(ctx context.Context, interval time.Duration) (<-chan ContractChange, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("interval must be positive")
+	}
+
+	out := make(chan ContractChange)
+	go func() {
+		defer close(out)
+
+		var previous map[string]Contract
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			current, err := s.recentContracts(ctx, previous)
+			if err == nil && previous != nil {
+				var changes []ContractChange
+				changes, err = s.diffContracts(ctx, previous, current)
+				for _, c := range changes {
+					if !sendContractChange(ctx, out, c) {
+						return
+					}
+				}
+			}
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				if !sendContractChange(ctx, out, ContractChange{Time: time.Now(), Err: err}) {
+					return
+				}
+			} else {
+				previous = current
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// sendContractChange delivers a change unless the context is cancelled first
+func sendContractChange(ctx context.Context, out chan<- ContractChange, change ContractChange) bool {
+	select {
+	case out <- change:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// recentContracts returns the most recent contracts by ID, paging back until a
+// page overlaps the known window
+func (s *Service) recentContracts(ctx context.Context, known map[string]Contract) (map[string]Contract, error) {
+	contracts := make(map[string]Contract)
+	for page := 0; page < maxContractWatchPages; page++ {
+		resp, err := s.GetContracts().Limit(maxPageSize).Offset(page * maxPageSize).Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		overlaps := false
+		for _, c := range resp.Data {
+			contracts[c.ID] = c
+			if _, ok := known[c.ID]; ok {
+				overlaps = true
+			}
+		}
+
+		if known == nil || overlaps || len(resp.Data) < maxPageSize {
+			break
+		}
+	}
+	return contracts, nil
+}
+
+// diffContracts compares two windows of contracts, fetching the code of new contracts when the list omits it
+func (s *Service) diffContracts(ctx context.Context, previous, current map[string]Contract) ([]ContractChange, error) {
+	now := time.Now()
+	replaced := make(map[string]bool)
+
+	var changes []ContractChange
+	for id, c := range current {
+		if _, ok := previous[id]; ok {
+			continue
+		}
+		replaced[c.Identifier] = true
+
+		if c.Body == "" {
+			resp, err := s.GetContract().Identifier(c.Identifier).ID(c.ID).Do(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("fetch contract %s: %w", c.Identifier, err)
+			}
+			if len(resp.Data) > 0 {
+				c.Body = resp.Data[0].Body
+			}
+		}
+
+		kind := ContractDeployed
+		if c.Status == string(ContractUpdated) {
+			kind = ContractUpdated
+		}
+		changes = append(changes, ContractChange{Kind: kind, Contract: c, Time: now})
+	}
+
+	// A contract whose validity ended without a new version replacing it was removed
+	for id, c := range current {
+		prev, ok := previous[id]
+		if ok && prev.ValidTo == 0 && c.ValidTo != 0 && !replaced[c.Identifier] {
+			changes = append(changes, ContractChange{Kind: ContractRemoved, Contract: c, Time: now})
+		}
+	}
+
+	// Sort for deterministic output, oldest first
+	sort.Slice(changes, func(i, j int) bool {
+		a, b := changes[i].Contract, changes[j].Contract
+		if a.ValidFrom != b.ValidFrom {
+			return a.ValidFrom < b.ValidFrom
+		}
+		return a.ID < b.ID
+	})
+	return changes, nil
+}
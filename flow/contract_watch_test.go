@@ -0,0 +1,91 @@
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestFlowService_WatchContracts(t *testing.T) {
+	var mu sync.Mutex
+	contracts := []Contract{
+		{ID: "2", Identifier: "A.02.Bar", Status: "deployed", ValidFrom: 20, Body: "contract Bar {}"},
+		{ID: "1", Identifier: "A.01.Foo", Status: "deployed", ValidFrom: 10, Body: "contract Foo {}"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		resp := ContractResponse{Data: []Contract{}}
+		switch {
+		case r.URL.Path == "/flow/v1/contract":
+			resp.Data = contracts
+		case strings.HasPrefix(r.URL.Path, "/flow/v1/contract/A.01.Foo/"):
+			resp.Data = []Contract{{ID: "3", Identifier: "A.01.Foo", Body: "contract Foo { v2 }"}}
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changes, err := service.WatchContracts(ctx, 20*time.Millisecond)
+	if err != nil {
+		t.Fatalf("WatchContracts failed: %v", err)
+	}
+
+	// Let the baseline poll complete, then update Foo (without code in the list) and remove Bar
+	time.Sleep(10 * time.Millisecond)
+	mu.Lock()
+	contracts = []Contract{
+		{ID: "3", Identifier: "A.01.Foo", Status: "updated", ValidFrom: 30},
+		{ID: "2", Identifier: "A.02.Bar", Status: "deployed", ValidFrom: 20, ValidTo: 31},
+		{ID: "1", Identifier: "A.01.Foo", Status: "deployed", ValidFrom: 10, ValidTo: 30},
+	}
+	mu.Unlock()
+
+	var got []ContractChange
+	for len(got) < 2 {
+		select {
+		case c := <-changes:
+			if c.Err != nil {
+				t.Fatalf("Unexpected error: %v", c.Err)
+			}
+			got = append(got, c)
+		case <-time.After(time.Second):
+			t.Fatalf("Timed out waiting for changes, got %d", len(got))
+		}
+	}
+
+	if got[0].Kind != ContractRemoved || got[0].Contract.Identifier != "A.02.Bar" {
+		t.Errorf("Expected Bar removed first, got %s %s", got[0].Kind, got[0].Contract.Identifier)
+	}
+	if got[1].Kind != ContractUpdated || got[1].Contract.Identifier != "A.01.Foo" {
+		t.Errorf("Expected Foo updated, got %s %s", got[1].Kind, got[1].Contract.Identifier)
+	}
+	if got[1].Contract.Body != "contract Foo { v2 }" {
+		t.Errorf("Expected fetched contract code, got %q", got[1].Contract.Body)
+	}
+
+	cancel()
+	for range changes {
+	}
+}
+
+func TestFlowService_WatchContractsInvalidInterval(t *testing.T) {
+	service := NewService(&mockClient{})
+	if _, err := service.WatchContracts(context.Background(), 0); err == nil {
+		t.Error("Expected error for non-positive interval")
+	}
+}
@@ -0,0 +1,247 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Epoch represents the staking statistics of an epoch
+type Epoch struct {
+	APY             float64 `json:"apy"`
+	Counter         uint64  `json:"epoch"`
+	Payout          float64 `json:"payout"`
+	StakeAPY        float64 `json:"stake_apy"`
+	Staked          float64 `json:"staked"`
+	Timestamp       string  `json:"timestamp"`
+	TotalDelegators int     `json:"total_delegators"`
+	TotalNodes      int     `json:"total_nodes"`
+	TotalValidators int     `json:"total_validators"`
+}
+
+// EpochResponse represents the response from the epoch statistics endpoint
+type EpochResponse = Response[Epoch]
+
+// EpochStatus represents the progress of the current epoch
+type EpochStatus struct {
+	Counter        uint64  `json:"epoch"`
+	Duration       int64   `json:"duration"`
+	EndView        uint64  `json:"endView"`
+	Height         uint64  `json:"height"`
+	Left           int64   `json:"left"`
+	PercentageLeft float64 `json:"percentageLeft"`
+	StakingView    uint64  `json:"stakingView"`
+	StartView      uint64  `json:"startView"`
+	Timestamp      int64   `json:"timestamp"`
+	View           uint64  `json:"view"`
+}
+
+// InStakingPhase reports whether the epoch is still in its staking phase, when
+// nodes and delegators can change their stake for the next epoch
+func (s EpochStatus) InStakingPhase() bool {
+	return s.View <= s.StakingView
+}
+
+// EpochStatusResponse represents the response from the epoch status endpoint
+type EpochStatusResponse = Response[EpochStatus]
+
+// EpochPayout represents an epoch reward payout event
+type EpochPayout struct {
+	BlockHeight uint64                 `json:"block_height"`
+	Epoch       string                 `json:"epoch"`
+	Fields      map[string]interface{} `json:"fields"`
+	Timestamp   string                 `json:"timestamp"`
+}
+
+// EpochPayoutResponse represents the response from the epoch payout endpoint
+type EpochPayoutResponse = Response[EpochPayout]
+
+// EpochsRequestBuilder builds a request to get epoch statistics
+type EpochsRequestBuilder struct {
+	service *Service
+}
+
+// GetEpochs creates a new epochs request builder
+func (s *Service) GetEpochs() *EpochsRequestBuilder {
+	return &EpochsRequestBuilder{service: s}
+}
+
+// Clone returns an independent copy of the epochs request builder
+func (b *EpochsRequestBuilder) Clone() *EpochsRequestBuilder {
+	c := *b
+	return &c
+}
+
+// Validate checks the epochs request without making a network call
+func (b *EpochsRequestBuilder) Validate() error {
+	return nil
+}
+
+// Do executes the epochs request
+func (b *EpochsRequestBuilder) Do(ctx context.Context) (*EpochResponse, error) {
+	resp, err := b.service.client.DoRequest(ctx, http.MethodGet, "/status/v1/epoch/stat", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var epochResp EpochResponse
+	if err := b.service.client.DecodeResponse(resp, &epochResp); err != nil {
+		return nil, err
+	}
+	epochResp.Data = emptyIfNil(epochResp.Data)
+
+	return &epochResp, nil
+}
+
+// EpochRequestBuilder builds a request to get a specific epoch
+type EpochRequestBuilder struct {
+	service *Service
+	counter *uint64
+}
+
+// GetEpoch creates a new epoch request builder
+func (s *Service) GetEpoch() *EpochRequestBuilder {
+	return &EpochRequestBuilder{service: s}
+}
+
+// Clone returns an independent copy of the epoch request builder
+func (b *EpochRequestBuilder) Clone() *EpochRequestBuilder {
+	c := *b
+	return &c
+}
+
+// Counter sets the epoch counter (required)
+func (b *EpochRequestBuilder) Counter(counter uint64) *EpochRequestBuilder {
+	b.counter = &counter
+	return b
+}
+
+// Validate checks the epoch request without making a network call
+func (b *EpochRequestBuilder) Validate() error {
+	if b.counter == nil {
+		return fmt.Errorf("epoch counter is required")
+	}
+	return nil
+}
+
+// Do executes the epoch request
+func (b *EpochRequestBuilder) Do(ctx context.Context) (*Epoch, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := b.service.GetEpochs().Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range resp.Data {
+		if resp.Data[i].Counter == *b.counter {
+			return &resp.Data[i], nil
+		}
+	}
+	return nil, fmt.Errorf("epoch %d not found", *b.counter)
+}
+
+// EpochStatusRequestBuilder builds a request to get the current epoch status
+type EpochStatusRequestBuilder struct {
+	service *Service
+}
+
+// GetEpochStatus creates a new epoch status request builder
+func (s *Service) GetEpochStatus() *EpochStatusRequestBuilder {
+	return &EpochStatusRequestBuilder{service: s}
+}
+
+// Clone returns an independent copy of the epoch status request builder
+func (b *EpochStatusRequestBuilder) Clone() *EpochStatusRequestBuilder {
+	c := *b
+	return &c
+}
+
+// Validate checks the epoch status request without making a network call
+func (b *EpochStatusRequestBuilder) Validate() error {
+	return nil
+}
+
+// Do executes the epoch status request
+func (b *EpochStatusRequestBuilder) Do(ctx context.Context) (*EpochStatus, error) {
+	resp, err := b.service.client.DoRequest(ctx, http.MethodGet, "/status/v1/epoch/status", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var statusResp EpochStatusResponse
+	if err := b.service.client.DecodeResponse(resp, &statusResp); err != nil {
+		return nil, err
+	}
+	if len(statusResp.Data) == 0 {
+		return nil, fmt.Errorf("epoch status not found")
+	}
+
+	return &statusResp.Data[0], nil
+}
+
+// EpochRewardsRequestBuilder builds a request to get epoch reward payouts
+type EpochRewardsRequestBuilder struct {
+	service *Service
+	limit   *int
+	offset  *int
+}
+
+// GetEpochRewards creates a new epoch rewards request builder
+func (s *Service) GetEpochRewards() *EpochRewardsRequestBuilder {
+	return &EpochRewardsRequestBuilder{service: s}
+}
+
+// Clone returns an independent copy of the epoch rewards request builder
+func (b *EpochRewardsRequestBuilder) Clone() *EpochRewardsRequestBuilder {
+	c := *b
+	return &c
+}
+
+// Limit sets the number of records to return (optional, default 25, max 100)
+func (b *EpochRewardsRequestBuilder) Limit(limit int) *EpochRewardsRequestBuilder {
+	b.limit = &limit
+	return b
+}
+
+// Offset sets the pagination offset (optional)
+func (b *EpochRewardsRequestBuilder) Offset(offset int) *EpochRewardsRequestBuilder {
+	b.offset = &offset
+	return b
+}
+
+// Validate checks the epoch rewards request without making a network call
+func (b *EpochRewardsRequestBuilder) Validate() error {
+	return validatePage(b.limit, b.offset)
+}
+
+// Do executes the epoch rewards request
+func (b *EpochRewardsRequestBuilder) Do(ctx context.Context) (*EpochPayoutResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	if b.limit != nil {
+		query.Set("limit", strconv.Itoa(*b.limit))
+	}
+	if b.offset != nil {
+		query.Set("offset", strconv.Itoa(*b.offset))
+	}
+
+	resp, err := b.service.client.DoRequest(ctx, http.MethodGet, "/public/v1/epoch/payout", query)
+	if err != nil {
+		return nil, err
+	}
+
+	var payoutResp EpochPayoutResponse
+	if err := b.service.client.DecodeResponse(resp, &payoutResp); err != nil {
+		return nil, err
+	}
+	payoutResp.Data = emptyIfNil(payoutResp.Data)
+
+	return &payoutResp, nil
+}
@@ -0,0 +1,100 @@
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFlowService_GetEpochs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/status/v1/epoch/stat" {
+			t.Errorf("Expected path /status/v1/epoch/stat, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[
+			{"epoch":101,"apy":6.1,"stake_apy":8.2,"payout":1300000.5,"staked":800000000,"total_nodes":450,"total_delegators":21000,"total_validators":120},
+			{"epoch":100,"apy":6.0,"payout":1290000}
+		]}`))
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	ctx := context.Background()
+
+	resp, err := service.GetEpochs().Do(ctx)
+	if err != nil {
+		t.Fatalf("GetEpochs failed: %v", err)
+	}
+	if len(resp.Data) != 2 {
+		t.Fatalf("Expected 2 epochs, got %d", len(resp.Data))
+	}
+	if resp.Data[0].Counter != 101 || resp.Data[0].TotalNodes != 450 || resp.Data[0].StakeAPY != 8.2 {
+		t.Errorf("Unexpected epoch %+v", resp.Data[0])
+	}
+
+	epoch, err := service.GetEpoch().Counter(100).Do(ctx)
+	if err != nil {
+		t.Fatalf("GetEpoch failed: %v", err)
+	}
+	if epoch.Payout != 1290000 {
+		t.Errorf("Expected payout 1290000, got %f", epoch.Payout)
+	}
+
+	if _, err := service.GetEpoch().Counter(5).Do(ctx); err == nil {
+		t.Error("Expected error for unknown epoch")
+	}
+	if _, err := service.GetEpoch().Do(ctx); err == nil {
+		t.Error("Expected error without counter")
+	}
+}
+
+func TestFlowService_GetEpochStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/status/v1/epoch/status" {
+			t.Errorf("Expected path /status/v1/epoch/status, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[{"epoch":101,"view":1500,"startView":1000,"stakingView":1200,"endView":2000,"percentageLeft":50}]}`))
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	status, err := service.GetEpochStatus().Do(context.Background())
+	if err != nil {
+		t.Fatalf("GetEpochStatus failed: %v", err)
+	}
+	if status.Counter != 101 || status.EndView != 2000 {
+		t.Errorf("Unexpected status %+v", status)
+	}
+	if status.InStakingPhase() {
+		t.Error("Expected staking phase to have ended")
+	}
+}
+
+func TestFlowService_GetEpochRewards(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/public/v1/epoch/payout" {
+			t.Errorf("Expected path /public/v1/epoch/payout, got %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("limit") != "5" {
+			t.Errorf("Expected limit 5, got %s", r.URL.Query().Get("limit"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(EpochPayoutResponse{Data: []EpochPayout{
+			{BlockHeight: 90000000, Epoch: "101", Fields: map[string]interface{}{"total": "1300000.5"}},
+		}})
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	resp, err := service.GetEpochRewards().Limit(5).Do(context.Background())
+	if err != nil {
+		t.Fatalf("GetEpochRewards failed: %v", err)
+	}
+	if len(resp.Data) != 1 || resp.Data[0].Epoch != "101" {
+		t.Errorf("Unexpected payouts %+v", resp.Data)
+	}
+}
@@ -0,0 +1,261 @@
+package flow
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// EventDetails is an event with, when requested, the transaction that emitted it
+type EventDetails struct {
+	ContractEvent
+	// Transaction is the emitting transaction, set when IncludeTransaction is enabled
+	Transaction *TransactionDetails `json:"transaction,omitempty"`
+}
+
+// EventsResponse represents the events matching an events query
+type EventsResponse struct {
+	Data []EventDetails `json:"data"`
+	// EventTypes are the fully qualified event types that were queried
+	EventTypes []string `json:"event_types"`
+	// Total is the number of matching events, before Limit and Offset
+	Total int `json:"total"`
+}
+
+// EventsRequestBuilder builds a request to query events across types
+type EventsRequestBuilder struct {
+	service            *Service
+	names              []string
+	contracts          []string
+	fromHeight         uint64
+	toHeight           uint64
+	account            string
+	includeTransaction bool
+	limit              *int
+	offset             *int
+}
+
+// GetEvents creates a new events request builder. The API only queries events
+// by name, so each requested type is queried over the height range and the
+// results are merged in chain order before filtering and pagination.
+func (s *Service) GetEvents() *EventsRequestBuilder {
+	return &EventsRequestBuilder{service: s}
+}
+
+// Clone returns an independent copy of the events request builder
+func (b *EventsRequestBuilder) Clone() *EventsRequestBuilder {
+	c := *b
+	c.names = slices.Clone(b.names)
+	c.contracts = slices.Clone(b.contracts)
+	return &c
+}
+
+// Names adds fully qualified event types to query, e.g.
+// A.1654653399040a61.FlowToken.TokensDeposited (Names or Contract required)
+func (b *EventsRequestBuilder) Names(names ...string) *EventsRequestBuilder {
+	b.names = append(b.names, names...)
+	return b
+}
+
+// Contract adds every event declared by a contract, e.g.
+// A.1654653399040a61.FlowToken (Names or Contract required)
+func (b *EventsRequestBuilder) Contract(identifier string) *EventsRequestBuilder {
+	b.contracts = append(b.contracts, identifier)
+	return b
+}
+
+// FromHeight sets the first block height of the range, inclusive (required)
+func (b *EventsRequestBuilder) FromHeight(height uint64) *EventsRequestBuilder {
+	b.fromHeight = height
+	return b
+}
+
+// ToHeight sets the last block height of the range, inclusive (required)
+func (b *EventsRequestBuilder) ToHeight(height uint64) *EventsRequestBuilder {
+	b.toHeight = height
+	return b
+}
+
+// Account keeps only events with a field referencing the address, such as the
+// from or to of a deposit or withdrawal (optional)
+func (b *EventsRequestBuilder) Account(address string) *EventsRequestBuilder {
+	b.account = address
+	return b
+}
+
+// IncludeTransaction attaches the emitting transaction to each returned event
+// (optional, default false). Each distinct transaction is fetched once.
+func (b *EventsRequestBuilder) IncludeTransaction(include bool) *EventsRequestBuilder {
+	b.includeTransaction = include
+	return b
+}
+
+// Limit sets the number of merged events to return (optional, default all)
+func (b *EventsRequestBuilder) Limit(limit int) *EventsRequestBuilder {
+	b.limit = &limit
+	return b
+}
+
+// Offset sets the number of merged events to skip (optional)
+func (b *EventsRequestBuilder) Offset(offset int) *EventsRequestBuilder {
+	b.offset = &offset
+	return b
+}
+
+// Validate checks the events request without making a network call
+func (b *EventsRequestBuilder) Validate() error {
+	if len(b.names) == 0 && len(b.contracts) == 0 {
+		return fmt.Errorf("event name or contract is required")
+	}
+	if err := checkHeightRange(b.fromHeight, b.toHeight); err != nil {
+		return err
+	}
+	if b.account != "" {
+		if err := validateAddress(b.account); err != nil {
+			return err
+		}
+	}
+	if b.limit != nil && *b.limit < 0 {
+		return fmt.Errorf("limit %d is negative", *b.limit)
+	}
+	return validatePage(nil, b.offset)
+}
+
+// Do executes the events request
+func (b *EventsRequestBuilder) Do(ctx context.Context) (*EventsResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	types := slices.Clone(b.names)
+	for _, identifier := range b.contracts {
+		declared, err := b.service.ContractEventTypes(ctx, identifier)
+		if err != nil {
+			return nil, err
+		}
+		types = append(types, declared...)
+	}
+	slices.Sort(types)
+	types = slices.Compact(types)
+
+	events, err := b.service.eventsByNames(ctx, types, b.fromHeight, b.toHeight)
+	if err != nil {
+		return nil, err
+	}
+	if b.account != "" {
+		events = slices.DeleteFunc(events, func(e ContractEvent) bool {
+			return !referencesAddress(e.Fields, b.account)
+		})
+	}
+
+	total := len(events)
+	events = paginate(events, b.limit, b.offset)
+
+	data := make([]EventDetails, len(events))
+	txs := map[string]*TransactionDetails{}
+	for i, e := range events {
+		data[i].ContractEvent = e
+		if !b.includeTransaction || e.TransactionHash == "" {
+			continue
+		}
+		tx, ok := txs[e.TransactionHash]
+		if !ok {
+			resp, err := b.service.GetTransaction().ID(e.TransactionHash).Do(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("fetch transaction %s: %w", e.TransactionHash, err)
+			}
+			if len(resp.Data) > 0 {
+				tx = &resp.Data[0]
+			}
+			txs[e.TransactionHash] = tx
+		}
+		data[i].Transaction = tx
+	}
+
+	return &EventsResponse{Data: data, EventTypes: types, Total: total}, nil
+}
+
+// eventsByNames fetches every event of the given types in a height range,
+// merged in chain order
+func (s *Service) eventsByNames(ctx context.Context, names []string, from, to uint64) ([]ContractEvent, error) {
+	events := []ContractEvent{}
+	for _, name := range names {
+		page, err := collectPages(func(offset int) ([]ContractEvent, error) {
+			return s.eventsByName(ctx, name, from, to, offset)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fetch %s events: %w", name, err)
+		}
+		events = append(events, page...)
+	}
+
+	slices.SortStableFunc(events, func(a, b ContractEvent) int {
+		if c := cmp.Compare(a.BlockHeight, b.BlockHeight); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.TransactionHash, b.TransactionHash); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.EventIndex, b.EventIndex)
+	})
+	return events, nil
+}
+
+// paginate applies an optional offset and limit to a merged result
+func paginate[T any](items []T, limit, offset *int) []T {
+	if offset != nil {
+		items = items[min(max(*offset, 0), len(items)):]
+	}
+	if limit != nil && *limit >= 0 && *limit < len(items) {
+		items = items[:*limit]
+	}
+	return items
+}
+
+// referencesAddress reports whether any value in v, searched recursively, is
+// the address. Addresses are compared without case or 0x prefix.
+func referencesAddress(v interface{}, address string) bool {
+	switch v := v.(type) {
+	case string:
+		return normalizeAddress(v) == normalizeAddress(address)
+	case map[string]interface{}:
+		for _, f := range v {
+			if referencesAddress(f, address) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, f := range v {
+			if referencesAddress(f, address) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// unmarshalFields decodes raw event fields into a map, leaving it nil when
+// the event has no fields
+func unmarshalFields(raw json.RawMessage, fields *map[string]interface{}) error {
+	if len(raw) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(raw, fields); err != nil {
+		return fmt.Errorf("invalid event fields: %w", err)
+	}
+	return nil
+}
+
+// decodeFields decodes event fields into v, from the raw bytes when they were
+// kept so large numbers keep their precision
+func decodeFields(raw json.RawMessage, fields map[string]interface{}, v any) error {
+	if len(raw) == 0 {
+		var err error
+		if raw, err = json.Marshal(fields); err != nil {
+			return err
+		}
+	}
+	return json.Unmarshal(raw, v)
+}
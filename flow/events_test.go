@@ -0,0 +1,92 @@
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestFlowService_GetEvents(t *testing.T) {
+	deposited := "A.1654653399040a61.FlowToken.TokensDeposited"
+	withdrawn := "A.1654653399040a61.FlowToken.TokensWithdrawn"
+	txFetches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/simple/v1/events":
+			q := r.URL.Query()
+			var events []ContractEvent
+			switch q.Get("name") {
+			case deposited:
+				events = []ContractEvent{
+					{Name: deposited, BlockHeight: 150, EventIndex: 1, TransactionHash: "tx1", Fields: map[string]interface{}{"amount": "1.0", "to": "0xABC"}},
+					{Name: deposited, BlockHeight: 160, EventIndex: 0, TransactionHash: "tx2", Fields: map[string]interface{}{"amount": "2.0", "to": "0xdef"}},
+				}
+			case withdrawn:
+				events = []ContractEvent{
+					{Name: withdrawn, BlockHeight: 150, EventIndex: 0, TransactionHash: "tx1", Fields: map[string]interface{}{"amount": "1.0", "from": "0x123"}},
+				}
+			default:
+				t.Errorf("Unexpected event name %s", q.Get("name"))
+			}
+			json.NewEncoder(w).Encode(contractEventsResponse{Events: events})
+		case "/flow/v1/transaction/tx1":
+			txFetches++
+			json.NewEncoder(w).Encode(TransactionResponse{Data: []TransactionDetails{{ID: "tx1", Payer: "0x123"}}})
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	ctx := context.Background()
+
+	result, err := service.GetEvents().Names(deposited, withdrawn, deposited).FromHeight(100).ToHeight(200).Do(ctx)
+	if err != nil {
+		t.Fatalf("GetEvents failed: %v", err)
+	}
+	if !slices.Equal(result.EventTypes, []string{deposited, withdrawn}) {
+		t.Errorf("Expected deduplicated event types, got %v", result.EventTypes)
+	}
+	if result.Total != 3 || len(result.Data) != 3 {
+		t.Fatalf("Expected 3 events, got %d (total %d)", len(result.Data), result.Total)
+	}
+	if result.Data[0].Name != withdrawn || result.Data[2].TransactionHash != "tx2" {
+		t.Errorf("Expected events in chain order, got %+v", result.Data)
+	}
+	if result.Data[0].Transaction != nil {
+		t.Error("Expected no transaction without IncludeTransaction")
+	}
+
+	filtered, err := service.GetEvents().Names(deposited, withdrawn).FromHeight(100).ToHeight(200).
+		Account("abc").IncludeTransaction(true).Do(ctx)
+	if err != nil {
+		t.Fatalf("GetEvents failed: %v", err)
+	}
+	if filtered.Total != 1 || filtered.Data[0].TransactionHash != "tx1" {
+		t.Fatalf("Expected only the deposit to 0xABC, got %+v", filtered.Data)
+	}
+	if filtered.Data[0].Transaction == nil || filtered.Data[0].Transaction.Payer != "0x123" {
+		t.Errorf("Expected attached transaction, got %+v", filtered.Data[0].Transaction)
+	}
+
+	both, err := service.GetEvents().Names(withdrawn, deposited).FromHeight(100).ToHeight(200).
+		Limit(2).IncludeTransaction(true).Do(ctx)
+	if err != nil {
+		t.Fatalf("GetEvents failed: %v", err)
+	}
+	if len(both.Data) != 2 || both.Data[0].Transaction != both.Data[1].Transaction {
+		t.Errorf("Expected both tx1 events to share one transaction, got %+v", both.Data)
+	}
+	if txFetches != 2 {
+		t.Errorf("Expected one transaction fetch per query, got %d", txFetches)
+	}
+
+	if _, err := service.GetEvents().FromHeight(100).ToHeight(200).Do(ctx); err == nil {
+		t.Error("Expected error without event names or contract")
+	}
+}
@@ -22,24 +22,19 @@ type EvmToken struct {
 }
 
 // EvmTokenResponse represents the response from the EVM tokens endpoint
-type EvmTokenResponse struct {
-	Data  []EvmToken             `json:"data"`
-	Links map[string]string      `json:"_links"`
-	Meta  map[string]interface{} `json:"_meta"`
-	Error interface{}            `json:"error,omitempty"`
-}
+type EvmTokenResponse = Response[EvmToken]
 
 // EvmTransaction represents an EVM transaction
 type EvmTransaction struct {
 	BlockNumber                     uint64 `json:"block_number"`
 	From                            string `json:"from"`
-	GasLimit                        string `json:"gas_limit"`
-	GasPrice                        string `json:"gas_price"`
-	GasUsed                         string `json:"gas_used"`
+	GasLimit                        Number `json:"gas_limit"`
+	GasPrice                        Number `json:"gas_price"`
+	GasUsed                         Number `json:"gas_used"`
 	HasErrorInInternalTransactions  bool   `json:"has_error_in_internal_transactions"`
 	Hash                            string `json:"hash"`
-	MaxFeePerGas                    string `json:"max_fee_per_gas"`
-	MaxPriorityFeePerGas            string `json:"max_priority_fee_per_gas"`
+	MaxFeePerGas                    Number `json:"max_fee_per_gas"`
+	MaxPriorityFeePerGas            Number `json:"max_priority_fee_per_gas"`
 	Nonce                           int    `json:"nonce"`
 	R                               string `json:"r"`
 	S                               string `json:"s"`
@@ -49,16 +44,11 @@ type EvmTransaction struct {
 	TransactionIndex                int    `json:"transaction_index"`
 	Type                            int    `json:"type"`
 	V                               string `json:"v"`
-	Value                           string `json:"value"`
+	Value                           Number `json:"value"`
 }
 
 // EvmTransactionResponse represents the response from the EVM transactions list endpoint
-type EvmTransactionResponse struct {
-	Data  []EvmTransaction       `json:"data"`
-	Links map[string]string      `json:"_links"`
-	Meta  map[string]interface{} `json:"_meta"`
-	Error interface{}            `json:"error,omitempty"`
-}
+type EvmTransactionResponse = Response[EvmTransaction]
 
 // EvmTokensRequestBuilder builds a request to get EVM tokens
 type EvmTokensRequestBuilder struct {
@@ -74,6 +64,12 @@ func (s *Service) GetEvmTokens() *EvmTokensRequestBuilder {
 	return &EvmTokensRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the EVM tokens request builder
+func (b *EvmTokensRequestBuilder) Clone() *EvmTokensRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Type sets the token type filter (optional)
 func (b *EvmTokensRequestBuilder) Type(typ string) *EvmTokensRequestBuilder {
 	b.typ = &typ
@@ -98,8 +94,17 @@ func (b *EvmTokensRequestBuilder) Offset(offset int) *EvmTokensRequestBuilder {
 	return b
 }
 
+// Validate checks the EVM tokens request without making a network call
+func (b *EvmTokensRequestBuilder) Validate() error {
+	return validatePage(b.limit, b.offset)
+}
+
 // Do executes the EVM tokens request
 func (b *EvmTokensRequestBuilder) Do(ctx context.Context) (*EvmTokenResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
 	query := url.Values{}
 	if b.typ != nil {
 		query.Set("type", *b.typ)
@@ -123,6 +128,7 @@ func (b *EvmTokensRequestBuilder) Do(ctx context.Context) (*EvmTokenResponse, er
 	if err := b.service.client.DecodeResponse(resp, &tokenResp); err != nil {
 		return nil, err
 	}
+	tokenResp.Data = emptyIfNil(tokenResp.Data)
 
 	return &tokenResp, nil
 }
@@ -140,6 +146,12 @@ func (s *Service) GetEvmToken() *EvmTokenRequestBuilder {
 	return &EvmTokenRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the EVM token request builder
+func (b *EvmTokenRequestBuilder) Clone() *EvmTokenRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Address sets the token contract address (required)
 func (b *EvmTokenRequestBuilder) Address(address string) *EvmTokenRequestBuilder {
 	b.address = address
@@ -158,10 +170,21 @@ func (b *EvmTokenRequestBuilder) Offset(offset int) *EvmTokenRequestBuilder {
 	return b
 }
 
+// Validate checks the EVM token request without making a network call
+func (b *EvmTokenRequestBuilder) Validate() error {
+	if b.address == "" {
+		return fmt.Errorf("token address is required")
+	}
+	if _, err := ParseEvmAddress(b.address); err != nil {
+		return err
+	}
+	return validatePage(b.limit, b.offset)
+}
+
 // Do executes the EVM token request
 func (b *EvmTokenRequestBuilder) Do(ctx context.Context) (*EvmTokenResponse, error) {
-	if b.address == "" {
-		return nil, fmt.Errorf("token address is required")
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	query := url.Values{}
@@ -182,6 +205,7 @@ func (b *EvmTokenRequestBuilder) Do(ctx context.Context) (*EvmTokenResponse, err
 	if err := b.service.client.DecodeResponse(resp, &tokenResp); err != nil {
 		return nil, err
 	}
+	tokenResp.Data = emptyIfNil(tokenResp.Data)
 
 	return &tokenResp, nil
 }
@@ -199,6 +223,12 @@ func (s *Service) GetEvmTransactions() *EvmTransactionsRequestBuilder {
 	return &EvmTransactionsRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the EVM transactions request builder
+func (b *EvmTransactionsRequestBuilder) Clone() *EvmTransactionsRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Height sets the block height filter (optional)
 func (b *EvmTransactionsRequestBuilder) Height(height uint64) *EvmTransactionsRequestBuilder {
 	b.height = &height
@@ -217,8 +247,17 @@ func (b *EvmTransactionsRequestBuilder) Offset(offset int) *EvmTransactionsReque
 	return b
 }
 
+// Validate checks the EVM transactions request without making a network call
+func (b *EvmTransactionsRequestBuilder) Validate() error {
+	return validatePage(b.limit, b.offset)
+}
+
 // Do executes the EVM transactions request
 func (b *EvmTransactionsRequestBuilder) Do(ctx context.Context) (*EvmTransactionResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
 	query := url.Values{}
 	if b.height != nil {
 		query.Set("height", strconv.FormatUint(*b.height, 10))
@@ -239,6 +278,7 @@ func (b *EvmTransactionsRequestBuilder) Do(ctx context.Context) (*EvmTransaction
 	if err := b.service.client.DecodeResponse(resp, &txResp); err != nil {
 		return nil, err
 	}
+	txResp.Data = emptyIfNil(txResp.Data)
 
 	return &txResp, nil
 }
@@ -254,16 +294,30 @@ func (s *Service) GetEvmTransaction() *EvmTransactionRequestBuilder {
 	return &EvmTransactionRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the EVM transaction request builder
+func (b *EvmTransactionRequestBuilder) Clone() *EvmTransactionRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Hash sets the transaction hash (required)
 func (b *EvmTransactionRequestBuilder) Hash(hash string) *EvmTransactionRequestBuilder {
 	b.hash = hash
 	return b
 }
 
+// Validate checks the EVM transaction request without making a network call
+func (b *EvmTransactionRequestBuilder) Validate() error {
+	if b.hash == "" {
+		return fmt.Errorf("transaction hash is required")
+	}
+	return nil
+}
+
 // Do executes the EVM transaction request
 func (b *EvmTransactionRequestBuilder) Do(ctx context.Context) (*EvmTransaction, error) {
-	if b.hash == "" {
-		return nil, fmt.Errorf("transaction hash is required")
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	path := fmt.Sprintf("/flow/v1/evm/transaction/%s", b.hash)
@@ -0,0 +1,161 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+)
+
+// maxEvmBlockProbes bounds the lookups GetEvmBlock makes while homing in on
+// the Cadence block of an EVM block number
+const maxEvmBlockProbes = 8
+
+// EvmBlock is an EVM block header, derived from the Cadence block that
+// contains it. The API does not expose EVM block hashes.
+type EvmBlock struct {
+	// Number is the EVM block number
+	Number uint64 `json:"number"`
+	// CadenceHeight and CadenceBlockID identify the Cadence block the EVM
+	// block was produced in
+	CadenceHeight    uint64 `json:"cadence_height"`
+	CadenceBlockID   string `json:"cadence_block_id"`
+	Timestamp        string `json:"timestamp"`
+	TransactionCount int    `json:"transaction_count"`
+	// GasUsed is the total gas used reported for the Cadence block
+	GasUsed int `json:"gas_used"`
+}
+
+// EvmBlockFromBlock returns the EVM block contained in a Cadence block, if any
+func EvmBlockFromBlock(b Block) (EvmBlock, bool) {
+	if b.Evm == nil {
+		return EvmBlock{}, false
+	}
+	return EvmBlock{
+		Number:           b.Evm.BlockHeight,
+		CadenceHeight:    b.Height,
+		CadenceBlockID:   b.ID,
+		Timestamp:        b.Timestamp,
+		TransactionCount: b.EvmTxCount,
+		GasUsed:          b.TotalGasUsed,
+	}, true
+}
+
+// EvmBlocksRequestBuilder builds a request to get EVM blocks
+type EvmBlocksRequestBuilder struct {
+	service *Service
+	height  *uint64
+	limit   *int
+	offset  *int
+}
+
+// GetEvmBlocks creates a new EVM blocks request builder. Blocks are listed
+// newest first from the Cadence blocks endpoint; Cadence blocks without an
+// EVM block are skipped, so a page may hold fewer than Limit blocks.
+func (s *Service) GetEvmBlocks() *EvmBlocksRequestBuilder {
+	return &EvmBlocksRequestBuilder{service: s}
+}
+
+// Clone returns an independent copy of the EVM blocks request builder
+func (b *EvmBlocksRequestBuilder) Clone() *EvmBlocksRequestBuilder {
+	c := *b
+	return &c
+}
+
+// Height sets the Cadence block height to start from (optional, descending)
+func (b *EvmBlocksRequestBuilder) Height(height uint64) *EvmBlocksRequestBuilder {
+	b.height = &height
+	return b
+}
+
+// Limit sets the number of Cadence blocks to scan (optional, default 25, max 100)
+func (b *EvmBlocksRequestBuilder) Limit(limit int) *EvmBlocksRequestBuilder {
+	b.limit = &limit
+	return b
+}
+
+// Offset sets the pagination offset (optional)
+func (b *EvmBlocksRequestBuilder) Offset(offset int) *EvmBlocksRequestBuilder {
+	b.offset = &offset
+	return b
+}
+
+// Validate checks the EVM blocks request without making a network call
+func (b *EvmBlocksRequestBuilder) Validate() error {
+	return validatePage(b.limit, b.offset)
+}
+
+// Do executes the EVM blocks request
+func (b *EvmBlocksRequestBuilder) Do(ctx context.Context) ([]EvmBlock, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	req := b.service.GetBlocks()
+	if b.height != nil {
+		req.Height(*b.height)
+	}
+	if b.limit != nil {
+		req.Limit(*b.limit)
+	}
+	if b.offset != nil {
+		req.Offset(*b.offset)
+	}
+
+	resp, err := req.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := []EvmBlock{}
+	for _, blk := range resp.Data {
+		if eb, ok := EvmBlockFromBlock(blk); ok {
+			blocks = append(blocks, eb)
+		}
+	}
+	return blocks, nil
+}
+
+// GetEvmBlock returns the EVM block with the given number. Starting from the
+// chain head, it jumps by the difference between the EVM block number found
+// and the one wanted until the containing Cadence block is reached.
+func (s *Service) GetEvmBlock(ctx context.Context, number uint64) (*EvmBlock, error) {
+	latest, err := s.GetLatestBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	height := latest.Height
+	for range maxEvmBlockProbes {
+		resp, err := s.GetBlocks().Height(height).Limit(maxPageSize).Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// Use the newest block carrying EVM data as the reference point
+		var ref *EvmBlock
+		for _, blk := range resp.Data {
+			eb, ok := EvmBlockFromBlock(blk)
+			if !ok {
+				continue
+			}
+			if eb.Number == number {
+				return &eb, nil
+			}
+			if ref == nil {
+				ref = &eb
+			}
+		}
+		if ref == nil {
+			return nil, fmt.Errorf("no EVM blocks found at or below height %d", height)
+		}
+		if number > ref.Number && height == latest.Height {
+			return nil, fmt.Errorf("EVM block %d not found: latest is %d", number, ref.Number)
+		}
+
+		next := int64(ref.CadenceHeight) + int64(number) - int64(ref.Number)
+		if next <= 0 {
+			return nil, fmt.Errorf("EVM block %d not found", number)
+		}
+		height = min(uint64(next)+maxPageSize/2, latest.Height)
+	}
+	return nil, fmt.Errorf("EVM block %d not found after %d lookups", number, maxEvmBlockProbes)
+}
@@ -0,0 +1,99 @@
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// evmChainServer serves Cadence blocks first..last where every block not
+// divisible by 7 carries the next EVM block number
+func evmChainServer(t *testing.T, first, last uint64) (*httptest.Server, *int) {
+	evm := map[uint64]uint64{}
+	n := uint64(1)
+	for h := first; h <= last; h++ {
+		if h%7 != 0 {
+			evm[h] = n
+			n++
+		}
+	}
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/flow/v1/block" {
+			t.Errorf("Expected path /flow/v1/block, got %s", r.URL.Path)
+		}
+		top := last
+		if h := r.URL.Query().Get("height"); h != "" {
+			top, _ = strconv.ParseUint(h, 10, 64)
+		}
+		limit := 25
+		if l := r.URL.Query().Get("limit"); l != "" {
+			limit, _ = strconv.Atoi(l)
+		}
+
+		var blocks []Block
+		for h := top; h >= first && len(blocks) < limit; h-- {
+			blk := Block{Height: h, ID: "id" + strconv.FormatUint(h, 10)}
+			if num, ok := evm[h]; ok {
+				blk.Evm = &EvmData{BlockHeight: num}
+				blk.EvmTxCount = 1
+			}
+			blocks = append(blocks, blk)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(BlockResponse{Data: blocks})
+	}))
+	return server, &requests
+}
+
+func TestFlowService_GetEvmBlocks(t *testing.T) {
+	server, _ := evmChainServer(t, 1, 100)
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	blocks, err := service.GetEvmBlocks().Height(14).Limit(8).Do(context.Background())
+	if err != nil {
+		t.Fatalf("GetEvmBlocks failed: %v", err)
+	}
+	// Heights 14..7 with 14 and 7 carrying no EVM block
+	if len(blocks) != 6 {
+		t.Fatalf("Expected 6 EVM blocks, got %d", len(blocks))
+	}
+	if blocks[0].CadenceHeight != 13 || blocks[0].Number != 12 {
+		t.Errorf("Expected EVM block 12 at height 13, got %+v", blocks[0])
+	}
+}
+
+func TestFlowService_GetEvmBlock(t *testing.T) {
+	server, requests := evmChainServer(t, 1000, 50000)
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	ctx := context.Background()
+
+	for _, number := range []uint64{1, 20000, 42000} {
+		*requests = 0
+		blk, err := service.GetEvmBlock(ctx, number)
+		if err != nil {
+			t.Fatalf("GetEvmBlock(%d) failed: %v", number, err)
+		}
+		if blk.Number != number {
+			t.Errorf("Expected EVM block %d, got %d", number, blk.Number)
+		}
+		if blk.CadenceHeight%7 == 0 {
+			t.Errorf("EVM block mapped to a block without EVM data: %d", blk.CadenceHeight)
+		}
+		if *requests > maxEvmBlockProbes+1 {
+			t.Errorf("Expected at most %d requests, got %d", maxEvmBlockProbes+1, *requests)
+		}
+	}
+
+	if _, err := service.GetEvmBlock(ctx, 1000000); err == nil {
+		t.Error("Expected error for an EVM block beyond the head")
+	}
+}
@@ -0,0 +1,205 @@
+package flow
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// EvmAddress is a 20 byte EVM address. It has the same layout as go-ethereum's
+// common.Address, so it converts directly: common.Address(addr).
+type EvmAddress [20]byte
+
+// Hex returns the 0x-prefixed lowercase hex encoding of the address
+func (a EvmAddress) Hex() string {
+	return "0x" + hex.EncodeToString(a[:])
+}
+
+// String implements fmt.Stringer
+func (a EvmAddress) String() string {
+	return a.Hex()
+}
+
+// EvmHash is a 32 byte EVM hash. It has the same layout as go-ethereum's
+// common.Hash, so it converts directly: common.Hash(h).
+type EvmHash [32]byte
+
+// Hex returns the 0x-prefixed lowercase hex encoding of the hash
+func (h EvmHash) Hex() string {
+	return "0x" + hex.EncodeToString(h[:])
+}
+
+// String implements fmt.Stringer
+func (h EvmHash) String() string {
+	return h.Hex()
+}
+
+// ParseEvmAddress parses a hex address, with or without the 0x prefix
+func ParseEvmAddress(s string) (EvmAddress, error) {
+	var a EvmAddress
+	if err := decodeFixedHex(s, a[:]); err != nil {
+		return a, fmt.Errorf("invalid EVM address %q: %w", s, err)
+	}
+	return a, nil
+}
+
+// ParseEvmHash parses a hex hash, with or without the 0x prefix
+func ParseEvmHash(s string) (EvmHash, error) {
+	var h EvmHash
+	if err := decodeFixedHex(s, h[:]); err != nil {
+		return h, fmt.Errorf("invalid EVM hash %q: %w", s, err)
+	}
+	return h, nil
+}
+
+// ParseEvmQuantity parses an EVM quantity encoded as 0x-prefixed hex or as a
+// decimal string. An empty string parses as zero.
+func ParseEvmQuantity(s string) (*big.Int, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return new(big.Int), nil
+	}
+	n, ok := new(big.Int), false
+	if rest, isHex := strings.CutPrefix(strings.ToLower(s), "0x"); isHex {
+		if rest == "" {
+			return new(big.Int), nil
+		}
+		n, ok = n.SetString(rest, 16)
+	} else {
+		n, ok = n.SetString(s, 10)
+	}
+	if !ok {
+		return nil, fmt.Errorf("invalid EVM quantity %q", s)
+	}
+	return n, nil
+}
+
+// decodeFixedHex decodes hex into dst, which it must fill exactly
+func decodeFixedHex(s string, dst []byte) error {
+	s = strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(s), "0x"), "0X")
+	if len(s) != 2*len(dst) {
+		return fmt.Errorf("expected %d hex characters, got %d", 2*len(dst), len(s))
+	}
+	_, err := hex.Decode(dst, []byte(s))
+	return err
+}
+
+// HashValue returns the transaction hash
+func (t *EvmTransaction) HashValue() (EvmHash, error) {
+	return ParseEvmHash(t.Hash)
+}
+
+// FromAddress returns the sender address
+func (t *EvmTransaction) FromAddress() (EvmAddress, error) {
+	return ParseEvmAddress(t.From)
+}
+
+// ToAddress returns the recipient address, or nil for a contract creation
+func (t *EvmTransaction) ToAddress() (*EvmAddress, error) {
+	if t.To == "" {
+		return nil, nil
+	}
+	a, err := ParseEvmAddress(t.To)
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// ValueInt returns the transferred value in wei
+func (t *EvmTransaction) ValueInt() (*big.Int, error) {
+	return t.Value.Int()
+}
+
+// GasPriceInt returns the gas price in wei
+func (t *EvmTransaction) GasPriceInt() (*big.Int, error) {
+	return t.GasPrice.Int()
+}
+
+// GasLimitInt returns the gas limit
+func (t *EvmTransaction) GasLimitInt() (*big.Int, error) {
+	return t.GasLimit.Int()
+}
+
+// GasUsedInt returns the gas used
+func (t *EvmTransaction) GasUsedInt() (*big.Int, error) {
+	return t.GasUsed.Int()
+}
+
+// SignatureValues returns the V, R and S signature values
+func (t *EvmTransaction) SignatureValues() (v, r, s *big.Int, err error) {
+	if v, err = ParseEvmQuantity(t.V); err != nil {
+		return nil, nil, nil, err
+	}
+	if r, err = ParseEvmQuantity(t.R); err != nil {
+		return nil, nil, nil, err
+	}
+	if s, err = ParseEvmQuantity(t.S); err != nil {
+		return nil, nil, nil, err
+	}
+	return v, r, s, nil
+}
+
+// EvmTxData holds the parsed fields of an EvmTransaction, named after
+// go-ethereum's types.LegacyTx and types.DynamicFeeTx so a geth transaction
+// can be built without further parsing. The API does not return the chain
+// ID, input data or access list.
+type EvmTxData struct {
+	Type      int
+	Hash      EvmHash
+	From      EvmAddress
+	To        *EvmAddress // nil means contract creation
+	Nonce     uint64
+	Gas       uint64
+	GasPrice  *big.Int
+	GasTipCap *big.Int // max priority fee per gas, nil for legacy transactions
+	GasFeeCap *big.Int // max fee per gas, nil for legacy transactions
+	Value     *big.Int
+	V, R, S   *big.Int
+}
+
+// TxData parses the transaction's string fields into EvmTxData
+func (t *EvmTransaction) TxData() (*EvmTxData, error) {
+	var (
+		d   = &EvmTxData{Type: t.Type, Nonce: uint64(t.Nonce)}
+		err error
+	)
+	if d.Hash, err = t.HashValue(); err != nil {
+		return nil, err
+	}
+	if d.From, err = t.FromAddress(); err != nil {
+		return nil, err
+	}
+	if d.To, err = t.ToAddress(); err != nil {
+		return nil, err
+	}
+	gas, err := t.GasLimitInt()
+	if err != nil {
+		return nil, err
+	}
+	if !gas.IsUint64() {
+		return nil, fmt.Errorf("gas limit %s overflows uint64", gas)
+	}
+	d.Gas = gas.Uint64()
+	if d.GasPrice, err = t.GasPriceInt(); err != nil {
+		return nil, err
+	}
+	if t.MaxPriorityFeePerGas != "" {
+		if d.GasTipCap, err = t.MaxPriorityFeePerGas.Int(); err != nil {
+			return nil, err
+		}
+	}
+	if t.MaxFeePerGas != "" {
+		if d.GasFeeCap, err = t.MaxFeePerGas.Int(); err != nil {
+			return nil, err
+		}
+	}
+	if d.Value, err = t.ValueInt(); err != nil {
+		return nil, err
+	}
+	if d.V, d.R, d.S, err = t.SignatureValues(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
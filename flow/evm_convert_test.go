@@ -0,0 +1,94 @@
+package flow
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestParseEvmQuantity(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"", "0"},
+		{"0x", "0"},
+		{"0x2a", "42"},
+		{"0X2A", "42"},
+		{"1000000000000000000", "1000000000000000000"},
+	}
+	for _, tt := range tests {
+		got, err := ParseEvmQuantity(tt.in)
+		if err != nil {
+			t.Errorf("ParseEvmQuantity(%q) failed: %v", tt.in, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("ParseEvmQuantity(%q): expected %s, got %s", tt.in, tt.want, got)
+		}
+	}
+
+	if _, err := ParseEvmQuantity("0xzz"); err == nil {
+		t.Error("Expected error for invalid hex quantity")
+	}
+}
+
+func TestParseEvmAddress(t *testing.T) {
+	a, err := ParseEvmAddress("0x00000000000000000000000235Ab1b3E42d6e3B1")
+	if err != nil {
+		t.Fatalf("ParseEvmAddress failed: %v", err)
+	}
+	if a.Hex() != "0x00000000000000000000000235ab1b3e42d6e3b1" {
+		t.Errorf("Unexpected address %s", a)
+	}
+	if _, err := ParseEvmAddress("0x1234"); err == nil {
+		t.Error("Expected error for short address")
+	}
+}
+
+func TestEvmTransaction_TxData(t *testing.T) {
+	tx := EvmTransaction{
+		Hash:                 "0x" + "ab" + "00000000000000000000000000000000000000000000000000000000000000",
+		From:                 "0x00000000000000000000000235ab1b3e42d6e3b1",
+		GasLimit:             "21000",
+		GasPrice:             "0x3b9aca00",
+		MaxFeePerGas:         "2000000000",
+		MaxPriorityFeePerGas: "1000000000",
+		Nonce:                7,
+		Type:                 2,
+		V:                    "0x1",
+		R:                    "0x10",
+		S:                    "0x20",
+		Value:                "1000000000000000000",
+	}
+
+	d, err := tx.TxData()
+	if err != nil {
+		t.Fatalf("TxData failed: %v", err)
+	}
+	if d.To != nil {
+		t.Errorf("Expected nil To for contract creation, got %s", d.To)
+	}
+	if d.Gas != 21000 || d.Nonce != 7 || d.Type != 2 {
+		t.Errorf("Unexpected gas/nonce/type %d/%d/%d", d.Gas, d.Nonce, d.Type)
+	}
+	if d.GasPrice.Cmp(big.NewInt(1000000000)) != 0 {
+		t.Errorf("Expected gas price 1000000000, got %s", d.GasPrice)
+	}
+	if d.GasTipCap.Cmp(big.NewInt(1000000000)) != 0 || d.GasFeeCap.Cmp(big.NewInt(2000000000)) != 0 {
+		t.Errorf("Unexpected fee caps %s/%s", d.GasTipCap, d.GasFeeCap)
+	}
+	if d.Value.String() != "1000000000000000000" {
+		t.Errorf("Expected value 1e18, got %s", d.Value)
+	}
+	if d.V.Int64() != 1 || d.R.Int64() != 16 || d.S.Int64() != 32 {
+		t.Errorf("Unexpected signature %s/%s/%s", d.V, d.R, d.S)
+	}
+	if d.Hash[0] != 0xab {
+		t.Errorf("Unexpected hash %s", d.Hash)
+	}
+
+	tx.To = "not-an-address"
+	if _, err := tx.TxData(); err == nil {
+		t.Error("Expected error for invalid recipient")
+	}
+}
@@ -61,7 +61,7 @@ func TestFlowService_GetEvmTokens(t *testing.T) {
 }
 
 func TestFlowService_GetEvmToken(t *testing.T) {
-	address := "0x1234567890abcdef"
+	address := "0xd3bf53dac106a0290b0483ecbc89d40fcc961f3e"
 
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
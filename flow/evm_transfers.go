@@ -0,0 +1,170 @@
+package flow
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+)
+
+// EvmNativeToken is the token of native FLOW value transfers on Flow EVM
+const EvmNativeToken = "FLOW"
+
+// EvmTransfer is a value transfer between two EVM addresses
+type EvmTransfer struct {
+	BlockNumber     uint64   `json:"block_number"`
+	TransactionHash string   `json:"transaction_hash"`
+	Timestamp       string   `json:"timestamp"`
+	From            string   `json:"from"`
+	To              string   `json:"to"`
+	Token           string   `json:"token"`
+	Value           *big.Int `json:"value"`
+}
+
+// EvmTokenTransfersRequestBuilder builds a request for the transfers in a
+// range of EVM blocks
+type EvmTokenTransfersRequestBuilder struct {
+	service    *Service
+	fromHeight uint64
+	toHeight   uint64
+	from       string
+	to         string
+}
+
+// GetEvmTokenTransfers creates a new EVM transfers request builder. The API
+// does not expose EVM logs, so ERC-20 transfers cannot be listed; transfers
+// are the native FLOW value moved by successful EVM transactions in the range.
+func (s *Service) GetEvmTokenTransfers() *EvmTokenTransfersRequestBuilder {
+	return &EvmTokenTransfersRequestBuilder{service: s}
+}
+
+// Clone returns an independent copy of the EVM transfers request, returning transfers in ascending builder
+func (b *EvmTokenTransfersRequestBuilder) Clone() *EvmTokenTransfersRequestBuilder {
+	c := *b
+	return &c
+}
+
+// FromHeight sets the first EVM block number of the range, inclusive (required)
+func (b *EvmTokenTransfersRequestBuilder) FromHeight(height uint64) *EvmTokenTransfersRequestBuilder {
+	b.fromHeight = height
+	return b
+}
+
+// ToHeight sets the last EVM block number of the range, inclusive (required)
+func (b *EvmTokenTransfersRequestBuilder) ToHeight(height uint64) *EvmTokenTransfersRequestBuilder {
+	b.toHeight = height
+	return b
+}
+
+// From filters transfers sent by an address (optional)
+func (b *EvmTokenTransfersRequestBuilder) From(address string) *EvmTokenTransfersRequestBuilder {
+	b.from = address
+	return b
+}
+
+// To filters transfers received by an address (optional)
+func (b *EvmTokenTransfersRequestBuilder) To(address string) *EvmTokenTransfersRequestBuilder {
+	b.to = address
+	return b
+}
+
+// Validate checks the EVM transfers request, returning transfers in ascending without making a network call
+func (b *EvmTokenTransfersRequestBuilder) Validate() error {
+	if b.fromHeight == 0 {
+		return fmt.Errorf("from_height is required")
+	}
+	if b.toHeight == 0 {
+		return fmt.Errorf("to_height is required")
+	}
+	if b.fromHeight > b.toHeight {
+		return fmt.Errorf("from_height %d is after to_height %d", b.fromHeight, b.toHeight)
+	}
+	for _, address := range []string{b.from, b.to} {
+		if address == "" {
+			continue
+		}
+		if _, err := ParseEvmAddress(address); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Do executes the EVM transfers request, returning transfers in ascending
+// block order
+func (b *EvmTokenTransfersRequestBuilder) Do(ctx context.Context) ([]EvmTransfer, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	// The endpoint walks down from a height, so page from the top of the
+	// range until a page reaches below its bottom
+	transfers := []EvmTransfer{}
+	for offset := 0; ; offset += maxPageSize {
+		resp, err := b.service.GetEvmTransactions().Height(b.toHeight).Limit(maxPageSize).Offset(offset).Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tx := range resp.Data {
+			if tx.BlockNumber < b.fromHeight || tx.BlockNumber > b.toHeight {
+				continue
+			}
+			if t, ok, err := b.transfer(tx); err != nil {
+				return nil, err
+			} else if ok {
+				transfers = append(transfers, t)
+			}
+		}
+
+		if len(resp.Data) < maxPageSize || resp.Data[len(resp.Data)-1].BlockNumber < b.fromHeight {
+			break
+		}
+	}
+
+	slices.SortStableFunc(transfers, func(a, b EvmTransfer) int { return cmp.Compare(a.BlockNumber, b.BlockNumber) })
+	return transfers, nil
+}
+
+// transfer returns the native value transfer of a transaction, if it moved
+// value and matches the builder's address filters
+func (b *EvmTokenTransfersRequestBuilder) transfer(tx EvmTransaction) (EvmTransfer, bool, error) {
+	if tx.To == "" || evmTxFailed(tx.Status) {
+		return EvmTransfer{}, false, nil
+	}
+	if b.from != "" && !strings.EqualFold(tx.From, b.from) {
+		return EvmTransfer{}, false, nil
+	}
+	if b.to != "" && !strings.EqualFold(tx.To, b.to) {
+		return EvmTransfer{}, false, nil
+	}
+
+	value, err := tx.ValueInt()
+	if err != nil {
+		return EvmTransfer{}, false, fmt.Errorf("transaction %s: %w", tx.Hash, err)
+	}
+	if value.Sign() == 0 {
+		return EvmTransfer{}, false, nil
+	}
+
+	return EvmTransfer{
+		BlockNumber:     tx.BlockNumber,
+		TransactionHash: tx.Hash,
+		Timestamp:       tx.Timestamp,
+		From:            tx.From,
+		To:              tx.To,
+		Token:           EvmNativeToken,
+		Value:           value,
+	}, true, nil
+}
+
+// evmTxFailed reports whether an EVM transaction status indicates failure
+func evmTxFailed(status string) bool {
+	switch strings.ToLower(status) {
+	case "error", "failed", "failure", "reverted", "0", "0x0":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestFlowService_GetEvmTokenTransfers(t *testing.T) {
+	// Ten transactions per block for blocks 100..80, newest first
+	var txs []EvmTransaction
+	for n := uint64(100); n >= 80; n-- {
+		for i := 0; i < 10; i++ {
+			tx := EvmTransaction{BlockNumber: n, Hash: "0x" + strconv.FormatUint(n, 10) + strconv.Itoa(i), From: "0xaa", To: "0xbb", Value: "0", Status: "success"}
+			if i == 0 {
+				tx.Value = "0x64"
+			}
+			if i == 1 {
+				tx.Value, tx.From = "5", "0x00000000000000000000000000000000000000CC"
+			}
+			if i == 2 {
+				tx.Value, tx.Status = "7", "error"
+			}
+			txs = append(txs, tx)
+		}
+	}
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/flow/v1/evm/transaction" {
+			t.Errorf("Expected path /flow/v1/evm/transaction, got %s", r.URL.Path)
+		}
+		if h := r.URL.Query().Get("height"); h != "95" {
+			t.Errorf("Expected height 95, got %s", h)
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		start := 50 + offset // skip blocks above 95
+		end := min(start+limit, len(txs))
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(EvmTransactionResponse{Data: txs[min(start, end):end]})
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+
+	ctx := context.Background()
+	transfers, err := service.GetEvmTokenTransfers().FromHeight(90).ToHeight(95).Do(ctx)
+	if err != nil {
+		t.Fatalf("GetEvmTokenTransfers failed: %v", err)
+	}
+	if len(transfers) != 12 {
+		t.Fatalf("Expected 12 transfers, got %d", len(transfers))
+	}
+	if transfers[0].BlockNumber != 90 || transfers[len(transfers)-1].BlockNumber != 95 {
+		t.Errorf("Expected ascending blocks 90..95, got %d..%d", transfers[0].BlockNumber, transfers[len(transfers)-1].BlockNumber)
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 request, got %d", requests)
+	}
+
+	fromCC, err := service.GetEvmTokenTransfers().FromHeight(90).ToHeight(95).From("0x00000000000000000000000000000000000000cc").Do(ctx)
+	if err != nil {
+		t.Fatalf("GetEvmTokenTransfers failed: %v", err)
+	}
+	if len(fromCC) != 6 {
+		t.Fatalf("Expected 6 transfers from 0xcc, got %d", len(fromCC))
+	}
+	if fromCC[0].Value.Int64() != 5 || fromCC[0].Token != EvmNativeToken {
+		t.Errorf("Unexpected transfer %+v", fromCC[0])
+	}
+
+	if _, err := service.GetEvmTokenTransfers().FromHeight(95).ToHeight(90).Do(ctx); err == nil {
+		t.Error("Expected error for inverted range")
+	}
+}
@@ -0,0 +1,43 @@
+package flow
+
+import (
+	"fmt"
+
+	"github.com/peterargue/find-api/filter"
+)
+
+// applyPagination copies a filter's limit and offset onto builder fields
+func applyPagination(p filter.Params, limit, offset **int) {
+	if p.Limit != nil {
+		*limit = p.Limit
+	}
+	if p.Offset != nil {
+		*offset = p.Offset
+	}
+}
+
+// applySingleHeight copies a one-block height range onto an endpoint that only
+// filters by a single height. Wider ranges cannot be expressed and are an error.
+func applySingleHeight(p filter.Params, builder string, height **uint64) error {
+	if p.FromHeight == nil && p.ToHeight == nil {
+		return nil
+	}
+	h, ok := p.SingleHeight()
+	if !ok {
+		return fmt.Errorf("%s only supports single height filters", builder)
+	}
+	*height = &h
+	return nil
+}
+
+// applyTimeRange copies a filter's time bounds onto ISO 8601 builder fields
+func applyTimeRange(p filter.Params, from, to **string) {
+	if p.FromTime != nil {
+		s := filter.FormatTime(*p.FromTime)
+		*from = &s
+	}
+	if p.ToTime != nil {
+		s := filter.FormatTime(*p.ToTime)
+		*to = &s
+	}
+}
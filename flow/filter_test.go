@@ -0,0 +1,70 @@
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/peterargue/find-api/filter"
+)
+
+func TestFilters_Apply(t *testing.T) {
+	var query map[string]string
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		query = map[string]string{}
+		for k := range r.URL.Query() {
+			query[k] = r.URL.Query().Get(k)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"data": []any{}})
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	ctx := context.Background()
+
+	from := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	shared := []filter.Filter{filter.Between(from, from.Add(24*time.Hour)), filter.Address("0x1234567890abcdef"), filter.Page(50, 100)}
+
+	if _, err := service.GetAccountTransactions().Apply(shared...).Do(ctx); err != nil {
+		t.Fatalf("GetAccountTransactions failed: %v", err)
+	}
+	if path != "/flow/v1/account/0x1234567890abcdef/transaction" {
+		t.Errorf("Expected address from filter in path, got %s", path)
+	}
+	if query["from"] != "2025-01-02T03:04:05Z" || query["to"] != "2025-01-03T03:04:05Z" {
+		t.Errorf("Expected ISO 8601 time range, got %v", query)
+	}
+	if query["limit"] != "50" || query["offset"] != "100" {
+		t.Errorf("Expected pagination from filter, got %v", query)
+	}
+
+	if _, err := service.GetNFTTransfers().Apply(filter.Address("0x1"), filter.AtHeight(42)).Do(ctx); err != nil {
+		t.Fatalf("GetNFTTransfers failed: %v", err)
+	}
+	if query["address"] != "0x1" || query["height"] != "42" {
+		t.Errorf("Expected address and height from filters, got %v", query)
+	}
+}
+
+func TestFilters_ApplyUnsupported(t *testing.T) {
+	service := NewService(&mockClient{})
+	ctx := context.Background()
+
+	// Token transfers have no time parameters
+	if _, err := service.GetAccountFTTokenTransfers().Token("A.1654653399040a61.FlowToken").Apply(filter.Last(time.Hour), filter.Address("0x1")).Do(ctx); err == nil {
+		t.Error("Expected error for unsupported time range")
+	}
+	// Only single heights can be expressed
+	if _, err := service.GetFTTransfers().Apply(filter.Heights(1, 10)).Do(ctx); err == nil {
+		t.Error("Expected error for multi-block height range")
+	}
+	if _, err := service.GetTransactions().Apply(filter.Address("0x1")).Do(ctx); err == nil {
+		t.Error("Expected error for unsupported address filter")
+	}
+}
@@ -4,11 +4,16 @@ import (
 	"context"
 	"net/http"
 	"net/url"
+	"sync"
 )
 
+// maxPageSize is the largest limit accepted by the list endpoints
+const maxPageSize = 100
+
 // Client is an interface for making HTTP requests to the API
 type Client interface {
 	DoRequest(ctx context.Context, method, path string, query url.Values) (*http.Response, error)
+	DoRequestWithBody(ctx context.Context, method, path string, query url.Values, body any) (*http.Response, error)
 	DecodeResponse(resp *http.Response, v any) error
 }
 
@@ -21,3 +26,106 @@ type Service struct {
 func NewService(client Client) *Service {
 	return &Service{client: client}
 }
+
+// emptyIfNil returns s, or an empty slice if s is nil. Every Do method returns
+// a non-nil Data slice, even when the API returns no records.
+func emptyIfNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
+
+// collectPages calls fetch with increasing offsets until a page comes back short
+// and returns all records
+func collectPages[T any](fetch func(offset int) ([]T, error)) ([]T, error) {
+	all := []T{}
+	for offset := 0; ; offset += maxPageSize {
+		page, err := fetch(offset)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page...)
+		if len(page) < maxPageSize {
+			return all, nil
+		}
+	}
+}
+
+// collectPagesConcurrent is collectPages with up to concurrency pages in flight.
+// Pages are fetched ahead until one comes back short and are returned in order.
+// If any fetch fails the others are cancelled and the first error is returned.
+func collectPagesConcurrent[T any](ctx context.Context, concurrency int, fetch func(ctx context.Context, offset int) ([]T, error)) ([]T, error) {
+	first, err := fetch(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(first) < maxPageSize {
+		return emptyIfNil(first), nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		pages    = map[int][]T{0: first}
+		next     = 1
+		last     = -1 // index of the first short page, once seen
+		firstErr error
+		wg       sync.WaitGroup
+	)
+	claim := func() (int, bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		if firstErr != nil || (last >= 0 && next > last) {
+			return 0, false
+		}
+		i := next
+		next++
+		return i, true
+	}
+
+	for range max(concurrency, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				i, ok := claim()
+				if !ok || ctx.Err() != nil {
+					return
+				}
+				page, err := fetch(ctx, i*maxPageSize)
+
+				mu.Lock()
+				switch {
+				case err != nil:
+					if firstErr == nil {
+						firstErr = err
+						cancel()
+					}
+				default:
+					pages[i] = page
+					if len(page) < maxPageSize && (last < 0 || i < last) {
+						last = i
+					}
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if last < 0 {
+		return nil, ctx.Err()
+	}
+
+	all := []T{}
+	for i := 0; i <= last; i++ {
+		all = append(all, pages[i]...)
+	}
+	return all, nil
+}
@@ -3,9 +3,13 @@ package flow
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
+	"time"
+
+	"github.com/peterargue/find-api/filter"
 )
 
 // FungibleToken represents a fungible token with its details
@@ -45,20 +49,10 @@ type FungibleTokenDetails struct {
 }
 
 // FTListResponse represents the response from the fungible tokens list endpoint
-type FTListResponse struct {
-	Data  []FungibleToken        `json:"data"`
-	Links map[string]string      `json:"_links"`
-	Meta  map[string]interface{} `json:"_meta"`
-	Error interface{}            `json:"error,omitempty"`
-}
+type FTListResponse = Response[FungibleToken]
 
 // FungibleTokenResponse represents the response from the fungible token details endpoint
-type FungibleTokenResponse struct {
-	Data  []FungibleTokenDetails `json:"data"`
-	Links map[string]string      `json:"_links"`
-	Meta  map[string]interface{} `json:"_meta"`
-	Error interface{}            `json:"error,omitempty"`
-}
+type FungibleTokenResponse = Response[FungibleTokenDetails]
 
 // FTTransferTokenDetails represents the token details nested within an FT transfer
 type FTTransferTokenDetails struct {
@@ -88,33 +82,23 @@ type FTTransfer struct {
 }
 
 // TransfersResponse represents the response from the transfers endpoint
-type TransfersResponse struct {
-	Data  []FTTransfer      `json:"data"`
-	Links map[string]string `json:"_links"`
-	Meta  map[string]string `json:"_meta,omitempty"`
-	Error string            `json:"error,omitempty"`
-}
+type TransfersResponse = Response[FTTransfer]
 
 // FTHolding represents a fungible token holding
 type FTHolding struct {
 	Address    string  `json:"address"`
-	Balance    float64 `json:"balance"`
+	Balance    Float   `json:"balance"`
 	Percentage float64 `json:"percentage"`
 	Token      string  `json:"token"`
 }
 
 // FTHoldingResponse represents the response from the holdings endpoint
-type FTHoldingResponse struct {
-	Data  []FTHolding            `json:"data"`
-	Links map[string]string      `json:"_links"`
-	Meta  map[string]interface{} `json:"_meta"`
-	Error interface{}            `json:"error,omitempty"`
-}
+type FTHoldingResponse = Response[FTHolding]
 
 // Vault represents a token vault for an account
 type Vault struct {
 	Address     string  `json:"address"`
-	Balance     float64 `json:"balance"`
+	Balance     Float   `json:"balance"`
 	BlockHeight uint64  `json:"block_height"`
 	ID          string  `json:"id"`
 	Path        string  `json:"path"`
@@ -123,12 +107,7 @@ type Vault struct {
 }
 
 // AccountFungibleTokenResponse represents the response from the account token endpoint
-type AccountFungibleTokenResponse struct {
-	Data  []Vault                `json:"data"`
-	Links map[string]string      `json:"_links"`
-	Meta  map[string]interface{} `json:"_meta"`
-	Error interface{}            `json:"error,omitempty"`
-}
+type AccountFungibleTokenResponse = Response[Vault]
 
 // FTsRequestBuilder builds a request to get fungible tokens list
 type FTsRequestBuilder struct {
@@ -143,6 +122,12 @@ func (s *Service) GetFTs() *FTsRequestBuilder {
 	return &FTsRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the fungible tokens list request builder
+func (b *FTsRequestBuilder) Clone() *FTsRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Height sets the block height filter (optional)
 func (b *FTsRequestBuilder) Height(height uint64) *FTsRequestBuilder {
 	b.height = &height
@@ -161,8 +146,17 @@ func (b *FTsRequestBuilder) Offset(offset int) *FTsRequestBuilder {
 	return b
 }
 
+// Validate checks the fungible tokens list request without making a network call
+func (b *FTsRequestBuilder) Validate() error {
+	return validatePage(b.limit, b.offset)
+}
+
 // Do executes the fungible tokens list request
 func (b *FTsRequestBuilder) Do(ctx context.Context) (*FTListResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
 	query := url.Values{}
 	if b.height != nil {
 		query.Set("height", strconv.FormatUint(*b.height, 10))
@@ -183,6 +177,7 @@ func (b *FTsRequestBuilder) Do(ctx context.Context) (*FTListResponse, error) {
 	if err := b.service.client.DecodeResponse(resp, &ftResp); err != nil {
 		return nil, err
 	}
+	ftResp.Data = emptyIfNil(ftResp.Data)
 
 	return &ftResp, nil
 }
@@ -198,16 +193,30 @@ func (s *Service) GetFT() *FTRequestBuilder {
 	return &FTRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the fungible token details request builder
+func (b *FTRequestBuilder) Clone() *FTRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Token sets the token identifier (required)
 func (b *FTRequestBuilder) Token(token string) *FTRequestBuilder {
 	b.token = token
 	return b
 }
 
+// Validate checks the fungible token details request without making a network call
+func (b *FTRequestBuilder) Validate() error {
+	if b.token == "" {
+		return fmt.Errorf("token identifier is required")
+	}
+	return nil
+}
+
 // Do executes the fungible token details request
 func (b *FTRequestBuilder) Do(ctx context.Context) (*FungibleTokenResponse, error) {
-	if b.token == "" {
-		return nil, fmt.Errorf("token identifier is required")
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	path := fmt.Sprintf("/flow/v1/ft/%s", b.token)
@@ -220,6 +229,7 @@ func (b *FTRequestBuilder) Do(ctx context.Context) (*FungibleTokenResponse, erro
 	if err := b.service.client.DecodeResponse(resp, &ftResp); err != nil {
 		return nil, err
 	}
+	ftResp.Data = emptyIfNil(ftResp.Data)
 
 	return &ftResp, nil
 }
@@ -229,9 +239,17 @@ type FTTransfersRequestBuilder struct {
 	service         *Service
 	token           *string
 	transactionHash *string
+	sender          *string
+	receiver        *string
+	direction       *string
+	minAmount       *float64
+	maxAmount       *float64
 	height          *uint64
+	from            *string
+	to              *string
 	limit           *int
 	offset          *int
+	filterErr       error
 }
 
 // GetFTTransfers creates a new fungible token transfers request builder
@@ -239,6 +257,12 @@ func (s *Service) GetFTTransfers() *FTTransfersRequestBuilder {
 	return &FTTransfersRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the fungible token transfers request builder
+func (b *FTTransfersRequestBuilder) Clone() *FTTransfersRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Token sets the token identifier filter (optional)
 func (b *FTTransfersRequestBuilder) Token(token string) *FTTransfersRequestBuilder {
 	b.token = &token
@@ -251,6 +275,62 @@ func (b *FTTransfersRequestBuilder) TransactionHash(hash string) *FTTransfersReq
 	return b
 }
 
+// Sender limits results to transfers sent by an address (optional)
+func (b *FTTransfersRequestBuilder) Sender(sender string) *FTTransfersRequestBuilder {
+	b.sender = &sender
+	return b
+}
+
+// Receiver limits results to transfers received by an address (optional)
+func (b *FTTransfersRequestBuilder) Receiver(receiver string) *FTTransfersRequestBuilder {
+	b.receiver = &receiver
+	return b
+}
+
+// Direction sets the transfer direction filter, DirectionIn or DirectionOut (optional)
+func (b *FTTransfersRequestBuilder) Direction(direction string) *FTTransfersRequestBuilder {
+	b.direction = &direction
+	return b
+}
+
+// MinAmount sets the inclusive lower bound on the transferred amount (optional)
+func (b *FTTransfersRequestBuilder) MinAmount(amount float64) *FTTransfersRequestBuilder {
+	b.minAmount = &amount
+	return b
+}
+
+// MaxAmount sets the inclusive upper bound on the transferred amount (optional)
+func (b *FTTransfersRequestBuilder) MaxAmount(amount float64) *FTTransfersRequestBuilder {
+	b.maxAmount = &amount
+	return b
+}
+
+// From sets the start time filter as an RFC 3339 timestamp (optional)
+func (b *FTTransfersRequestBuilder) From(from string) *FTTransfersRequestBuilder {
+	b.from = &from
+	return b
+}
+
+// FromTime sets the start time filter (optional)
+func (b *FTTransfersRequestBuilder) FromTime(from time.Time) *FTTransfersRequestBuilder {
+	s := filter.FormatTime(from)
+	b.from = &s
+	return b
+}
+
+// To sets the end time filter as an RFC 3339 timestamp (optional)
+func (b *FTTransfersRequestBuilder) To(to string) *FTTransfersRequestBuilder {
+	b.to = &to
+	return b
+}
+
+// ToTime sets the end time filter (optional)
+func (b *FTTransfersRequestBuilder) ToTime(to time.Time) *FTTransfersRequestBuilder {
+	s := filter.FormatTime(to)
+	b.to = &s
+	return b
+}
+
 // Height sets the block height filter (optional)
 func (b *FTTransfersRequestBuilder) Height(height uint64) *FTTransfersRequestBuilder {
 	b.height = &height
@@ -269,8 +349,49 @@ func (b *FTTransfersRequestBuilder) Offset(offset int) *FTTransfersRequestBuilde
 	return b
 }
 
-// Do executes the fungible token transfers request
-func (b *FTTransfersRequestBuilder) Do(ctx context.Context) (*TransfersResponse, error) {
+// Apply applies reusable filters: height, time range and pagination (optional)
+func (b *FTTransfersRequestBuilder) Apply(filters ...filter.Filter) *FTTransfersRequestBuilder {
+	p := filter.Collect(filters...)
+	if err := p.Unsupported("GetFTTransfers", filter.FieldHeightRange, filter.FieldTimeRange, filter.FieldPagination); err != nil {
+		b.filterErr = err
+		return b
+	}
+	if err := applySingleHeight(p, "GetFTTransfers", &b.height); err != nil {
+		b.filterErr = err
+		return b
+	}
+	applyTimeRange(p, &b.from, &b.to)
+	applyPagination(p, &b.limit, &b.offset)
+	return b
+}
+
+// Validate checks the fungible token transfers request without making a network call
+func (b *FTTransfersRequestBuilder) Validate() error {
+	if b.filterErr != nil {
+		return b.filterErr
+	}
+	if err := b.clientFilter().validate(); err != nil {
+		return err
+	}
+	return validatePage(b.limit, b.offset)
+}
+
+// clientFilter returns the filters the transfers endpoint does not support
+func (b *FTTransfersRequestBuilder) clientFilter() transferFilter {
+	return transferFilter{
+		sender:    b.sender,
+		receiver:  b.receiver,
+		direction: b.direction,
+		minAmount: b.minAmount,
+		maxAmount: b.maxAmount,
+		from:      b.from,
+		to:        b.to,
+	}
+}
+
+// query returns the fungible token transfers request's server-side query
+// parameters, without limit and offset
+func (b *FTTransfersRequestBuilder) query() url.Values {
 	query := url.Values{}
 	if b.token != nil {
 		query.Set("token", *b.token)
@@ -281,13 +402,23 @@ func (b *FTTransfersRequestBuilder) Do(ctx context.Context) (*TransfersResponse,
 	if b.height != nil {
 		query.Set("height", strconv.FormatUint(*b.height, 10))
 	}
-	if b.limit != nil {
-		query.Set("limit", strconv.Itoa(*b.limit))
+	return query
+}
+
+// Do executes the fungible token transfers request. Sender, Receiver,
+// Direction, the amount range and the time range are applied on the client
+// (see transferFilter).
+func (b *FTTransfersRequestBuilder) Do(ctx context.Context) (*TransfersResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
-	if b.offset != nil {
-		query.Set("offset", strconv.Itoa(*b.offset))
+
+	query := b.query()
+	if f := b.clientFilter(); f.active() {
+		return filterPages(ctx, b.service.client, "/flow/v1/ft/transfer", query, b.limit, b.offset, f.matchFT)
 	}
 
+	setPage(query, b.limit, b.offset)
 	resp, err := b.service.client.DoRequest(ctx, http.MethodGet, "/flow/v1/ft/transfer", query)
 	if err != nil {
 		return nil, err
@@ -297,10 +428,28 @@ func (b *FTTransfersRequestBuilder) Do(ctx context.Context) (*TransfersResponse,
 	if err := b.service.client.DecodeResponse(resp, &transfersResp); err != nil {
 		return nil, err
 	}
+	transfersResp.Data = emptyIfNil(transfersResp.Data)
 
 	return &transfersResp, nil
 }
 
+// Stream iterates over the matching transfers page by page from the request's
+// offset, decoding one record at a time instead of whole pages so memory stays
+// flat during backfills. Limit sets the page size (default 100). If a request
+// fails the error is yielded once and iteration stops.
+func (b *FTTransfersRequestBuilder) Stream(ctx context.Context) iter.Seq2[FTTransfer, error] {
+	if err := b.Validate(); err != nil {
+		return func(yield func(FTTransfer, error) bool) { yield(FTTransfer{}, err) }
+	}
+	query := b.query()
+	setPage(query, b.limit, b.offset)
+	var keep func(*FTTransfer) bool
+	if f := b.clientFilter(); f.active() {
+		keep = f.matchFT
+	}
+	return streamPages(ctx, b.service.client, "/flow/v1/ft/transfer", query, keep)
+}
+
 // FTHoldingsRequestBuilder builds a request to get fungible token holdings
 type FTHoldingsRequestBuilder struct {
 	service *Service
@@ -314,6 +463,12 @@ func (s *Service) GetFTHoldings() *FTHoldingsRequestBuilder {
 	return &FTHoldingsRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the fungible token holdings request builder
+func (b *FTHoldingsRequestBuilder) Clone() *FTHoldingsRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Token sets the token identifier (required)
 func (b *FTHoldingsRequestBuilder) Token(token string) *FTHoldingsRequestBuilder {
 	b.token = token
@@ -332,10 +487,18 @@ func (b *FTHoldingsRequestBuilder) Offset(offset int) *FTHoldingsRequestBuilder
 	return b
 }
 
+// Validate checks the fungible token holdings request without making a network call
+func (b *FTHoldingsRequestBuilder) Validate() error {
+	if b.token == "" {
+		return fmt.Errorf("token identifier is required")
+	}
+	return validatePage(b.limit, b.offset)
+}
+
 // Do executes the fungible token holdings request
 func (b *FTHoldingsRequestBuilder) Do(ctx context.Context) (*FTHoldingResponse, error) {
-	if b.token == "" {
-		return nil, fmt.Errorf("token identifier is required")
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	query := url.Values{}
@@ -356,6 +519,7 @@ func (b *FTHoldingsRequestBuilder) Do(ctx context.Context) (*FTHoldingResponse,
 	if err := b.service.client.DecodeResponse(resp, &holdingsResp); err != nil {
 		return nil, err
 	}
+	holdingsResp.Data = emptyIfNil(holdingsResp.Data)
 
 	return &holdingsResp, nil
 }
@@ -374,6 +538,12 @@ func (s *Service) GetFTAccountToken() *FTAccountTokenRequestBuilder {
 	return &FTAccountTokenRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the account fungible token request builder
+func (b *FTAccountTokenRequestBuilder) Clone() *FTAccountTokenRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Token sets the token identifier (required)
 func (b *FTAccountTokenRequestBuilder) Token(token string) *FTAccountTokenRequestBuilder {
 	b.token = token
@@ -398,13 +568,24 @@ func (b *FTAccountTokenRequestBuilder) Offset(offset int) *FTAccountTokenRequest
 	return b
 }
 
-// Do executes the account fungible token request
-func (b *FTAccountTokenRequestBuilder) Do(ctx context.Context) (*AccountFungibleTokenResponse, error) {
+// Validate checks the account fungible token request without making a network call
+func (b *FTAccountTokenRequestBuilder) Validate() error {
 	if b.token == "" {
-		return nil, fmt.Errorf("token identifier is required")
+		return fmt.Errorf("token identifier is required")
 	}
 	if b.address == "" {
-		return nil, fmt.Errorf("account address is required")
+		return fmt.Errorf("account address is required")
+	}
+	if err := validateAddress(b.address); err != nil {
+		return err
+	}
+	return validatePage(b.limit, b.offset)
+}
+
+// Do executes the account fungible token request
+func (b *FTAccountTokenRequestBuilder) Do(ctx context.Context) (*AccountFungibleTokenResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	query := url.Values{}
@@ -425,6 +606,7 @@ func (b *FTAccountTokenRequestBuilder) Do(ctx context.Context) (*AccountFungible
 	if err := b.service.client.DecodeResponse(resp, &accountResp); err != nil {
 		return nil, err
 	}
+	accountResp.Data = emptyIfNil(accountResp.Data)
 
 	return &accountResp, nil
 }
@@ -1,6 +1,7 @@
 package flow
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 )
 
 // mockClient implements the Client interface for testing
@@ -17,17 +19,29 @@ type mockClient struct {
 }
 
 func (m *mockClient) DoRequest(ctx context.Context, method, path string, query url.Values) (*http.Response, error) {
+	return m.DoRequestWithBody(ctx, method, path, query, nil)
+}
+
+func (m *mockClient) DoRequestWithBody(ctx context.Context, method, path string, query url.Values, body any) (*http.Response, error) {
 	u, err := url.Parse(m.server.URL + path)
 	if err != nil {
 		return nil, err
 	}
 	u.RawQuery = query.Encode()
 
-	req, err := http.NewRequestWithContext(ctx, method, u.String(), nil)
+	var bodyReader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		bodyReader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bodyReader)
 	if err != nil {
 		return nil, err
 	}
-
 	return http.DefaultClient.Do(req)
 }
 
@@ -211,6 +225,63 @@ func TestFlowService_GetFTTransfers(t *testing.T) {
 	}
 }
 
+func TestFlowService_GetFTTransfersFilters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		for _, param := range []string{"sender", "receiver", "direction", "min_amount", "max_amount", "from", "to"} {
+			if query.Has(param) {
+				t.Errorf("Expected %s to be filtered on the client, got %s", param, query.Get(param))
+			}
+		}
+		if got := query.Get("limit"); got != "100" {
+			t.Errorf("Expected limit 100, got %s", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[
+			{"amount":250000,"sender":"0x1654653399040a61","receiver":"0xf233dcee88fe0abe","direction":"out","timestamp":"2024-01-15T00:00:00Z"},
+			{"amount":5,"sender":"0x1654653399040a61","receiver":"0xf233dcee88fe0abe","direction":"out","timestamp":"2024-01-15T00:00:00Z"},
+			{"amount":250000,"sender":"0xf233dcee88fe0abe","receiver":"0x1654653399040a61","direction":"in","timestamp":"2024-01-15T00:00:00Z"},
+			{"amount":250000,"sender":"0x1654653399040a61","receiver":"0xf233dcee88fe0abe","direction":"out","timestamp":"2024-03-01T00:00:00Z"}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := &mockClient{server: server}
+	service := NewService(client)
+
+	builder := service.GetFTTransfers().
+		Sender("0x1654653399040a61").
+		Receiver("0xf233dcee88fe0abe").
+		Direction(DirectionOut).
+		MinAmount(10000.5).
+		MaxAmount(1000000).
+		FromTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).
+		ToTime(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+	result, err := builder.Clone().Do(context.Background())
+	if err != nil {
+		t.Fatalf("GetFTTransfers failed: %v", err)
+	}
+	if len(result.Data) != 1 || result.Data[0].Amount != 250000 || result.Data[0].Timestamp != "2024-01-15T00:00:00Z" {
+		t.Errorf("Expected one transfer of 250000, got %+v", result.Data)
+	}
+
+	var streamed []FTTransfer
+	for transfer, err := range builder.Stream(context.Background()) {
+		if err != nil {
+			t.Fatalf("Stream failed: %v", err)
+		}
+		streamed = append(streamed, transfer)
+	}
+	if len(streamed) != 1 || streamed[0].Amount != 250000 {
+		t.Errorf("Expected one streamed transfer of 250000, got %+v", streamed)
+	}
+
+	if err := service.GetFTTransfers().From("yesterday").Validate(); err == nil {
+		t.Error("Expected error for invalid from time")
+	}
+}
+
 func TestFlowService_GetFTHoldings(t *testing.T) {
 	tokenID := "A.1654653399040a61.FlowToken.Vault"
 
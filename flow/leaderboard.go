@@ -0,0 +1,59 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+)
+
+// LeaderboardEntry is a ranked account returned by GetTopAccounts
+type LeaderboardEntry struct {
+	Rank        int     `json:"rank"`
+	Address     string  `json:"address"`
+	FindName    string  `json:"find_name,omitempty"`
+	FlowBalance float64 `json:"flow_balance"`
+	Account     Account `json:"account"`
+}
+
+// GetTopAccounts returns the top n accounts ordered by sortBy (default "flow_balance"),
+// paging through the accounts endpoint as needed. Ranks start at 1. Fewer than n
+// entries are returned if the API runs out of accounts.
+func (s *Service) GetTopAccounts(ctx context.Context, n int, sortBy string) ([]LeaderboardEntry, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("n must be positive")
+	}
+	if sortBy == "" {
+		sortBy = "flow_balance"
+	}
+
+	entries := make([]LeaderboardEntry, 0, n)
+	for offset := 0; len(entries) < n; offset += maxPageSize {
+		limit := min(n-len(entries), maxPageSize)
+		resp, err := s.GetAccounts().
+			SortBy(sortBy).
+			Limit(limit).
+			Offset(offset).
+			Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, a := range resp.Data {
+			if len(entries) == n {
+				break
+			}
+			entries = append(entries, LeaderboardEntry{
+				Rank:        len(entries) + 1,
+				Address:     a.Address,
+				FindName:    a.FindName,
+				FlowBalance: a.FlowBalance,
+				Account:     a,
+			})
+		}
+
+		if len(resp.Data) < limit {
+			break
+		}
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,77 @@
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestFlowService_GetTopAccounts(t *testing.T) {
+	const total = 150
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/flow/v1/account" {
+			t.Errorf("Expected path /flow/v1/account, got %s", r.URL.Path)
+		}
+		if sortBy := r.URL.Query().Get("sort_by"); sortBy != "flow_balance" {
+			t.Errorf("Expected sort_by=flow_balance, got %s", sortBy)
+		}
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		if limit > 100 {
+			t.Errorf("Expected limit <= 100, got %d", limit)
+		}
+
+		resp := AccountsResponse{}
+		for i := offset; i < total && i < offset+limit; i++ {
+			resp.Data = append(resp.Data, Account{
+				Address:     fmt.Sprintf("0x%02x", i),
+				FlowBalance: float64(total - i),
+				FindName:    fmt.Sprintf("name%d", i),
+			})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	ctx := context.Background()
+
+	top, err := service.GetTopAccounts(ctx, 120, "")
+	if err != nil {
+		t.Fatalf("GetTopAccounts failed: %v", err)
+	}
+	if len(top) != 120 {
+		t.Fatalf("Expected 120 entries, got %d", len(top))
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+	if top[0].Rank != 1 || top[0].FlowBalance != total || top[0].FindName != "name0" {
+		t.Errorf("Unexpected first entry %+v", top[0])
+	}
+	if top[119].Rank != 120 {
+		t.Errorf("Expected last rank 120, got %d", top[119].Rank)
+	}
+
+	// Asking for more than exist returns what is available
+	top, err = service.GetTopAccounts(ctx, 500, "flow_balance")
+	if err != nil {
+		t.Fatalf("GetTopAccounts failed: %v", err)
+	}
+	if len(top) != total {
+		t.Errorf("Expected %d entries, got %d", total, len(top))
+	}
+
+	if _, err := service.GetTopAccounts(ctx, 0, ""); err == nil {
+		t.Error("Expected error for n = 0")
+	}
+}
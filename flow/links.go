@@ -0,0 +1,97 @@
+package flow
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Links holds the pagination links of a list response. URLs may be relative
+// to the API base URL.
+type Links struct {
+	Next *url.URL
+	Prev *url.URL
+	Self *url.URL
+	// Other holds any further links by name
+	Other map[string]string
+}
+
+// UnmarshalJSON parses the _links object of a response
+func (l *Links) UnmarshalJSON(b []byte) error {
+	var raw map[string]string
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	*l = Links{}
+	for name, href := range raw {
+		var dst **url.URL
+		switch name {
+		case "next":
+			dst = &l.Next
+		case "prev":
+			dst = &l.Prev
+		case "self":
+			dst = &l.Self
+		default:
+			if l.Other == nil {
+				l.Other = make(map[string]string)
+			}
+			l.Other[name] = href
+			continue
+		}
+		if href == "" {
+			continue
+		}
+		u, err := url.Parse(href)
+		if err != nil {
+			return fmt.Errorf("invalid %s link %q: %w", name, href, err)
+		}
+		*dst = u
+	}
+	return nil
+}
+
+// MarshalJSON encodes the links in the API's _links format
+func (l Links) MarshalJSON() ([]byte, error) {
+	raw := make(map[string]string, len(l.Other)+3)
+	for name, href := range l.Other {
+		raw[name] = href
+	}
+	for name, u := range map[string]*url.URL{"next": l.Next, "prev": l.Prev, "self": l.Self} {
+		if u != nil {
+			raw[name] = u.String()
+		}
+	}
+	return json.Marshal(raw)
+}
+
+// HasNext reports whether there is a next page
+func (l Links) HasNext() bool {
+	return l.Next != nil
+}
+
+// NextOffset returns the offset of the next page, and false if there is no
+// next page or its link carries no offset
+func (l Links) NextOffset() (int, bool) {
+	return linkOffset(l.Next)
+}
+
+// PrevOffset returns the offset of the previous page, and false if there is
+// no previous page or its link carries no offset
+func (l Links) PrevOffset() (int, bool) {
+	return linkOffset(l.Prev)
+}
+
+// linkOffset reads the offset query parameter of a link
+func linkOffset(u *url.URL) (int, bool) {
+	if u == nil {
+		return 0, false
+	}
+	offset, err := strconv.Atoi(u.Query().Get("offset"))
+	if err != nil {
+		return 0, false
+	}
+	return offset, true
+}
@@ -0,0 +1,54 @@
+package flow
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestLinks_UnmarshalJSON(t *testing.T) {
+	var resp BlockResponse
+	body := `{"data":[],"_links":{"next":"/flow/v1/block?limit=25&offset=50","prev":"/flow/v1/block?limit=25&offset=0","self":"/flow/v1/block?limit=25&offset=25","docs":"https://docs.find.xyz"}}`
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	links := resp.Links
+	if !links.HasNext() || links.Next.Path != "/flow/v1/block" {
+		t.Errorf("Expected next link to /flow/v1/block, got %v", links.Next)
+	}
+	if offset, ok := links.NextOffset(); !ok || offset != 50 {
+		t.Errorf("Expected next offset 50, got %d (%v)", offset, ok)
+	}
+	if offset, ok := links.PrevOffset(); !ok || offset != 0 {
+		t.Errorf("Expected prev offset 0, got %d (%v)", offset, ok)
+	}
+	if links.Other["docs"] != "https://docs.find.xyz" {
+		t.Errorf("Expected docs link kept, got %v", links.Other)
+	}
+
+	// Links round-trip in the API's format
+	out, err := json.Marshal(links)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	var again Links
+	if err := json.Unmarshal(out, &again); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if again.Self.String() != "/flow/v1/block?limit=25&offset=25" {
+		t.Errorf("Expected self link to round-trip, got %v", again.Self)
+	}
+}
+
+func TestLinks_LastPage(t *testing.T) {
+	var links Links
+	if err := json.Unmarshal([]byte(`{"next":""}`), &links); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if links.HasNext() {
+		t.Error("Expected no next page")
+	}
+	if _, ok := links.NextOffset(); ok {
+		t.Error("Expected no next offset")
+	}
+}
@@ -0,0 +1,71 @@
+package flow
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// Meta holds the metadata of a list response
+type Meta struct {
+	// Total is the number of records matching the query across all pages
+	Total int
+	// Limit is the page size
+	Limit int
+	// Offset is the offset of the current page
+	Offset int
+	// Raw holds every reported field, including ones without a typed counterpart
+	Raw map[string]any
+}
+
+// metaTotalKeys are the names the API reports the total count under
+var metaTotalKeys = []string{"total", "count", "total_count"}
+
+// UnmarshalJSON parses the _meta object of a response. Counts may be
+// reported as numbers or numeric strings.
+func (m *Meta) UnmarshalJSON(b []byte) error {
+	var raw map[string]any
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	*m = Meta{Raw: raw}
+	for _, key := range metaTotalKeys {
+		if n, ok := metaInt(raw[key]); ok {
+			m.Total = n
+			break
+		}
+	}
+	m.Limit, _ = metaInt(raw["limit"])
+	m.Offset, _ = metaInt(raw["offset"])
+	return nil
+}
+
+// MarshalJSON encodes the metadata as reported by the API
+func (m Meta) MarshalJSON() ([]byte, error) {
+	if m.Raw == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(m.Raw)
+}
+
+// HasTotal reports whether the response included a total count
+func (m Meta) HasTotal() bool {
+	for _, key := range metaTotalKeys {
+		if _, ok := metaInt(m.Raw[key]); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// metaInt converts a decoded JSON number or numeric string to an int
+func metaInt(v any) (int, bool) {
+	switch v := v.(type) {
+	case float64:
+		return int(v), true
+	case string:
+		n, err := strconv.Atoi(v)
+		return n, err == nil
+	}
+	return 0, false
+}
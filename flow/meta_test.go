@@ -0,0 +1,48 @@
+package flow
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMeta_UnmarshalJSON(t *testing.T) {
+	var resp AccountTransactionsResponse
+	body := `{"data":[],"_meta":{"count":58000,"limit":100,"offset":3200,"cached":true}}`
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	meta := resp.Meta
+	if !meta.HasTotal() || meta.Total != 58000 {
+		t.Errorf("Expected total 58000, got %d", meta.Total)
+	}
+	if meta.Limit != 100 || meta.Offset != 3200 {
+		t.Errorf("Expected limit 100 and offset 3200, got %d and %d", meta.Limit, meta.Offset)
+	}
+	if meta.Raw["cached"] != true {
+		t.Errorf("Expected untyped fields kept, got %v", meta.Raw)
+	}
+
+	out, err := json.Marshal(meta)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(out) != `{"cached":true,"count":58000,"limit":100,"offset":3200}` {
+		t.Errorf("Expected metadata to round-trip, got %s", out)
+	}
+}
+
+func TestMeta_StringCounts(t *testing.T) {
+	var resp TransfersResponse
+	if err := json.Unmarshal([]byte(`{"data":[],"_meta":{"total":"42","limit":"25"}}`), &resp); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if resp.Meta.Total != 42 || resp.Meta.Limit != 25 {
+		t.Errorf("Expected total 42 and limit 25, got %d and %d", resp.Meta.Total, resp.Meta.Limit)
+	}
+
+	var empty Meta
+	if empty.HasTotal() {
+		t.Error("Expected no total on empty metadata")
+	}
+}
@@ -3,9 +3,14 @@ package flow
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
+	"time"
+
+	"github.com/peterargue/find-api/filter"
 )
 
 // NFTCollection represents an NFT collection
@@ -26,12 +31,7 @@ type NFTCollection struct {
 }
 
 // NFTCollectionResponse represents the response from the NFT collections list endpoint
-type NFTCollectionResponse struct {
-	Data  []NFTCollection        `json:"data"`
-	Links map[string]string      `json:"_links"`
-	Meta  map[string]interface{} `json:"_meta"`
-	Error interface{}            `json:"error,omitempty"`
-}
+type NFTCollectionResponse = Response[NFTCollection]
 
 // NFTCollectionDetails represents detailed NFT collection information
 type NFTCollectionDetails struct {
@@ -41,12 +41,7 @@ type NFTCollectionDetails struct {
 }
 
 // NFTCollectionDetailsResponse represents the response from the NFT collection details endpoint
-type NFTCollectionDetailsResponse struct {
-	Data  []NFTCollectionDetails `json:"data"`
-	Links map[string]string      `json:"_links"`
-	Meta  map[string]interface{} `json:"_meta"`
-	Error interface{}            `json:"error,omitempty"`
-}
+type NFTCollectionDetailsResponse = Response[NFTCollectionDetails]
 
 // NFTTransfer represents an NFT transfer
 type NFTTransfer struct {
@@ -63,12 +58,7 @@ type NFTTransfer struct {
 }
 
 // NFTTransfersResponse represents the response from the NFT transfers endpoint
-type NFTTransfersResponse struct {
-	Data  []NFTTransfer          `json:"data"`
-	Links map[string]string      `json:"_links"`
-	Meta  map[string]interface{} `json:"_meta"`
-	Error interface{}            `json:"error,omitempty"`
-}
+type NFTTransfersResponse = Response[NFTTransfer]
 
 // NFTHolding represents an NFT holding
 type NFTHolding struct {
@@ -79,12 +69,7 @@ type NFTHolding struct {
 }
 
 // NFTHoldingResponse represents the response from the NFT holdings endpoint
-type NFTHoldingResponse struct {
-	Data  []NFTHolding           `json:"data"`
-	Links map[string]string      `json:"_links"`
-	Meta  map[string]interface{} `json:"_meta"`
-	Error interface{}            `json:"error,omitempty"`
-}
+type NFTHoldingResponse = Response[NFTHolding]
 
 // NFT represents detailed NFT information
 type NFT struct {
@@ -100,13 +85,33 @@ type NFT struct {
 }
 
 // NFTDetailsResponse represents the response from the NFT details endpoint
-type NFTDetailsResponse struct {
-	Data  []NFT                  `json:"data"`
-	Links map[string]string      `json:"_links"`
-	Meta  map[string]interface{} `json:"_meta"`
-	Error interface{}            `json:"error,omitempty"`
+type NFTDetailsResponse = Response[NFT]
+
+// NFTItem represents an NFT in a collection-wide item listing
+type NFTItem struct {
+	BlockHeight     uint64 `json:"block_height"`
+	CollectionImage string `json:"collection_image"`
+	CollectionName  string `json:"collection_name"`
+	Edition         *int64 `json:"edition,omitempty"`
+	ExternalURL     string `json:"external_url,omitempty"`
+	ID              string `json:"id"`
+	MaxEdition      *int64 `json:"max_edition,omitempty"`
+	Name            string `json:"name"`
+	NFTId           int64  `json:"nft_id"`
+	NFTType         string `json:"nft_type"`
+	Owner           string `json:"owner"`
+	Path            string `json:"path,omitempty"`
+	Serial          *int64 `json:"serial,omitempty"`
+	Status          string `json:"status"`
+	Thumbnail       string `json:"thumbnail"`
+	Timestamp       string `json:"timestamp"`
+	TransactionHash string `json:"transaction_hash"`
+	UUID            uint64 `json:"uuid"`
 }
 
+// NFTItemsResponse represents the response from the NFT items endpoint
+type NFTItemsResponse = Response[NFTItem]
+
 // AccountNFTCollection represents an NFT collection summary for an account
 type AccountNFTCollection struct {
 	Banner   string `json:"banner"`
@@ -118,12 +123,7 @@ type AccountNFTCollection struct {
 }
 
 // AccountNFTCollectionsResponse represents the response from account NFT collections endpoint
-type AccountNFTCollectionsResponse struct {
-	Data  []AccountNFTCollection `json:"data"`
-	Links map[string]string      `json:"_links"`
-	Meta  map[string]interface{} `json:"_meta"`
-	Error interface{}            `json:"error,omitempty"`
-}
+type AccountNFTCollectionsResponse = Response[AccountNFTCollection]
 
 // AccountNFT represents an NFT owned by an account
 type AccountNFT struct {
@@ -140,12 +140,7 @@ type AccountNFT struct {
 }
 
 // AccountNFTResponse represents the response from account NFT endpoint
-type AccountNFTResponse struct {
-	Data  []AccountNFT           `json:"data"`
-	Links map[string]string      `json:"_links"`
-	Meta  map[string]interface{} `json:"_meta"`
-	Error interface{}            `json:"error,omitempty"`
-}
+type AccountNFTResponse = Response[AccountNFT]
 
 // NFTCollectionsRequestBuilder builds a request to get NFT collections
 type NFTCollectionsRequestBuilder struct {
@@ -159,6 +154,12 @@ func (s *Service) GetNFTCollections() *NFTCollectionsRequestBuilder {
 	return &NFTCollectionsRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the NFT collections request builder
+func (b *NFTCollectionsRequestBuilder) Clone() *NFTCollectionsRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Limit sets the number of records to return (optional, default 25, max 100)
 func (b *NFTCollectionsRequestBuilder) Limit(limit int) *NFTCollectionsRequestBuilder {
 	b.limit = &limit
@@ -171,8 +172,17 @@ func (b *NFTCollectionsRequestBuilder) Offset(offset int) *NFTCollectionsRequest
 	return b
 }
 
+// Validate checks the NFT collections request without making a network call
+func (b *NFTCollectionsRequestBuilder) Validate() error {
+	return validatePage(b.limit, b.offset)
+}
+
 // Do executes the NFT collections request
 func (b *NFTCollectionsRequestBuilder) Do(ctx context.Context) (*NFTCollectionResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
 	query := url.Values{}
 	if b.limit != nil {
 		query.Set("limit", strconv.Itoa(*b.limit))
@@ -190,6 +200,7 @@ func (b *NFTCollectionsRequestBuilder) Do(ctx context.Context) (*NFTCollectionRe
 	if err := b.service.client.DecodeResponse(resp, &nftResp); err != nil {
 		return nil, err
 	}
+	nftResp.Data = emptyIfNil(nftResp.Data)
 
 	return &nftResp, nil
 }
@@ -205,16 +216,30 @@ func (s *Service) GetNFTCollection() *NFTCollectionRequestBuilder {
 	return &NFTCollectionRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the NFT collection details request builder
+func (b *NFTCollectionRequestBuilder) Clone() *NFTCollectionRequestBuilder {
+	c := *b
+	return &c
+}
+
 // NFTType sets the NFT collection type (required)
 func (b *NFTCollectionRequestBuilder) NFTType(nftType string) *NFTCollectionRequestBuilder {
 	b.nftType = nftType
 	return b
 }
 
+// Validate checks the NFT collection details request without making a network call
+func (b *NFTCollectionRequestBuilder) Validate() error {
+	if b.nftType == "" {
+		return fmt.Errorf("NFT type is required")
+	}
+	return nil
+}
+
 // Do executes the NFT collection details request
 func (b *NFTCollectionRequestBuilder) Do(ctx context.Context) (*NFTCollectionDetailsResponse, error) {
-	if b.nftType == "" {
-		return nil, fmt.Errorf("NFT type is required")
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	path := fmt.Sprintf("/flow/v1/nft/%s", b.nftType)
@@ -227,19 +252,25 @@ func (b *NFTCollectionRequestBuilder) Do(ctx context.Context) (*NFTCollectionDet
 	if err := b.service.client.DecodeResponse(resp, &nftResp); err != nil {
 		return nil, err
 	}
+	nftResp.Data = emptyIfNil(nftResp.Data)
 
 	return &nftResp, nil
 }
 
 // NFTTransfersRequestBuilder builds a request to get NFT transfers
 type NFTTransfersRequestBuilder struct {
-	service *Service
-	address *string
-	height  *uint64
-	limit   *int
-	nftID   *int
-	nftType *string
-	offset  *int
+	service   *Service
+	address   *string
+	from      *string
+	height    *uint64
+	limit     *int
+	nftID     *int
+	nftType   *string
+	offset    *int
+	receiver  *string
+	sender    *string
+	to        *string
+	filterErr error
 }
 
 // GetNFTTransfers creates a new NFT transfers request builder
@@ -247,12 +278,31 @@ func (s *Service) GetNFTTransfers() *NFTTransfersRequestBuilder {
 	return &NFTTransfersRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the NFT transfers request builder
+func (b *NFTTransfersRequestBuilder) Clone() *NFTTransfersRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Address sets the address filter (optional)
 func (b *NFTTransfersRequestBuilder) Address(address string) *NFTTransfersRequestBuilder {
 	b.address = &address
 	return b
 }
 
+// From sets the start time filter as an RFC 3339 timestamp (optional)
+func (b *NFTTransfersRequestBuilder) From(from string) *NFTTransfersRequestBuilder {
+	b.from = &from
+	return b
+}
+
+// FromTime sets the start time filter (optional)
+func (b *NFTTransfersRequestBuilder) FromTime(from time.Time) *NFTTransfersRequestBuilder {
+	s := filter.FormatTime(from)
+	b.from = &s
+	return b
+}
+
 // Height sets the block height filter (optional)
 func (b *NFTTransfersRequestBuilder) Height(height uint64) *NFTTransfersRequestBuilder {
 	b.height = &height
@@ -283,8 +333,74 @@ func (b *NFTTransfersRequestBuilder) Offset(offset int) *NFTTransfersRequestBuil
 	return b
 }
 
-// Do executes the NFT transfers request
-func (b *NFTTransfersRequestBuilder) Do(ctx context.Context) (*NFTTransfersResponse, error) {
+// Receiver limits results to transfers received by an address (optional)
+func (b *NFTTransfersRequestBuilder) Receiver(receiver string) *NFTTransfersRequestBuilder {
+	b.receiver = &receiver
+	return b
+}
+
+// Sender limits results to transfers sent by an address (optional)
+func (b *NFTTransfersRequestBuilder) Sender(sender string) *NFTTransfersRequestBuilder {
+	b.sender = &sender
+	return b
+}
+
+// To sets the end time filter as an RFC 3339 timestamp (optional)
+func (b *NFTTransfersRequestBuilder) To(to string) *NFTTransfersRequestBuilder {
+	b.to = &to
+	return b
+}
+
+// ToTime sets the end time filter (optional)
+func (b *NFTTransfersRequestBuilder) ToTime(to time.Time) *NFTTransfersRequestBuilder {
+	s := filter.FormatTime(to)
+	b.to = &s
+	return b
+}
+
+// Apply applies reusable filters: address, height, time range and pagination (optional)
+func (b *NFTTransfersRequestBuilder) Apply(filters ...filter.Filter) *NFTTransfersRequestBuilder {
+	p := filter.Collect(filters...)
+	if err := p.Unsupported("GetNFTTransfers", filter.FieldHeightRange, filter.FieldTimeRange, filter.FieldAddress, filter.FieldPagination); err != nil {
+		b.filterErr = err
+		return b
+	}
+	if err := applySingleHeight(p, "GetNFTTransfers", &b.height); err != nil {
+		b.filterErr = err
+		return b
+	}
+	if p.Address != nil {
+		b.address = p.Address
+	}
+	applyTimeRange(p, &b.from, &b.to)
+	applyPagination(p, &b.limit, &b.offset)
+	return b
+}
+
+// Validate checks the NFT transfers request without making a network call
+func (b *NFTTransfersRequestBuilder) Validate() error {
+	if b.filterErr != nil {
+		return b.filterErr
+	}
+	if b.address != nil {
+		if err := validateAddress(*b.address); err != nil {
+			return err
+		}
+	}
+	if err := b.clientFilter().validate(); err != nil {
+		return err
+	}
+	return validatePage(b.limit, b.offset)
+}
+
+// clientFilter returns the filters the transfers endpoint does not support
+func (b *NFTTransfersRequestBuilder) clientFilter() transferFilter {
+	return transferFilter{sender: b.sender, receiver: b.receiver, from: b.from, to: b.to}
+}
+
+// query returns the NFT transfers request's server-side query parameters,
+// without limit and offset
+func (b *NFTTransfersRequestBuilder) query() url.Values {
 	query := url.Values{}
 	if b.address != nil {
 		query.Set("address", *b.address)
@@ -292,19 +408,28 @@ func (b *NFTTransfersRequestBuilder) Do(ctx context.Context) (*NFTTransfersRespo
 	if b.height != nil {
 		query.Set("height", strconv.FormatUint(*b.height, 10))
 	}
-	if b.limit != nil {
-		query.Set("limit", strconv.Itoa(*b.limit))
-	}
 	if b.nftID != nil {
 		query.Set("nft_id", strconv.Itoa(*b.nftID))
 	}
 	if b.nftType != nil {
 		query.Set("nft_type", *b.nftType)
 	}
-	if b.offset != nil {
-		query.Set("offset", strconv.Itoa(*b.offset))
+	return query
+}
+
+// Do executes the NFT transfers request. Sender, Receiver and the time range
+// are applied on the client (see transferFilter).
+func (b *NFTTransfersRequestBuilder) Do(ctx context.Context) (*NFTTransfersResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
+	query := b.query()
+	if f := b.clientFilter(); f.active() {
+		return filterPages(ctx, b.service.client, "/flow/v1/nft/transfer", query, b.limit, b.offset, f.matchNFT)
+	}
+
+	setPage(query, b.limit, b.offset)
 	resp, err := b.service.client.DoRequest(ctx, http.MethodGet, "/flow/v1/nft/transfer", query)
 	if err != nil {
 		return nil, err
@@ -314,10 +439,28 @@ func (b *NFTTransfersRequestBuilder) Do(ctx context.Context) (*NFTTransfersRespo
 	if err := b.service.client.DecodeResponse(resp, &transfersResp); err != nil {
 		return nil, err
 	}
+	transfersResp.Data = emptyIfNil(transfersResp.Data)
 
 	return &transfersResp, nil
 }
 
+// Stream iterates over the matching transfers page by page from the request's
+// offset, decoding one record at a time instead of whole pages so memory stays
+// flat during backfills. Limit sets the page size (default 100). If a request
+// fails the error is yielded once and iteration stops.
+func (b *NFTTransfersRequestBuilder) Stream(ctx context.Context) iter.Seq2[NFTTransfer, error] {
+	if err := b.Validate(); err != nil {
+		return func(yield func(NFTTransfer, error) bool) { yield(NFTTransfer{}, err) }
+	}
+	query := b.query()
+	setPage(query, b.limit, b.offset)
+	var keep func(*NFTTransfer) bool
+	if f := b.clientFilter(); f.active() {
+		keep = f.matchNFT
+	}
+	return streamPages(ctx, b.service.client, "/flow/v1/nft/transfer", query, keep)
+}
+
 // NFTHoldingsRequestBuilder builds a request to get NFT holdings
 type NFTHoldingsRequestBuilder struct {
 	service *Service
@@ -331,6 +474,12 @@ func (s *Service) GetNFTHoldings() *NFTHoldingsRequestBuilder {
 	return &NFTHoldingsRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the NFT holdings request builder
+func (b *NFTHoldingsRequestBuilder) Clone() *NFTHoldingsRequestBuilder {
+	c := *b
+	return &c
+}
+
 // NFTType sets the NFT type (required)
 func (b *NFTHoldingsRequestBuilder) NFTType(nftType string) *NFTHoldingsRequestBuilder {
 	b.nftType = nftType
@@ -349,10 +498,18 @@ func (b *NFTHoldingsRequestBuilder) Offset(offset int) *NFTHoldingsRequestBuilde
 	return b
 }
 
+// Validate checks the NFT holdings request without making a network call
+func (b *NFTHoldingsRequestBuilder) Validate() error {
+	if b.nftType == "" {
+		return fmt.Errorf("NFT type is required")
+	}
+	return validatePage(b.limit, b.offset)
+}
+
 // Do executes the NFT holdings request
 func (b *NFTHoldingsRequestBuilder) Do(ctx context.Context) (*NFTHoldingResponse, error) {
-	if b.nftType == "" {
-		return nil, fmt.Errorf("NFT type is required")
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	query := url.Values{}
@@ -373,6 +530,7 @@ func (b *NFTHoldingsRequestBuilder) Do(ctx context.Context) (*NFTHoldingResponse
 	if err := b.service.client.DecodeResponse(resp, &holdingsResp); err != nil {
 		return nil, err
 	}
+	holdingsResp.Data = emptyIfNil(holdingsResp.Data)
 
 	return &holdingsResp, nil
 }
@@ -389,6 +547,12 @@ func (s *Service) GetNFTItem() *NFTItemRequestBuilder {
 	return &NFTItemRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the NFT item details request builder
+func (b *NFTItemRequestBuilder) Clone() *NFTItemRequestBuilder {
+	c := *b
+	return &c
+}
+
 // NFTType sets the NFT type (required)
 func (b *NFTItemRequestBuilder) NFTType(nftType string) *NFTItemRequestBuilder {
 	b.nftType = nftType
@@ -401,13 +565,21 @@ func (b *NFTItemRequestBuilder) ID(id string) *NFTItemRequestBuilder {
 	return b
 }
 
-// Do executes the NFT item details request
-func (b *NFTItemRequestBuilder) Do(ctx context.Context) (*NFTDetailsResponse, error) {
+// Validate checks the NFT item details request without making a network call
+func (b *NFTItemRequestBuilder) Validate() error {
 	if b.nftType == "" {
-		return nil, fmt.Errorf("NFT type is required")
+		return fmt.Errorf("NFT type is required")
 	}
 	if b.id == "" {
-		return nil, fmt.Errorf("NFT ID is required")
+		return fmt.Errorf("NFT ID is required")
+	}
+	return nil
+}
+
+// Do executes the NFT item details request
+func (b *NFTItemRequestBuilder) Do(ctx context.Context) (*NFTDetailsResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	path := fmt.Sprintf("/flow/v1/nft/%s/item/%s", b.nftType, b.id)
@@ -420,10 +592,160 @@ func (b *NFTItemRequestBuilder) Do(ctx context.Context) (*NFTDetailsResponse, er
 	if err := b.service.client.DecodeResponse(resp, &nftResp); err != nil {
 		return nil, err
 	}
+	nftResp.Data = emptyIfNil(nftResp.Data)
 
 	return &nftResp, nil
 }
 
+// NFTItemsRequestBuilder builds a request to list the items of an NFT collection
+type NFTItemsRequestBuilder struct {
+	service *Service
+	nftType string
+	name    *string
+	traits  []traitFilter
+	limit   *int
+	offset  *int
+}
+
+// GetNFTItems creates a new NFT items request builder
+func (s *Service) GetNFTItems() *NFTItemsRequestBuilder {
+	return &NFTItemsRequestBuilder{service: s}
+}
+
+// Clone returns an independent copy of the NFT items request builder
+func (b *NFTItemsRequestBuilder) Clone() *NFTItemsRequestBuilder {
+	c := *b
+	c.traits = slices.Clone(b.traits)
+	return &c
+}
+
+// NFTType sets the NFT type (required)
+func (b *NFTItemsRequestBuilder) NFTType(nftType string) *NFTItemsRequestBuilder {
+	b.nftType = nftType
+	return b
+}
+
+// Name filters by NFT name, case-insensitive (optional)
+func (b *NFTItemsRequestBuilder) Name(name string) *NFTItemsRequestBuilder {
+	b.name = &name
+	return b
+}
+
+// Trait filters by a metadata trait, e.g. Trait("Background", "Gold"),
+// comparing case-insensitively. Repeat to require several traits. Traits are
+// matched on the client, one GetNFTItem request per listed item (optional).
+func (b *NFTItemsRequestBuilder) Trait(name, value string) *NFTItemsRequestBuilder {
+	b.traits = append(b.traits, traitFilter{name, value})
+	return b
+}
+
+// Limit sets the number of records to return (optional, default 25, max 100)
+func (b *NFTItemsRequestBuilder) Limit(limit int) *NFTItemsRequestBuilder {
+	b.limit = &limit
+	return b
+}
+
+// Offset sets the pagination offset (optional)
+func (b *NFTItemsRequestBuilder) Offset(offset int) *NFTItemsRequestBuilder {
+	b.offset = &offset
+	return b
+}
+
+// Validate checks the NFT items request without making a network call
+func (b *NFTItemsRequestBuilder) Validate() error {
+	if b.nftType == "" {
+		return fmt.Errorf("NFT type is required")
+	}
+	if err := validateTraits(b.traits); err != nil {
+		return err
+	}
+	return validatePage(b.limit, b.offset)
+}
+
+// query returns the NFT items request's server-side query parameters,
+// without limit and offset
+func (b *NFTItemsRequestBuilder) query() url.Values {
+	query := url.Values{}
+	if b.name != nil {
+		query.Set("name", *b.name)
+	}
+	return query
+}
+
+// Do executes the NFT items request. Trait filters are applied on the client.
+func (b *NFTItemsRequestBuilder) Do(ctx context.Context) (*NFTItemsResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/nft/v0/%s/item", b.nftType)
+	query := b.query()
+	if len(b.traits) > 0 {
+		var traitErr error
+		itemsResp, err := filterPages(ctx, b.service.client, path, query, b.limit, b.offset, func(item *NFTItem) bool {
+			if traitErr != nil {
+				return false
+			}
+			ok, err := b.service.itemHasTraits(ctx, b.nftType, item.NFTId, b.traits)
+			traitErr = err
+			return ok
+		})
+		if err != nil {
+			return nil, err
+		}
+		if traitErr != nil {
+			return nil, traitErr
+		}
+		return itemsResp, nil
+	}
+
+	setPage(query, b.limit, b.offset)
+	resp, err := b.service.client.DoRequest(ctx, http.MethodGet, path, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var itemsResp NFTItemsResponse
+	if err := b.service.client.DecodeResponse(resp, &itemsResp); err != nil {
+		return nil, err
+	}
+	itemsResp.Data = emptyIfNil(itemsResp.Data)
+
+	return &itemsResp, nil
+}
+
+// Stream iterates over the matching items page by page from the request's
+// offset, decoding one record at a time instead of whole pages so memory stays
+// flat during backfills. Limit sets the page size (default 100). If a request
+// fails the error is yielded once and iteration stops.
+func (b *NFTItemsRequestBuilder) Stream(ctx context.Context) iter.Seq2[NFTItem, error] {
+	if err := b.Validate(); err != nil {
+		return func(yield func(NFTItem, error) bool) { yield(NFTItem{}, err) }
+	}
+	query := b.query()
+	setPage(query, b.limit, b.offset)
+	items := streamPages[NFTItem](ctx, b.service.client, fmt.Sprintf("/nft/v0/%s/item", b.nftType), query, nil)
+	if len(b.traits) == 0 {
+		return items
+	}
+	return func(yield func(NFTItem, error) bool) {
+		for item, err := range items {
+			if err != nil {
+				yield(item, err)
+				return
+			}
+			ok, err := b.service.itemHasTraits(ctx, b.nftType, item.NFTId, b.traits)
+			if err != nil {
+				yield(NFTItem{}, err)
+				return
+			}
+			if ok && !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 // AccountNFTCollectionsRequestBuilder builds a request to get account NFT collections
 type AccountNFTCollectionsRequestBuilder struct {
 	service *Service
@@ -437,6 +759,12 @@ func (s *Service) GetAccountNFTCollections() *AccountNFTCollectionsRequestBuilde
 	return &AccountNFTCollectionsRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the account NFT collections request builder
+func (b *AccountNFTCollectionsRequestBuilder) Clone() *AccountNFTCollectionsRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Address sets the account address (required)
 func (b *AccountNFTCollectionsRequestBuilder) Address(address string) *AccountNFTCollectionsRequestBuilder {
 	b.address = address
@@ -455,10 +783,21 @@ func (b *AccountNFTCollectionsRequestBuilder) Offset(offset int) *AccountNFTColl
 	return b
 }
 
+// Validate checks the account NFT collections request without making a network call
+func (b *AccountNFTCollectionsRequestBuilder) Validate() error {
+	if b.address == "" {
+		return fmt.Errorf("account address is required")
+	}
+	if err := validateAddress(b.address); err != nil {
+		return err
+	}
+	return validatePage(b.limit, b.offset)
+}
+
 // Do executes the account NFT collections request
 func (b *AccountNFTCollectionsRequestBuilder) Do(ctx context.Context) (*AccountNFTCollectionsResponse, error) {
-	if b.address == "" {
-		return nil, fmt.Errorf("account address is required")
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	query := url.Values{}
@@ -479,6 +818,7 @@ func (b *AccountNFTCollectionsRequestBuilder) Do(ctx context.Context) (*AccountN
 	if err := b.service.client.DecodeResponse(resp, &collectionsResp); err != nil {
 		return nil, err
 	}
+	collectionsResp.Data = emptyIfNil(collectionsResp.Data)
 
 	return &collectionsResp, nil
 }
@@ -488,6 +828,7 @@ type AccountNFTsRequestBuilder struct {
 	service   *Service
 	address   string
 	nftType   string
+	traits    []traitFilter
 	limit     *int
 	offset    *int
 	validOnly *bool
@@ -499,6 +840,13 @@ func (s *Service) GetAccountNFTs() *AccountNFTsRequestBuilder {
 	return &AccountNFTsRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the account NFTs request builder
+func (b *AccountNFTsRequestBuilder) Clone() *AccountNFTsRequestBuilder {
+	c := *b
+	c.traits = slices.Clone(b.traits)
+	return &c
+}
+
 // Address sets the account address (required)
 func (b *AccountNFTsRequestBuilder) Address(address string) *AccountNFTsRequestBuilder {
 	b.address = address
@@ -511,6 +859,15 @@ func (b *AccountNFTsRequestBuilder) NFTType(nftType string) *AccountNFTsRequestB
 	return b
 }
 
+// Trait filters by a metadata trait, e.g. Trait("Background", "Gold"),
+// comparing case-insensitively. Repeat to require several traits. Traits are
+// matched on the client, looking up items without metadata with GetNFTItem
+// (optional).
+func (b *AccountNFTsRequestBuilder) Trait(name, value string) *AccountNFTsRequestBuilder {
+	b.traits = append(b.traits, traitFilter{name, value})
+	return b
+}
+
 // Limit sets the number of records to return (optional, default 25, max 100)
 func (b *AccountNFTsRequestBuilder) Limit(limit int) *AccountNFTsRequestBuilder {
 	b.limit = &limit
@@ -535,22 +892,30 @@ func (b *AccountNFTsRequestBuilder) SortBy(sortBy string) *AccountNFTsRequestBui
 	return b
 }
 
-// Do executes the account NFTs request
-func (b *AccountNFTsRequestBuilder) Do(ctx context.Context) (*AccountNFTResponse, error) {
+// Validate checks the account NFTs request without making a network call
+func (b *AccountNFTsRequestBuilder) Validate() error {
 	if b.address == "" {
-		return nil, fmt.Errorf("account address is required")
+		return fmt.Errorf("account address is required")
 	}
 	if b.nftType == "" {
-		return nil, fmt.Errorf("NFT type is required")
+		return fmt.Errorf("NFT type is required")
 	}
-
-	query := url.Values{}
-	if b.limit != nil {
-		query.Set("limit", strconv.Itoa(*b.limit))
+	if err := validateAddress(b.address); err != nil {
+		return err
 	}
-	if b.offset != nil {
-		query.Set("offset", strconv.Itoa(*b.offset))
+	if err := validateTraits(b.traits); err != nil {
+		return err
+	}
+	return validatePage(b.limit, b.offset)
+}
+
+// Do executes the account NFTs request
+func (b *AccountNFTsRequestBuilder) Do(ctx context.Context) (*AccountNFTResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
+
+	query := url.Values{}
 	if b.validOnly != nil {
 		query.Set("valid_only", strconv.FormatBool(*b.validOnly))
 	}
@@ -559,6 +924,29 @@ func (b *AccountNFTsRequestBuilder) Do(ctx context.Context) (*AccountNFTResponse
 	}
 
 	path := fmt.Sprintf("/flow/v1/account/%s/nft/%s", b.address, b.nftType)
+	if len(b.traits) > 0 {
+		var traitErr error
+		nftResp, err := filterPages(ctx, b.service.client, path, query, b.limit, b.offset, func(n *AccountNFT) bool {
+			if traitErr != nil {
+				return false
+			}
+			if n.Metadata != nil {
+				return matchTraits(n.ParsedMetadata(), b.traits)
+			}
+			ok, err := b.service.itemHasTraits(ctx, b.nftType, n.NFTId, b.traits)
+			traitErr = err
+			return ok
+		})
+		if err != nil {
+			return nil, err
+		}
+		if traitErr != nil {
+			return nil, traitErr
+		}
+		return nftResp, nil
+	}
+
+	setPage(query, b.limit, b.offset)
 	resp, err := b.service.client.DoRequest(ctx, http.MethodGet, path, query)
 	if err != nil {
 		return nil, err
@@ -568,6 +956,248 @@ func (b *AccountNFTsRequestBuilder) Do(ctx context.Context) (*AccountNFTResponse
 	if err := b.service.client.DecodeResponse(resp, &nftResp); err != nil {
 		return nil, err
 	}
+	nftResp.Data = emptyIfNil(nftResp.Data)
 
 	return &nftResp, nil
 }
+
+// AccountNFTTransfersRequestBuilder builds a request to get account NFT transfers
+type AccountNFTTransfersRequestBuilder struct {
+	service   *Service
+	address   string
+	direction *string
+	nftType   *string
+	height    *uint64
+	from      *string
+	to        *string
+	limit     *int
+	offset    *int
+	filterErr error
+}
+
+// GetAccountNFTTransfers creates a new account NFT transfers request builder
+func (s *Service) GetAccountNFTTransfers() *AccountNFTTransfersRequestBuilder {
+	return &AccountNFTTransfersRequestBuilder{service: s}
+}
+
+// Clone returns an independent copy of the account NFT transfers request builder
+func (b *AccountNFTTransfersRequestBuilder) Clone() *AccountNFTTransfersRequestBuilder {
+	c := *b
+	return &c
+}
+
+// Address sets the account address (required)
+func (b *AccountNFTTransfersRequestBuilder) Address(address string) *AccountNFTTransfersRequestBuilder {
+	b.address = address
+	return b
+}
+
+// Direction limits transfers to those received (DirectionIn) or sent
+// (DirectionOut) by the account (optional)
+func (b *AccountNFTTransfersRequestBuilder) Direction(direction string) *AccountNFTTransfersRequestBuilder {
+	b.direction = &direction
+	return b
+}
+
+// NFTType sets the NFT collection type filter (optional)
+func (b *AccountNFTTransfersRequestBuilder) NFTType(nftType string) *AccountNFTTransfersRequestBuilder {
+	b.nftType = &nftType
+	return b
+}
+
+// Height sets the block height filter (optional)
+func (b *AccountNFTTransfersRequestBuilder) Height(height uint64) *AccountNFTTransfersRequestBuilder {
+	b.height = &height
+	return b
+}
+
+// From sets the start time filter as an RFC 3339 timestamp (optional)
+func (b *AccountNFTTransfersRequestBuilder) From(from string) *AccountNFTTransfersRequestBuilder {
+	b.from = &from
+	return b
+}
+
+// FromTime sets the start time filter (optional)
+func (b *AccountNFTTransfersRequestBuilder) FromTime(from time.Time) *AccountNFTTransfersRequestBuilder {
+	s := filter.FormatTime(from)
+	b.from = &s
+	return b
+}
+
+// To sets the end time filter as an RFC 3339 timestamp (optional)
+func (b *AccountNFTTransfersRequestBuilder) To(to string) *AccountNFTTransfersRequestBuilder {
+	b.to = &to
+	return b
+}
+
+// ToTime sets the end time filter (optional)
+func (b *AccountNFTTransfersRequestBuilder) ToTime(to time.Time) *AccountNFTTransfersRequestBuilder {
+	s := filter.FormatTime(to)
+	b.to = &s
+	return b
+}
+
+// Limit sets the number of records to return (optional, default 25, max 100)
+func (b *AccountNFTTransfersRequestBuilder) Limit(limit int) *AccountNFTTransfersRequestBuilder {
+	b.limit = &limit
+	return b
+}
+
+// Offset sets the pagination offset (optional)
+func (b *AccountNFTTransfersRequestBuilder) Offset(offset int) *AccountNFTTransfersRequestBuilder {
+	b.offset = &offset
+	return b
+}
+
+// Apply applies reusable filters: address, height, time range and pagination (optional)
+func (b *AccountNFTTransfersRequestBuilder) Apply(filters ...filter.Filter) *AccountNFTTransfersRequestBuilder {
+	p := filter.Collect(filters...)
+	if err := p.Unsupported("GetAccountNFTTransfers", filter.FieldHeightRange, filter.FieldTimeRange, filter.FieldAddress, filter.FieldPagination); err != nil {
+		b.filterErr = err
+		return b
+	}
+	if err := applySingleHeight(p, "GetAccountNFTTransfers", &b.height); err != nil {
+		b.filterErr = err
+		return b
+	}
+	if p.Address != nil {
+		b.address = *p.Address
+	}
+	applyTimeRange(p, &b.from, &b.to)
+	applyPagination(p, &b.limit, &b.offset)
+	return b
+}
+
+// Validate checks the account NFT transfers request without making a network call
+func (b *AccountNFTTransfersRequestBuilder) Validate() error {
+	if b.filterErr != nil {
+		return b.filterErr
+	}
+	if b.address == "" {
+		return fmt.Errorf("account address is required")
+	}
+	if err := validateAddress(b.address); err != nil {
+		return err
+	}
+	if err := b.clientFilter().validate(); err != nil {
+		return err
+	}
+	return validatePage(b.limit, b.offset)
+}
+
+// clientFilter returns the filters the transfers endpoint does not support
+func (b *AccountNFTTransfersRequestBuilder) clientFilter() transferFilter {
+	return transferFilter{account: b.address, direction: b.direction, from: b.from, to: b.to}
+}
+
+// Do executes the account NFT transfers request. Transfers are listed with
+// GetNFTTransfers' address filter; Direction and the time range are applied
+// on the client (see transferFilter).
+func (b *AccountNFTTransfersRequestBuilder) Do(ctx context.Context) (*NFTTransfersResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("address", b.address)
+	if b.nftType != nil {
+		query.Set("nft_type", *b.nftType)
+	}
+	if b.height != nil {
+		query.Set("height", strconv.FormatUint(*b.height, 10))
+	}
+
+	if f := b.clientFilter(); f.active() {
+		return filterPages(ctx, b.service.client, "/flow/v1/nft/transfer", query, b.limit, b.offset, f.matchNFT)
+	}
+
+	if b.limit != nil {
+		query.Set("limit", strconv.Itoa(*b.limit))
+	}
+	if b.offset != nil {
+		query.Set("offset", strconv.Itoa(*b.offset))
+	}
+
+	resp, err := b.service.client.DoRequest(ctx, http.MethodGet, "/flow/v1/nft/transfer", query)
+	if err != nil {
+		return nil, err
+	}
+
+	var transfersResp NFTTransfersResponse
+	if err := b.service.client.DecodeResponse(resp, &transfersResp); err != nil {
+		return nil, err
+	}
+	transfersResp.Data = emptyIfNil(transfersResp.Data)
+
+	return &transfersResp, nil
+}
+
+// AccountNFTRequestBuilder builds a request to get one NFT owned by an account
+type AccountNFTRequestBuilder struct {
+	service *Service
+	address string
+	nftType string
+	id      string
+}
+
+// GetAccountNFT creates a new account NFT request builder
+func (s *Service) GetAccountNFT() *AccountNFTRequestBuilder {
+	return &AccountNFTRequestBuilder{service: s}
+}
+
+// Clone returns an independent copy of the account NFT request builder
+func (b *AccountNFTRequestBuilder) Clone() *AccountNFTRequestBuilder {
+	c := *b
+	return &c
+}
+
+// Address sets the account address (required)
+func (b *AccountNFTRequestBuilder) Address(address string) *AccountNFTRequestBuilder {
+	b.address = address
+	return b
+}
+
+// NFTType sets the NFT collection type (required)
+func (b *AccountNFTRequestBuilder) NFTType(nftType string) *AccountNFTRequestBuilder {
+	b.nftType = nftType
+	return b
+}
+
+// ID sets the NFT ID (required)
+func (b *AccountNFTRequestBuilder) ID(id string) *AccountNFTRequestBuilder {
+	b.id = id
+	return b
+}
+
+// Validate checks the account NFT request without making a network call
+func (b *AccountNFTRequestBuilder) Validate() error {
+	if b.address == "" {
+		return fmt.Errorf("account address is required")
+	}
+	if b.nftType == "" {
+		return fmt.Errorf("NFT type is required")
+	}
+	if b.id == "" {
+		return fmt.Errorf("NFT ID is required")
+	}
+	return validateAddress(b.address)
+}
+
+// Do executes the account NFT request. The NFT is fetched with GetNFTItem and
+// its owner checked on the client; the response is empty if the account does
+// not own the NFT.
+func (b *AccountNFTRequestBuilder) Do(ctx context.Context) (*NFTDetailsResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	nftResp, err := b.service.GetNFTItem().NFTType(b.nftType).ID(b.id).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nftResp.Data = slices.DeleteFunc(nftResp.Data, func(nft NFT) bool {
+		return normalizeAddress(nft.Owner) != normalizeAddress(b.address)
+	})
+
+	return nftResp, nil
+}
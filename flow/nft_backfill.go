@@ -0,0 +1,204 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// NFTBackfillCheckpoint records how far a metadata backfill has progressed. It
+// is JSON-serializable so it can be persisted and passed back to resume a run.
+type NFTBackfillCheckpoint struct {
+	NFTType string `json:"nft_type"`
+	// Offset is the listing offset of the next page to process
+	Offset int `json:"offset"`
+	// Processed is the number of items handed to the callback so far
+	Processed int `json:"processed"`
+	// Done is set once the last page of the collection has been processed
+	Done bool `json:"done"`
+}
+
+// NFTBackfillOptions configures BackfillNFTMetadata
+type NFTBackfillOptions struct {
+	// QPS caps the rate of all requests made by the backfill; 0 means unlimited
+	QPS float64
+	// Concurrency is the number of item requests in flight (default 1)
+	Concurrency int
+	// Resume continues from a checkpoint saved by a previous run
+	Resume *NFTBackfillCheckpoint
+	// OnCheckpoint is called after every page has been fully handled, e.g. to
+	// persist progress. Returning an error stops the backfill.
+	OnCheckpoint func(NFTBackfillCheckpoint) error
+}
+
+// BackfillNFTMetadata walks every item of a collection with GetNFTItems, fetches
+// each item's metadata with GetNFTItem and passes it to fn, one page at a time
+// and in listing order. fn is never called concurrently. Items without a detail
+// record are passed with the listing fields only.
+//
+// Progress is checkpointed after each page; on error the returned checkpoint
+// points at the first page that was not completed, so a run can be resumed with
+// NFTBackfillOptions.Resume. Rate limited responses are retried by the client,
+// QPS keeps the backfill within a request budget to avoid them in the first place.
+func (s *Service) BackfillNFTMetadata(ctx context.Context, nftType string, opts NFTBackfillOptions, fn func(NFT) error) (NFTBackfillCheckpoint, error) {
+	cp := NFTBackfillCheckpoint{NFTType: nftType}
+	if nftType == "" {
+		return cp, fmt.Errorf("NFT type is required")
+	}
+	if fn == nil {
+		return cp, fmt.Errorf("callback is required")
+	}
+	if opts.Resume != nil {
+		if opts.Resume.NFTType != nftType {
+			return cp, fmt.Errorf("checkpoint is for %s, not %s", opts.Resume.NFTType, nftType)
+		}
+		cp = *opts.Resume
+		if cp.Done {
+			return cp, nil
+		}
+	}
+
+	limiter := newQPSLimiter(opts.QPS)
+	defer limiter.stop()
+
+	for {
+		if err := limiter.wait(ctx); err != nil {
+			return cp, err
+		}
+		resp, err := s.GetNFTItems().NFTType(nftType).Limit(maxPageSize).Offset(cp.Offset).Do(ctx)
+		if err != nil {
+			return cp, fmt.Errorf("list items at offset %d: %w", cp.Offset, err)
+		}
+
+		nfts, err := s.fetchNFTDetails(ctx, nftType, resp.Data, opts.Concurrency, limiter)
+		if err != nil {
+			return cp, err
+		}
+		for _, nft := range nfts {
+			if err := fn(nft); err != nil {
+				return cp, err
+			}
+		}
+
+		cp.Offset += len(resp.Data)
+		cp.Processed += len(resp.Data)
+		cp.Done = len(resp.Data) < maxPageSize
+		if opts.OnCheckpoint != nil {
+			if err := opts.OnCheckpoint(cp); err != nil {
+				return cp, err
+			}
+		}
+		if cp.Done {
+			return cp, nil
+		}
+	}
+}
+
+// fetchNFTDetails fetches the detail record of each item with bounded
+// concurrency and returns them in item order
+func (s *Service) fetchNFTDetails(ctx context.Context, nftType string, items []NFTItem, concurrency int, limiter *qpsLimiter) ([]NFT, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	nfts := make([]NFT, len(items))
+	sem := make(chan struct{}, max(concurrency, 1))
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	for i, item := range items {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			nft, err := s.fetchNFTDetail(ctx, nftType, item, limiter)
+			if err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			nfts[i] = nft
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return nfts, nil
+}
+
+// fetchNFTDetail fetches a single item's detail record, falling back to the
+// listing fields when the API has none
+func (s *Service) fetchNFTDetail(ctx context.Context, nftType string, item NFTItem, limiter *qpsLimiter) (NFT, error) {
+	if err := limiter.wait(ctx); err != nil {
+		return NFT{}, err
+	}
+	id := strconv.FormatInt(item.NFTId, 10)
+	resp, err := s.GetNFTItem().NFTType(nftType).ID(id).Do(ctx)
+	if err != nil {
+		return NFT{}, fmt.Errorf("fetch item %s: %w", id, err)
+	}
+	if len(resp.Data) > 0 {
+		return resp.Data[0], nil
+	}
+	return NFT{
+		BlockHeight: item.BlockHeight,
+		ID:          item.ID,
+		Name:        item.Name,
+		NFTId:       item.NFTId,
+		NFTType:     item.NFTType,
+		Owner:       item.Owner,
+		Thumbnail:   item.Thumbnail,
+	}, nil
+}
+
+// qpsLimiter spaces requests evenly to stay within a requests-per-second
+// budget. A nil limiter does not limit.
+type qpsLimiter struct {
+	ticker *time.Ticker
+}
+
+func newQPSLimiter(qps float64) *qpsLimiter {
+	if qps <= 0 {
+		return nil
+	}
+	return &qpsLimiter{ticker: time.NewTicker(time.Duration(float64(time.Second) / qps))}
+}
+
+// wait blocks until the next request may be made or ctx is done
+func (l *qpsLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return ctx.Err()
+	}
+	select {
+	case <-l.ticker.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l *qpsLimiter) stop() {
+	if l != nil {
+		l.ticker.Stop()
+	}
+}
@@ -0,0 +1,166 @@
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+const backfillNFTType = "A.0b2a3299cc857e29.TopShot.NFT"
+
+// newBackfillServer serves a collection of total items, each with metadata
+// {"n": <nft_id>}, and counts item detail requests
+func newBackfillServer(t *testing.T, total int, detailCalls *atomic.Int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/nft/v0/"+backfillNFTType+"/item":
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+			limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+			items := []NFTItem{}
+			for i := offset; i < total && i < offset+limit; i++ {
+				items = append(items, NFTItem{NFTId: int64(i), NFTType: backfillNFTType})
+			}
+			json.NewEncoder(w).Encode(NFTItemsResponse{Data: items})
+		case strings.HasPrefix(r.URL.Path, "/flow/v1/nft/"+backfillNFTType+"/item/"):
+			detailCalls.Add(1)
+			id, _ := strconv.ParseInt(r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:], 10, 64)
+			json.NewEncoder(w).Encode(NFTDetailsResponse{Data: []NFT{
+				{NFTId: id, NFTType: backfillNFTType, Metadata: map[string]interface{}{"n": float64(id)}},
+			}})
+		default:
+			t.Errorf("Unexpected request %s", r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func TestFlowService_BackfillNFTMetadata(t *testing.T) {
+	var detailCalls atomic.Int32
+	server := newBackfillServer(t, 150, &detailCalls)
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+
+	var got []int64
+	var checkpoints []NFTBackfillCheckpoint
+	cp, err := service.BackfillNFTMetadata(context.Background(), backfillNFTType, NFTBackfillOptions{
+		Concurrency:  4,
+		OnCheckpoint: func(cp NFTBackfillCheckpoint) error { checkpoints = append(checkpoints, cp); return nil },
+	}, func(nft NFT) error {
+		if nft.Metadata["n"] != float64(nft.NFTId) {
+			t.Errorf("Expected metadata for %d, got %v", nft.NFTId, nft.Metadata)
+		}
+		got = append(got, nft.NFTId)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("BackfillNFTMetadata failed: %v", err)
+	}
+
+	if len(got) != 150 {
+		t.Fatalf("Expected 150 items, got %d", len(got))
+	}
+	for i, id := range got {
+		if id != int64(i) {
+			t.Fatalf("Expected items in listing order, got %d at %d", id, i)
+		}
+	}
+	if detailCalls.Load() != 150 {
+		t.Errorf("Expected 150 detail requests, got %d", detailCalls.Load())
+	}
+	if len(checkpoints) != 2 || checkpoints[0].Offset != 100 || checkpoints[0].Done {
+		t.Errorf("Expected a checkpoint per page, got %+v", checkpoints)
+	}
+	if !cp.Done || cp.Offset != 150 || cp.Processed != 150 {
+		t.Errorf("Expected completed checkpoint at 150, got %+v", cp)
+	}
+}
+
+func TestFlowService_BackfillNFTMetadata_Resume(t *testing.T) {
+	var detailCalls atomic.Int32
+	server := newBackfillServer(t, 150, &detailCalls)
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	ctx := context.Background()
+
+	// Fail on the first item of the second page
+	stop := errors.New("stop")
+	cp, err := service.BackfillNFTMetadata(ctx, backfillNFTType, NFTBackfillOptions{}, func(nft NFT) error {
+		if nft.NFTId == 100 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) {
+		t.Fatalf("Expected callback error, got %v", err)
+	}
+	if cp.Offset != 100 || cp.Processed != 100 || cp.Done {
+		t.Fatalf("Expected checkpoint at 100, got %+v", cp)
+	}
+
+	var resumed []int64
+	cp, err = service.BackfillNFTMetadata(ctx, backfillNFTType, NFTBackfillOptions{Resume: &cp}, func(nft NFT) error {
+		resumed = append(resumed, nft.NFTId)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Resume failed: %v", err)
+	}
+	if len(resumed) != 50 || resumed[0] != 100 {
+		t.Errorf("Expected items 100-149 on resume, got %d starting at %v", len(resumed), resumed)
+	}
+	if !cp.Done || cp.Processed != 150 {
+		t.Errorf("Expected completed checkpoint, got %+v", cp)
+	}
+
+	// A completed checkpoint makes no requests
+	before := detailCalls.Load()
+	if _, err := service.BackfillNFTMetadata(ctx, backfillNFTType, NFTBackfillOptions{Resume: &cp}, func(NFT) error { return nil }); err != nil {
+		t.Fatalf("Resume of completed checkpoint failed: %v", err)
+	}
+	if detailCalls.Load() != before {
+		t.Errorf("Expected no requests for a completed checkpoint")
+	}
+
+	other := NFTBackfillCheckpoint{NFTType: "A.1.Other.NFT"}
+	if _, err := service.BackfillNFTMetadata(ctx, backfillNFTType, NFTBackfillOptions{Resume: &other}, func(NFT) error { return nil }); err == nil {
+		t.Error("Expected error for checkpoint of another collection")
+	}
+}
+
+func TestFlowService_BackfillNFTMetadata_QPS(t *testing.T) {
+	var detailCalls atomic.Int32
+	server := newBackfillServer(t, 5, &detailCalls)
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+
+	// 6 requests at 200 QPS take at least 30ms; cancel well before that
+	ctx, cancel := context.WithCancel(context.Background())
+	n := 0
+	_, err := service.BackfillNFTMetadata(ctx, backfillNFTType, NFTBackfillOptions{QPS: 200, Concurrency: 5}, func(NFT) error {
+		n++
+		return nil
+	})
+	cancel()
+	if err != nil {
+		t.Fatalf("BackfillNFTMetadata failed: %v", err)
+	}
+	if n != 5 {
+		t.Errorf("Expected 5 items, got %d", n)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	if _, err := service.BackfillNFTMetadata(ctx, backfillNFTType, NFTBackfillOptions{QPS: 1}, func(NFT) error { return nil }); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
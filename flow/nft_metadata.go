@@ -0,0 +1,73 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/peterargue/find-api/nft"
+)
+
+// ParsedMetadata returns the NFT's metadata as typed MetadataViews fields
+func (n NFT) ParsedMetadata() nft.Metadata {
+	return nft.ParseMetadata(n.Metadata)
+}
+
+// Traits returns the NFT's traits
+func (n NFT) Traits() []nft.Trait {
+	return n.ParsedMetadata().Traits
+}
+
+// ParsedMetadata returns the NFT's metadata as typed MetadataViews fields
+func (n AccountNFT) ParsedMetadata() nft.Metadata {
+	return nft.ParseMetadata(n.Metadata)
+}
+
+// Traits returns the NFT's traits
+func (n AccountNFT) Traits() []nft.Trait {
+	return n.ParsedMetadata().Traits
+}
+
+// The NFT listing endpoints neither filter by trait nor return metadata, so
+// trait filters are applied on the client: every page is fetched, each item's
+// metadata is read from GetNFTItem and Limit and Offset are applied to the
+// matches. That is one request per listed item, so narrow the listing (e.g. by
+// Name) where possible.
+
+// traitFilter is a trait name and value an NFT query is filtered by
+type traitFilter struct {
+	name  string
+	value string
+}
+
+// validateTraits checks trait filters without making a network call
+func validateTraits(traits []traitFilter) error {
+	for _, t := range traits {
+		if t.name == "" {
+			return fmt.Errorf("trait name is required")
+		}
+	}
+	return nil
+}
+
+// matchTraits reports whether metadata has every trait filter, comparing
+// names and values case-insensitively
+func matchTraits(m nft.Metadata, traits []traitFilter) bool {
+	for _, f := range traits {
+		t, ok := m.Trait(f.name)
+		if !ok || !strings.EqualFold(t.ValueString(), f.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// itemHasTraits reads an item's metadata from GetNFTItem and reports whether
+// it has every trait filter
+func (s *Service) itemHasTraits(ctx context.Context, nftType string, nftID int64, traits []traitFilter) (bool, error) {
+	detail, err := s.fetchNFTDetail(ctx, nftType, NFTItem{NFTId: nftID}, nil)
+	if err != nil {
+		return false, err
+	}
+	return matchTraits(detail.ParsedMetadata(), traits), nil
+}
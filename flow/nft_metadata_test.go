@@ -0,0 +1,24 @@
+package flow
+
+import "testing"
+
+func TestNFT_Traits(t *testing.T) {
+	n := NFT{Metadata: map[string]interface{}{
+		"display": map[string]interface{}{"name": "Moment"},
+		"traits": map[string]interface{}{"traits": []interface{}{
+			map[string]interface{}{"name": "Team", "value": "Lakers"},
+		}},
+	}}
+
+	if got := n.ParsedMetadata().Name; got != "Moment" {
+		t.Errorf("Expected name Moment, got %s", got)
+	}
+	traits := n.Traits()
+	if len(traits) != 1 || traits[0].Name != "Team" || traits[0].ValueString() != "Lakers" {
+		t.Errorf("Unexpected traits: %+v", traits)
+	}
+
+	if traits := (AccountNFT{}).Traits(); traits != nil {
+		t.Errorf("Expected no traits without metadata, got %+v", traits)
+	}
+}
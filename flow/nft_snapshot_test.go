@@ -0,0 +1,100 @@
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+func TestFlowService_SnapshotNFTHolders(t *testing.T) {
+	nftType := "A.0b2a3299cc857e29.TopShot.NFT"
+
+	var holdings []NFTHolding
+	for i := 0; i < 250; i++ {
+		holdings = append(holdings, NFTHolding{Owner: fmt.Sprintf("0x%016x", i), Count: i%3 + 1})
+	}
+
+	var pageRequests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/flow/v1/block":
+			if r.URL.Query().Get("limit") != "1" {
+				t.Errorf("Expected limit 1, got %s", r.URL.Query().Get("limit"))
+			}
+			json.NewEncoder(w).Encode(BlockResponse{Data: []Block{{Height: 1234}}})
+		case "/flow/v1/nft/" + nftType + "/holding":
+			pageRequests.Add(1)
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+			page := []NFTHolding{}
+			if offset < len(holdings) {
+				page = holdings[offset:min(offset+maxPageSize, len(holdings))]
+			}
+			json.NewEncoder(w).Encode(NFTHoldingResponse{Data: page})
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	snapshot, err := service.SnapshotNFTHolders(context.Background(), nftType)
+	if err != nil {
+		t.Fatalf("SnapshotNFTHolders failed: %v", err)
+	}
+
+	if snapshot.Height != 1234 {
+		t.Errorf("Expected height 1234, got %d", snapshot.Height)
+	}
+	if len(snapshot.Holders) != 250 {
+		t.Errorf("Expected 250 holders, got %d", len(snapshot.Holders))
+	}
+	total := 0
+	for _, h := range holdings {
+		total += h.Count
+		if snapshot.Holders[h.Owner] != h.Count {
+			t.Fatalf("Expected %s to hold %d, got %d", h.Owner, h.Count, snapshot.Holders[h.Owner])
+		}
+	}
+	if snapshot.Total != total {
+		t.Errorf("Expected total %d, got %d", total, snapshot.Total)
+	}
+	// Pages 0-2, plus at most one look-ahead request per extra worker
+	if n := pageRequests.Load(); n < 3 || n > 3+snapshotConcurrency {
+		t.Errorf("Expected between 3 and %d page requests, got %d", 3+snapshotConcurrency, n)
+	}
+}
+
+func TestFlowService_SnapshotNFTHolders_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/flow/v1/block" {
+			json.NewEncoder(w).Encode(BlockResponse{Data: []Block{{Height: 1}}})
+			return
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		if offset >= 2*maxPageSize {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		page := make([]NFTHolding, maxPageSize)
+		for i := range page {
+			page[i].Owner = strconv.Itoa(offset + i)
+		}
+		json.NewEncoder(w).Encode(NFTHoldingResponse{Data: page})
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	if _, err := service.SnapshotNFTHolders(context.Background(), "A.1.Coll.NFT"); err == nil {
+		t.Error("Expected error when a page fails")
+	}
+	if _, err := service.SnapshotNFTHolders(context.Background(), ""); err == nil {
+		t.Error("Expected error for missing NFT type")
+	}
+}
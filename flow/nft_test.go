@@ -6,7 +6,12 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"path"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/peterargue/find-api/filter"
 )
 
 func TestFlowService_GetNFTCollections(t *testing.T) {
@@ -148,6 +153,62 @@ func TestFlowService_GetNFTTransfers(t *testing.T) {
 	}
 }
 
+func TestFlowService_GetNFTTransfersFilters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		for _, param := range []string{"sender", "receiver", "from", "to"} {
+			if query.Has(param) {
+				t.Errorf("Expected %s to be filtered on the client, got %s", param, query.Get(param))
+			}
+		}
+		if got := query.Get("nft_type"); got != "A.0b2a3299cc857e29.TopShot.NFT" {
+			t.Errorf("Expected nft_type A.0b2a3299cc857e29.TopShot.NFT, got %s", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[
+			{"nft_id":42,"sender":"0x1654653399040a61","receiver":"0xf233dcee88fe0abe","timestamp":"2024-03-02T00:00:00Z"},
+			{"nft_id":43,"sender":"0xf233dcee88fe0abe","receiver":"0x1654653399040a61","timestamp":"2024-03-02T00:00:00Z"},
+			{"nft_id":44,"sender":"0x1654653399040a61","receiver":"0xf233dcee88fe0abe","timestamp":"2024-04-01T00:00:00Z"}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := &mockClient{server: server}
+	service := NewService(client)
+
+	ctx := context.Background()
+	week := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	builder := service.GetNFTTransfers().
+		NFTType("A.0b2a3299cc857e29.TopShot.NFT").
+		Sender("0x1654653399040a61").
+		Receiver("0xf233dcee88fe0abe").
+		FromTime(week).
+		ToTime(week.AddDate(0, 0, 7))
+	result, err := builder.Clone().Do(ctx)
+	if err != nil {
+		t.Fatalf("GetNFTTransfers failed: %v", err)
+	}
+	if len(result.Data) != 1 || result.Data[0].NFTId != 42 {
+		t.Errorf("Expected only NFT 42 to match, got %+v", result.Data)
+	}
+
+	var streamed []int64
+	for transfer, err := range builder.Stream(ctx) {
+		if err != nil {
+			t.Fatalf("Stream failed: %v", err)
+		}
+		streamed = append(streamed, transfer.NFTId)
+	}
+	if fmt.Sprint(streamed) != "[42]" {
+		t.Errorf("Expected streamed NFT IDs [42], got %v", streamed)
+	}
+
+	if _, err := service.GetNFTTransfers().Receiver("0xnothex").Do(ctx); err == nil {
+		t.Error("Expected error for malformed receiver")
+	}
+}
+
 func TestFlowService_GetAccountNFTCollections(t *testing.T) {
 	address := "0x1654653399040a61"
 
@@ -252,6 +313,140 @@ func TestFlowService_GetAccountNFTs(t *testing.T) {
 	}
 }
 
+func TestFlowService_GetAccountNFTTransfers(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/flow/v1/nft/transfer" {
+			t.Errorf("Expected path /flow/v1/nft/transfer, got %s", r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		if got := query.Get("address"); got != "0x1654653399040a61" {
+			t.Errorf("Expected address 0x1654653399040a61, got %s", got)
+		}
+		if got := query.Get("nft_type"); got != "A.0b2a3299cc857e29.TopShot.NFT" {
+			t.Errorf("Expected nft_type A.0b2a3299cc857e29.TopShot.NFT, got %s", got)
+		}
+		for _, param := range []string{"direction", "from", "to"} {
+			if query.Has(param) {
+				t.Errorf("Expected %s to be filtered on the client, got %s", param, query.Get(param))
+			}
+		}
+
+		resp := NFTTransfersResponse{
+			Data: []NFTTransfer{
+				{NFTId: 1, Sender: "0xf233dcee88fe0abe", Receiver: "0x1654653399040a61", Timestamp: "2024-02-01T00:00:00Z"},
+				{NFTId: 2, Sender: "0x1654653399040a61", Receiver: "0xf233dcee88fe0abe", Timestamp: "2024-02-01T00:00:00Z"},
+				{NFTId: 3, Sender: "0xf233dcee88fe0abe", Receiver: "0x1654653399040a61", Timestamp: "2023-12-01T00:00:00Z"},
+			},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := &mockClient{server: server}
+	service := NewService(client)
+
+	ctx := context.Background()
+	result, err := service.GetAccountNFTTransfers().
+		Address("0x1654653399040a61").
+		Direction("in").
+		NFTType("A.0b2a3299cc857e29.TopShot.NFT").
+		Apply(filter.Between(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Time{})).
+		Do(ctx)
+	if err != nil {
+		t.Fatalf("GetAccountNFTTransfers failed: %v", err)
+	}
+
+	if len(result.Data) != 1 || result.Data[0].NFTId != 1 {
+		t.Errorf("Expected only NFT 1 to match, got %+v", result.Data)
+	}
+
+	// Without client-side filters the page is requested directly
+	requests = 0
+	result, err = service.GetAccountNFTTransfers().
+		Address("0x1654653399040a61").
+		NFTType("A.0b2a3299cc857e29.TopShot.NFT").
+		Do(ctx)
+	if err != nil {
+		t.Fatalf("GetAccountNFTTransfers failed: %v", err)
+	}
+	if requests != 1 || len(result.Data) != 3 {
+		t.Errorf("Expected 3 transfers from 1 request, got %d from %d", len(result.Data), requests)
+	}
+
+	if err := service.GetAccountNFTTransfers().Address("0x1654653399040a61").Direction("sideways").Validate(); err == nil {
+		t.Error("Expected error for invalid direction")
+	}
+	if err := service.GetAccountNFTTransfers().Address("0x1654653399040a61").From("yesterday").Validate(); err == nil {
+		t.Error("Expected error for invalid from time")
+	}
+}
+
+func TestFlowService_GetAccountNFT(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		expectedPath := "/flow/v1/nft/A.0b2a3299cc857e29.TopShot.NFT/item/42"
+		if r.URL.Path != expectedPath {
+			t.Errorf("Expected path %s, got %s", expectedPath, r.URL.Path)
+		}
+
+		resp := NFTDetailsResponse{
+			Data: []NFT{
+				{
+					NFTId:    42,
+					NFTType:  "A.0b2a3299cc857e29.TopShot.NFT",
+					Owner:    "0x1654653399040a61",
+					Metadata: map[string]interface{}{"name": "LeBron James Dunk"},
+				},
+			},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := &mockClient{server: server}
+	service := NewService(client)
+
+	ctx := context.Background()
+	result, err := service.GetAccountNFT().
+		Address("0x1654653399040a61").
+		NFTType("A.0b2a3299cc857e29.TopShot.NFT").
+		ID("42").
+		Do(ctx)
+	if err != nil {
+		t.Fatalf("GetAccountNFT failed: %v", err)
+	}
+
+	if len(result.Data) != 1 {
+		t.Fatalf("Expected 1 NFT, got %d", len(result.Data))
+	}
+	nft := result.Data[0]
+	if nft.NFTId != 42 {
+		t.Errorf("Expected NFT 42, got %+v", nft)
+	}
+	if nft.Metadata["name"] != "LeBron James Dunk" {
+		t.Errorf("Expected metadata name LeBron James Dunk, got %v", nft.Metadata["name"])
+	}
+
+	// An NFT owned by another account is not returned
+	result, err = service.GetAccountNFT().
+		Address("0xf233dcee88fe0abe").
+		NFTType("A.0b2a3299cc857e29.TopShot.NFT").
+		ID("42").
+		Do(ctx)
+	if err != nil {
+		t.Fatalf("GetAccountNFT failed: %v", err)
+	}
+	if !result.Empty() {
+		t.Errorf("Expected no NFT for another owner, got %+v", result.Data)
+	}
+}
+
 func TestFlowService_NFTRequiredFields(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
 	defer server.Close()
@@ -272,6 +467,18 @@ func TestFlowService_NFTRequiredFields(t *testing.T) {
 		t.Error("Expected error when address is not provided")
 	}
 
+	// Test GetAccountNFTTransfers without address
+	_, err = service.GetAccountNFTTransfers().Do(ctx)
+	if err == nil {
+		t.Error("Expected error when address is not provided")
+	}
+
+	// Test GetAccountNFT without ID
+	_, err = service.GetAccountNFT().Address("0x1654653399040a61").NFTType("A.0b2a3299cc857e29.TopShot.NFT").Do(ctx)
+	if err == nil {
+		t.Error("Expected error when ID is not provided")
+	}
+
 	// Test GetAccountNFTs without address
 	_, err = service.GetAccountNFTs().NFTType("A.0b2a3299cc857e29.TopShot.NFT").Do(ctx)
 	if err == nil {
@@ -284,3 +491,111 @@ func TestFlowService_NFTRequiredFields(t *testing.T) {
 		t.Error("Expected error when nft_type is not provided")
 	}
 }
+
+func TestFlowService_GetNFTItems(t *testing.T) {
+	nftType := "A.0b2a3299cc857e29.TopShot.NFT"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/nft/v0/"+nftType+"/item" {
+			t.Errorf("Expected items path, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("name"); got != "lebron" {
+			t.Errorf("Expected name lebron, got %s", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data":[{"nft_id":7,"name":"LeBron James","serial":12}]}`)
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	resp, err := service.GetNFTItems().NFTType(nftType).Name("lebron").Do(context.Background())
+	if err != nil {
+		t.Fatalf("GetNFTItems failed: %v", err)
+	}
+	if len(resp.Data) != 1 || resp.Data[0].NFTId != 7 || resp.Data[0].Serial == nil || *resp.Data[0].Serial != 12 {
+		t.Errorf("Unexpected items: %+v", resp.Data)
+	}
+
+	if _, err := service.GetNFTItems().Do(context.Background()); err == nil {
+		t.Error("Expected error for missing NFT type")
+	}
+}
+
+func TestFlowService_NFTTraitFilters(t *testing.T) {
+	traits := map[string]string{
+		"7": `[{"name":"Background","value":"Gold"},{"name":"Eyes","value":"Laser"}]`,
+		"8": `[{"name":"Background","value":"Gold"},{"name":"Eyes","value":"Sleepy"}]`,
+		"9": `[{"name":"Background","value":"Blue"}]`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("trait") {
+			t.Errorf("Expected traits to be filtered on the client, got %v", r.URL.Query()["trait"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/flow/v1/nft/A.0b2a3299cc857e29.TopShot.NFT/item/"):
+			id := path.Base(r.URL.Path)
+			fmt.Fprintf(w, `{"data":[{"nft_id":%s,"metadata":{"traits":{"traits":%s}}}]}`, id, traits[id])
+		case r.URL.Path == "/flow/v1/account/0x1654653399040a61/nft/A.0b2a3299cc857e29.TopShot.NFT":
+			// Listed metadata is used when present; NFT 8 is looked up
+			w.Write([]byte(`{"data":[
+				{"nft_id":7,"metadata":{"traits":{"traits":[{"name":"background","value":"gold"},{"name":"Eyes","value":"LASER"}]}}},
+				{"nft_id":8},
+				{"nft_id":9,"metadata":{"traits":{"traits":[{"name":"Background","value":"Blue"}]}}}
+			]}`))
+		default:
+			w.Write([]byte(`{"data":[{"nft_id":7},{"nft_id":8},{"nft_id":9}]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := &mockClient{server: server}
+	service := NewService(client)
+	ctx := context.Background()
+
+	items := service.GetNFTItems().NFTType("A.0b2a3299cc857e29.TopShot.NFT").Trait("Background", "Gold")
+	result, err := items.Clone().Do(ctx)
+	if err != nil {
+		t.Fatalf("GetNFTItems failed: %v", err)
+	}
+	if len(result.Data) != 2 || result.Data[0].NFTId != 7 || result.Data[1].NFTId != 8 {
+		t.Errorf("Expected NFTs 7 and 8 with a gold background, got %+v", result.Data)
+	}
+
+	result, err = items.Clone().Trait("Eyes", "Laser").Do(ctx)
+	if err != nil {
+		t.Fatalf("GetNFTItems failed: %v", err)
+	}
+	if len(result.Data) != 1 || result.Data[0].NFTId != 7 {
+		t.Errorf("Expected only NFT 7, got %+v", result.Data)
+	}
+
+	var streamed []int64
+	for item, err := range items.Clone().Trait("Eyes", "Sleepy").Stream(ctx) {
+		if err != nil {
+			t.Fatalf("Stream failed: %v", err)
+		}
+		streamed = append(streamed, item.NFTId)
+	}
+	if fmt.Sprint(streamed) != "[8]" {
+		t.Errorf("Expected streamed NFT IDs [8], got %v", streamed)
+	}
+
+	owned, err := service.GetAccountNFTs().
+		Address("0x1654653399040a61").
+		NFTType("A.0b2a3299cc857e29.TopShot.NFT").
+		Trait("Background", "Gold").
+		Trait("Eyes", "Laser").
+		Do(ctx)
+	if err != nil {
+		t.Fatalf("GetAccountNFTs failed: %v", err)
+	}
+	if len(owned.Data) != 1 || owned.Data[0].NFTId != 7 {
+		t.Errorf("Expected only owned NFT 7, got %+v", owned.Data)
+	}
+
+	if err := items.Trait("", "Gold").Validate(); err == nil {
+		t.Error("Expected error for empty trait name")
+	}
+}
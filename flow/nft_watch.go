@@ -0,0 +1,120 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// NFTHolderChange describes how a single owner's holding changed between snapshots
+type NFTHolderChange struct {
+	Owner         string `json:"owner"`
+	PreviousCount int    `json:"previous_count"`
+	Count         int    `json:"count"`
+}
+
+// NFTHoldingsDiff is emitted by WatchNFTHoldings whenever the holders of a collection change
+type NFTHoldingsDiff struct {
+	NFTType    string            `json:"nft_type"`
+	Time       time.Time         `json:"time"`
+	NewHolders []NFTHolderChange `json:"new_holders,omitempty"`
+	Exits      []NFTHolderChange `json:"exits,omitempty"`
+	Changed    []NFTHolderChange `json:"changed,omitempty"`
+
+	// Err is set when a snapshot could not be taken; the watcher keeps polling
+	Err error `json:"-"`
+}
+
+// IsEmpty reports whether the diff contains no holder changes
+func (d NFTHoldingsDiff) IsEmpty() bool {
+	return len(d.NewHolders) == 0 && len(d.Exits) == 0 && len(d.Changed) == 0
+}
+
+// WatchNFTHoldings polls the holdings of a collection every interval and emits
+// a diff whenever holders join, exit, or change their count. The first snapshot
+// is used as the baseline and is not emitted. Snapshot failures are emitted as
+// diffs with Err set. The returned channel is closed when ctx is done.
+func (s *Service) WatchNFTHoldings(ctx context.Context, nftType string, interval time.Duration) (<-chan NFTHoldingsDiff, error) {
+	if nftType == "" {
+		return nil, fmt.Errorf("NFT type is required")
+	}
+	if interval <= 0 {
+		return nil, fmt.Errorf("interval must be positive")
+	}
+
+	out := make(chan NFTHoldingsDiff)
+	go func() {
+		defer close(out)
+
+		var previous map[string]int
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			current, err := s.snapshotNFTHoldings(ctx, nftType)
+			switch {
+			case err != nil:
+				if ctx.Err() != nil {
+					return
+				}
+				if !sendDiff(ctx, out, NFTHoldingsDiff{NFTType: nftType, Time: time.Now(), Err: err}) {
+					return
+				}
+			case previous == nil:
+				previous = current
+			default:
+				diff := diffNFTHoldings(previous, current)
+				diff.NFTType = nftType
+				diff.Time = time.Now()
+				previous = current
+				if !diff.IsEmpty() && !sendDiff(ctx, out, diff) {
+					return
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// sendDiff delivers a diff unless the context is cancelled first
+func sendDiff(ctx context.Context, out chan<- NFTHoldingsDiff, diff NFTHoldingsDiff) bool {
+	select {
+	case out <- diff:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// diffNFTHoldings compares two owner to count snapshots
+func diffNFTHoldings(previous, current map[string]int) NFTHoldingsDiff {
+	var diff NFTHoldingsDiff
+	for owner, count := range current {
+		prev, ok := previous[owner]
+		switch {
+		case !ok:
+			diff.NewHolders = append(diff.NewHolders, NFTHolderChange{Owner: owner, Count: count})
+		case prev != count:
+			diff.Changed = append(diff.Changed, NFTHolderChange{Owner: owner, PreviousCount: prev, Count: count})
+		}
+	}
+	for owner, prev := range previous {
+		if _, ok := current[owner]; !ok {
+			diff.Exits = append(diff.Exits, NFTHolderChange{Owner: owner, PreviousCount: prev})
+		}
+	}
+
+	// Sort for deterministic output
+	for _, changes := range [][]NFTHolderChange{diff.NewHolders, diff.Exits, diff.Changed} {
+		sort.Slice(changes, func(i, j int) bool { return changes[i].Owner < changes[j].Owner })
+	}
+	return diff
+}
@@ -0,0 +1,85 @@
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDiffNFTHoldings(t *testing.T) {
+	previous := map[string]int{"0x1": 1, "0x2": 5, "0x3": 2}
+	current := map[string]int{"0x2": 3, "0x3": 2, "0x4": 7}
+
+	diff := diffNFTHoldings(previous, current)
+
+	if len(diff.NewHolders) != 1 || diff.NewHolders[0].Owner != "0x4" || diff.NewHolders[0].Count != 7 {
+		t.Errorf("Unexpected new holders: %+v", diff.NewHolders)
+	}
+	if len(diff.Exits) != 1 || diff.Exits[0].Owner != "0x1" || diff.Exits[0].PreviousCount != 1 {
+		t.Errorf("Unexpected exits: %+v", diff.Exits)
+	}
+	if len(diff.Changed) != 1 || diff.Changed[0].Owner != "0x2" || diff.Changed[0].PreviousCount != 5 || diff.Changed[0].Count != 3 {
+		t.Errorf("Unexpected changes: %+v", diff.Changed)
+	}
+}
+
+func TestFlowService_WatchNFTHoldings(t *testing.T) {
+	var polls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/flow/v1/nft/A.1.TopShot/holding" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+
+		holdings := []NFTHolding{{Owner: "0x1", Count: 1}}
+		if polls.Add(1) > 1 {
+			holdings = []NFTHolding{{Owner: "0x1", Count: 2}, {Owner: "0x2", Count: 1}}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(NFTHoldingResponse{Data: holdings})
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	diffs, err := service.WatchNFTHoldings(ctx, "A.1.TopShot", 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("WatchNFTHoldings failed: %v", err)
+	}
+
+	select {
+	case diff := <-diffs:
+		if diff.Err != nil {
+			t.Fatalf("Unexpected error: %v", diff.Err)
+		}
+		if len(diff.NewHolders) != 1 || diff.NewHolders[0].Owner != "0x2" {
+			t.Errorf("Unexpected new holders: %+v", diff.NewHolders)
+		}
+		if len(diff.Changed) != 1 || diff.Changed[0].Count != 2 {
+			t.Errorf("Unexpected changes: %+v", diff.Changed)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for diff")
+	}
+
+	cancel()
+	for range diffs {
+	}
+}
+
+func TestFlowService_WatchNFTHoldingsValidation(t *testing.T) {
+	service := NewService(&mockClient{})
+	if _, err := service.WatchNFTHoldings(context.Background(), "", time.Second); err == nil {
+		t.Error("Expected error for missing NFT type")
+	}
+	if _, err := service.WatchNFTHoldings(context.Background(), "A.1.TopShot", 0); err == nil {
+		t.Error("Expected error for zero interval")
+	}
+}
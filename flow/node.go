@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
+	"strings"
 )
 
 // Node represents a Flow node
@@ -32,12 +34,7 @@ type Node struct {
 }
 
 // NodeResponse represents the response from the nodes endpoint
-type NodeResponse struct {
-	Data  []Node                 `json:"data"`
-	Links map[string]string      `json:"_links"`
-	Meta  map[string]interface{} `json:"_meta"`
-	Error interface{}            `json:"error,omitempty"`
-}
+type NodeResponse = Response[Node]
 
 // DelegationReward represents a delegation reward
 type DelegationReward struct {
@@ -50,13 +47,20 @@ type DelegationReward struct {
 }
 
 // DelegationRewardResponse represents the response from the delegation rewards endpoint
-type DelegationRewardResponse struct {
-	Data  []DelegationReward     `json:"data"`
-	Links map[string]string      `json:"_links"`
-	Meta  map[string]interface{} `json:"_meta"`
-	Error interface{}            `json:"error,omitempty"`
+type DelegationRewardResponse = Response[DelegationReward]
+
+// Delegator represents a delegator registered with a node
+type Delegator struct {
+	Address       string `json:"address"`
+	BlockHeight   uint64 `json:"block_height"`
+	DelegatorID   string `json:"delegatorid"`
+	NodeID        string `json:"nodeid"`
+	TransactionID string `json:"transaction_id"`
 }
 
+// DelegatorResponse represents the response from the delegators endpoint
+type DelegatorResponse = Response[Delegator]
+
 // NodesRequestBuilder builds a request to get nodes
 type NodesRequestBuilder struct {
 	service      *Service
@@ -73,6 +77,12 @@ func (s *Service) GetNodes() *NodesRequestBuilder {
 	return &NodesRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the nodes request builder
+func (b *NodesRequestBuilder) Clone() *NodesRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Height sets the block height filter (optional)
 func (b *NodesRequestBuilder) Height(height uint64) *NodesRequestBuilder {
 	b.height = &height
@@ -111,8 +121,17 @@ func (b *NodesRequestBuilder) SortBy(sortBy string) *NodesRequestBuilder {
 	return b
 }
 
+// Validate checks the nodes request without making a network call
+func (b *NodesRequestBuilder) Validate() error {
+	return validatePage(b.limit, b.offset)
+}
+
 // Do executes the nodes request
 func (b *NodesRequestBuilder) Do(ctx context.Context) (*NodeResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
 	query := url.Values{}
 	if b.height != nil {
 		query.Set("height", strconv.FormatUint(*b.height, 10))
@@ -142,6 +161,7 @@ func (b *NodesRequestBuilder) Do(ctx context.Context) (*NodeResponse, error) {
 	if err := b.service.client.DecodeResponse(resp, &nodeResp); err != nil {
 		return nil, err
 	}
+	nodeResp.Data = emptyIfNil(nodeResp.Data)
 
 	return &nodeResp, nil
 }
@@ -157,16 +177,30 @@ func (s *Service) GetNode() *NodeRequestBuilder {
 	return &NodeRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the node request builder
+func (b *NodeRequestBuilder) Clone() *NodeRequestBuilder {
+	c := *b
+	return &c
+}
+
 // NodeID sets the node ID (required)
 func (b *NodeRequestBuilder) NodeID(nodeID string) *NodeRequestBuilder {
 	b.nodeID = nodeID
 	return b
 }
 
+// Validate checks the node request without making a network call
+func (b *NodeRequestBuilder) Validate() error {
+	if b.nodeID == "" {
+		return fmt.Errorf("node ID is required")
+	}
+	return nil
+}
+
 // Do executes the node request
 func (b *NodeRequestBuilder) Do(ctx context.Context) (*NodeResponse, error) {
-	if b.nodeID == "" {
-		return nil, fmt.Errorf("node ID is required")
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	path := fmt.Sprintf("/flow/v1/node/%s", b.nodeID)
@@ -179,6 +213,7 @@ func (b *NodeRequestBuilder) Do(ctx context.Context) (*NodeResponse, error) {
 	if err := b.service.client.DecodeResponse(resp, &nodeResp); err != nil {
 		return nil, err
 	}
+	nodeResp.Data = emptyIfNil(nodeResp.Data)
 
 	return &nodeResp, nil
 }
@@ -198,6 +233,12 @@ func (s *Service) GetNodeDelegationRewards() *NodeDelegationRewardsRequestBuilde
 	return &NodeDelegationRewardsRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the delegation rewards request builder
+func (b *NodeDelegationRewardsRequestBuilder) Clone() *NodeDelegationRewardsRequestBuilder {
+	c := *b
+	return &c
+}
+
 // NodeID sets the node ID (required)
 func (b *NodeDelegationRewardsRequestBuilder) NodeID(nodeID string) *NodeDelegationRewardsRequestBuilder {
 	b.nodeID = nodeID
@@ -229,10 +270,23 @@ func (b *NodeDelegationRewardsRequestBuilder) SortBy(sortBy string) *NodeDelegat
 	return b
 }
 
+// Validate checks the delegation rewards request without making a network call
+func (b *NodeDelegationRewardsRequestBuilder) Validate() error {
+	if b.nodeID == "" {
+		return fmt.Errorf("node ID is required")
+	}
+	if b.address != nil {
+		if err := validateAddress(*b.address); err != nil {
+			return err
+		}
+	}
+	return validatePage(b.limit, b.offset)
+}
+
 // Do executes the delegation rewards request
 func (b *NodeDelegationRewardsRequestBuilder) Do(ctx context.Context) (*DelegationRewardResponse, error) {
-	if b.nodeID == "" {
-		return nil, fmt.Errorf("node ID is required")
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	query := url.Values{}
@@ -259,6 +313,94 @@ func (b *NodeDelegationRewardsRequestBuilder) Do(ctx context.Context) (*Delegati
 	if err := b.service.client.DecodeResponse(resp, &rewardResp); err != nil {
 		return nil, err
 	}
+	rewardResp.Data = emptyIfNil(rewardResp.Data)
 
 	return &rewardResp, nil
 }
+
+// NodeDelegatorsRequestBuilder builds a request to get the delegators of a node
+type NodeDelegatorsRequestBuilder struct {
+	service      *Service
+	nodeID       string
+	delegatorIDs []string
+	limit        *int
+	offset       *int
+}
+
+// GetNodeDelegators creates a new node delegators request builder. Each
+// delegator is returned with the address and transaction that registered it;
+// staked amounts are not exposed by the endpoint.
+func (s *Service) GetNodeDelegators() *NodeDelegatorsRequestBuilder {
+	return &NodeDelegatorsRequestBuilder{service: s}
+}
+
+// Clone returns an independent copy of the node delegators request builder
+func (b *NodeDelegatorsRequestBuilder) Clone() *NodeDelegatorsRequestBuilder {
+	c := *b
+	c.delegatorIDs = slices.Clone(b.delegatorIDs)
+	return &c
+}
+
+// NodeID sets the node ID (required)
+func (b *NodeDelegatorsRequestBuilder) NodeID(nodeID string) *NodeDelegatorsRequestBuilder {
+	b.nodeID = nodeID
+	return b
+}
+
+// DelegatorIDs filters by delegator IDs (optional)
+func (b *NodeDelegatorsRequestBuilder) DelegatorIDs(ids ...string) *NodeDelegatorsRequestBuilder {
+	b.delegatorIDs = append(b.delegatorIDs, ids...)
+	return b
+}
+
+// Limit sets the number of records to return (optional, default 25, max 100)
+func (b *NodeDelegatorsRequestBuilder) Limit(limit int) *NodeDelegatorsRequestBuilder {
+	b.limit = &limit
+	return b
+}
+
+// Offset sets the pagination offset (optional)
+func (b *NodeDelegatorsRequestBuilder) Offset(offset int) *NodeDelegatorsRequestBuilder {
+	b.offset = &offset
+	return b
+}
+
+// Validate checks the node delegators request without making a network call
+func (b *NodeDelegatorsRequestBuilder) Validate() error {
+	if b.nodeID == "" {
+		return fmt.Errorf("node ID is required")
+	}
+	return validatePage(b.limit, b.offset)
+}
+
+// Do executes the node delegators request
+func (b *NodeDelegatorsRequestBuilder) Do(ctx context.Context) (*DelegatorResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("node_id", b.nodeID)
+	if len(b.delegatorIDs) > 0 {
+		query.Set("delegator_ids", strings.Join(b.delegatorIDs, ","))
+	}
+	if b.limit != nil {
+		query.Set("limit", strconv.Itoa(*b.limit))
+	}
+	if b.offset != nil {
+		query.Set("offset", strconv.Itoa(*b.offset))
+	}
+
+	resp, err := b.service.client.DoRequest(ctx, http.MethodGet, "/staking/v1/delegator", query)
+	if err != nil {
+		return nil, err
+	}
+
+	var delegatorResp DelegatorResponse
+	if err := b.service.client.DecodeResponse(resp, &delegatorResp); err != nil {
+		return nil, err
+	}
+	delegatorResp.Data = emptyIfNil(delegatorResp.Data)
+
+	return &delegatorResp, nil
+}
@@ -225,6 +225,52 @@ func TestFlowService_GetNodeDelegationRewards(t *testing.T) {
 	}
 }
 
+func TestFlowService_GetNodeDelegators(t *testing.T) {
+	nodeID := "abc123"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/staking/v1/delegator" {
+			t.Errorf("Expected path /staking/v1/delegator, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("node_id"); got != nodeID {
+			t.Errorf("Expected node_id %s, got %s", nodeID, got)
+		}
+		if got := r.URL.Query().Get("delegator_ids"); got != "1,2" {
+			t.Errorf("Expected delegator_ids 1,2, got %s", got)
+		}
+
+		resp := DelegatorResponse{
+			Data: []Delegator{
+				{Address: "0x1234", DelegatorID: "1", NodeID: nodeID, BlockHeight: 96708412},
+				{Address: "0x5678", DelegatorID: "2", NodeID: nodeID},
+			},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := &mockClient{server: server}
+	service := NewService(client)
+
+	ctx := context.Background()
+	result, err := service.GetNodeDelegators().
+		NodeID(nodeID).
+		DelegatorIDs("1", "2").
+		Limit(10).
+		Do(ctx)
+	if err != nil {
+		t.Fatalf("GetNodeDelegators failed: %v", err)
+	}
+
+	if len(result.Data) != 2 {
+		t.Fatalf("Expected 2 delegators, got %d", len(result.Data))
+	}
+	if result.Data[0].Address != "0x1234" || result.Data[0].DelegatorID != "1" {
+		t.Errorf("Unexpected delegator %+v", result.Data[0])
+	}
+}
+
 func TestFlowService_NodeRequiredFields(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
 	defer server.Close()
@@ -244,4 +290,10 @@ func TestFlowService_NodeRequiredFields(t *testing.T) {
 	if err == nil {
 		t.Error("Expected error when node ID is not provided")
 	}
+
+	// Test GetNodeDelegators without node ID
+	_, err = service.GetNodeDelegators().Do(ctx)
+	if err == nil {
+		t.Error("Expected error when node ID is not provided")
+	}
 }
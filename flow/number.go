@@ -0,0 +1,78 @@
+package flow
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+)
+
+// Number is a numeric value the API encodes as a JSON number in some
+// responses and as a string in others. It keeps the text as sent, so large
+// EVM quantities lose no precision, and marshals as a string.
+type Number string
+
+// UnmarshalJSON accepts a JSON number, a string or null
+func (n *Number) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		*n = ""
+		return nil
+	}
+	if len(b) > 0 && b[0] == '"' {
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		*n = Number(s)
+		return nil
+	}
+	var num json.Number
+	if err := json.Unmarshal(b, &num); err != nil {
+		return fmt.Errorf("invalid number %s", b)
+	}
+	*n = Number(num)
+	return nil
+}
+
+// String returns the number as sent by the API
+func (n Number) String() string {
+	return string(n)
+}
+
+// Float64 returns the number as a float64. An empty number is zero.
+func (n Number) Float64() (float64, error) {
+	if n == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(string(n), 64)
+}
+
+// Int returns the number as an integer, accepting decimal and 0x-prefixed
+// hex encodings. An empty number is zero.
+func (n Number) Int() (*big.Int, error) {
+	return ParseEvmQuantity(string(n))
+}
+
+// Float is a float64 the API encodes as a JSON number in some responses and
+// as a string in others
+type Float float64
+
+// UnmarshalJSON accepts a JSON number, a numeric string, an empty string or null
+func (f *Float) UnmarshalJSON(b []byte) error {
+	var n Number
+	if err := n.UnmarshalJSON(b); err != nil {
+		return err
+	}
+	v, err := n.Float64()
+	if err != nil {
+		return fmt.Errorf("invalid number %s", b)
+	}
+	*f = Float(v)
+	return nil
+}
+
+// Float64 returns the value as a float64
+func (f Float) Float64() float64 {
+	return float64(f)
+}
@@ -0,0 +1,56 @@
+package flow
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNumber_UnmarshalJSON(t *testing.T) {
+	var tx EvmTransaction
+	body := `{"gas_limit":21000,"gas_price":"0x3b9aca00","value":"1000000000000000000000000","gas_used":null}`
+	if err := json.Unmarshal([]byte(body), &tx); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	if gas, err := tx.GasLimit.Int(); err != nil || gas.Int64() != 21000 {
+		t.Errorf("Expected gas limit 21000 from a JSON number, got %v (%v)", gas, err)
+	}
+	if price, err := tx.GasPrice.Int(); err != nil || price.Int64() != 1000000000 {
+		t.Errorf("Expected gas price 1000000000 from hex, got %v (%v)", price, err)
+	}
+	if value, err := tx.Value.Int(); err != nil || value.String() != "1000000000000000000000000" {
+		t.Errorf("Expected value to keep its precision, got %v (%v)", value, err)
+	}
+	if tx.GasUsed != "" {
+		t.Errorf("Expected null gas used to be empty, got %q", tx.GasUsed)
+	}
+
+	var c AccountFTCollection
+	if err := json.Unmarshal([]byte(`{"balance":100.5}`), &c); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if f, err := c.Balance.Float64(); err != nil || f != 100.5 {
+		t.Errorf("Expected balance 100.5, got %v (%v)", f, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"balance":true}`), &c); err == nil {
+		t.Error("Expected error for a non-numeric balance")
+	}
+}
+
+func TestFloat_UnmarshalJSON(t *testing.T) {
+	for _, body := range []string{`{"balance":500}`, `{"balance":"500"}`, `{"balance":"500.0"}`} {
+		var v Vault
+		if err := json.Unmarshal([]byte(body), &v); err != nil {
+			t.Fatalf("Unmarshal %s failed: %v", body, err)
+		}
+		if v.Balance != 500 {
+			t.Errorf("Expected balance 500 from %s, got %v", body, v.Balance)
+		}
+	}
+
+	var v Vault
+	if err := json.Unmarshal([]byte(`{"balance":"abc"}`), &v); err == nil {
+		t.Error("Expected error for a non-numeric balance string")
+	}
+}
@@ -0,0 +1,106 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Portfolio is a composite view of an account's details and holdings
+type Portfolio struct {
+	Address        string                 `json:"address"`
+	Account        CombinedAccountDetails `json:"account"`
+	FTCollections  []AccountFTCollection  `json:"ft_collections"`
+	FTHoldings     []FTHolding            `json:"ft_holdings"`
+	NFTCollections []AccountNFTCollection `json:"nft_collections"`
+	// NFTCount is the total number of NFTs across all collections
+	NFTCount int `json:"nft_count"`
+}
+
+// GetPortfolio concurrently fetches an account's details, FT collections, FT
+// holdings and NFT collection summaries (all pages) and returns them as one
+// Portfolio. If any call fails the others are cancelled and the first error
+// is returned.
+func (s *Service) GetPortfolio(ctx context.Context, address string) (*Portfolio, error) {
+	if address == "" {
+		return nil, fmt.Errorf("account address is required")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	p := &Portfolio{Address: address}
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	run := func(name string, fn func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fn(); err != nil {
+				errOnce.Do(func() {
+					firstErr = fmt.Errorf("fetch %s: %w", name, err)
+					cancel()
+				})
+			}
+		}()
+	}
+
+	run("account", func() error {
+		resp, err := s.GetAccount().Address(address).Do(ctx)
+		if err != nil {
+			return err
+		}
+		if len(resp.Data) == 0 {
+			return fmt.Errorf("account %s not found", address)
+		}
+		p.Account = resp.Data[0]
+		return nil
+	})
+
+	run("FT collections", func() (err error) {
+		p.FTCollections, err = collectPages(func(offset int) ([]AccountFTCollection, error) {
+			resp, err := s.GetAccountFTs().Address(address).Limit(maxPageSize).Offset(offset).Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Data, nil
+		})
+		return err
+	})
+
+	run("FT holdings", func() (err error) {
+		p.FTHoldings, err = collectPages(func(offset int) ([]FTHolding, error) {
+			resp, err := s.GetAccountFTHoldings().Address(address).Limit(maxPageSize).Offset(offset).Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Data, nil
+		})
+		return err
+	})
+
+	run("NFT collections", func() (err error) {
+		p.NFTCollections, err = collectPages(func(offset int) ([]AccountNFTCollection, error) {
+			resp, err := s.GetAccountNFTCollections().Address(address).Limit(maxPageSize).Offset(offset).Do(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Data, nil
+		})
+		return err
+	})
+
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	for _, c := range p.NFTCollections {
+		p.NFTCount += c.NFTCount
+	}
+	return p, nil
+}
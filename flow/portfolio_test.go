@@ -0,0 +1,77 @@
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFlowService_GetPortfolio(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var resp any
+		switch r.URL.Path {
+		case "/flow/v1/account/0x01":
+			resp = AccountDetailsResponse{Data: []CombinedAccountDetails{{Address: "0x01", FlowBalance: 12.5}}}
+		case "/flow/v1/account/0x01/ft":
+			resp = AccountFTCollectionsResponse{Data: []AccountFTCollection{{Token: "A.1654653399040a61.FlowToken", Balance: "12.5"}}}
+		case "/flow/v1/account/0x01/ft/holding":
+			resp = FTHoldingResponse{Data: []FTHolding{{Token: "A.1654653399040a61.FlowToken", Balance: 12.5}}}
+		case "/flow/v1/account/0x01/nft":
+			resp = AccountNFTCollectionsResponse{Data: []AccountNFTCollection{
+				{NFTType: "A.1.TopShot", NFTCount: 3},
+				{NFTType: "A.2.Flovatar", NFTCount: 2},
+			}}
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	p, err := service.GetPortfolio(context.Background(), "0x01")
+	if err != nil {
+		t.Fatalf("GetPortfolio failed: %v", err)
+	}
+
+	if p.Account.FlowBalance != 12.5 {
+		t.Errorf("Expected flow balance 12.5, got %f", p.Account.FlowBalance)
+	}
+	if len(p.FTCollections) != 1 {
+		t.Errorf("Expected 1 FT collection, got %d", len(p.FTCollections))
+	}
+	if len(p.FTHoldings) != 1 {
+		t.Errorf("Expected 1 FT holding, got %d", len(p.FTHoldings))
+	}
+	if len(p.NFTCollections) != 2 {
+		t.Errorf("Expected 2 NFT collections, got %d", len(p.NFTCollections))
+	}
+	if p.NFTCount != 5 {
+		t.Errorf("Expected 5 NFTs, got %d", p.NFTCount)
+	}
+}
+
+func TestFlowService_GetPortfolioError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/flow/v1/account/0x01/nft" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[{}]}`))
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	if _, err := service.GetPortfolio(context.Background(), "0x01"); err == nil {
+		t.Error("Expected error when a sub-request fails")
+	}
+	if _, err := service.GetPortfolio(context.Background(), ""); err == nil {
+		t.Error("Expected error when address is not provided")
+	}
+}
@@ -0,0 +1,48 @@
+package flow
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Response is the envelope shared by the Flow API's list endpoints. Each
+// endpoint's response type, such as BlockResponse, is an alias of it.
+type Response[T any] struct {
+	Data  []T           `json:"data"`
+	Links Links         `json:"_links"`
+	Meta  Meta          `json:"_meta"`
+	Error *APIErrorBody `json:"error,omitempty"`
+}
+
+// Empty reports whether the response holds no records
+func (r *Response[T]) Empty() bool {
+	return len(r.Data) == 0
+}
+
+// NextOffset returns the offset of the next page, and false on the last page
+func (r *Response[T]) NextOffset() (int, bool) {
+	return r.Links.NextOffset()
+}
+
+// APIErrorBody is an error reported in a response body
+type APIErrorBody struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// UnmarshalJSON accepts the error as an object or a plain message string
+func (e *APIErrorBody) UnmarshalJSON(b []byte) error {
+	var msg string
+	if err := json.Unmarshal(b, &msg); err == nil {
+		*e = APIErrorBody{Message: msg}
+		return nil
+	}
+
+	type body APIErrorBody
+	var v body
+	if err := json.Unmarshal(b, &v); err != nil {
+		return fmt.Errorf("invalid error body: %w", err)
+	}
+	*e = APIErrorBody(v)
+	return nil
+}
@@ -0,0 +1,42 @@
+package flow
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestResponse_Unmarshal(t *testing.T) {
+	var resp TransfersResponse
+	body := `{"data":[{"transaction_id":"0xabc"}],"_links":{"next":"/flow/v1/ft/transfer?offset=100"},"error":"partial results"}`
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	// Endpoint response types are aliases of the generic envelope
+	var generic *Response[FTTransfer] = &resp
+	if generic.Empty() {
+		t.Error("Expected one record")
+	}
+	if offset, ok := generic.NextOffset(); !ok || offset != 100 {
+		t.Errorf("Expected next offset 100, got %d (%v)", offset, ok)
+	}
+	if resp.Error == nil || resp.Error.Message != "partial results" {
+		t.Errorf("Expected string error body, got %+v", resp.Error)
+	}
+
+	var blocks BlockResponse
+	if err := json.Unmarshal([]byte(`{"data":[],"error":{"code":503,"message":"indexer lagging"}}`), &blocks); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if blocks.Error == nil || blocks.Error.Code != 503 || blocks.Error.Message != "indexer lagging" {
+		t.Errorf("Expected object error body, got %+v", blocks.Error)
+	}
+
+	var ok BlockResponse
+	if err := json.Unmarshal([]byte(`{"data":[],"error":null}`), &ok); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if ok.Error != nil || !ok.Empty() {
+		t.Errorf("Expected empty response without error, got %+v", ok)
+	}
+}
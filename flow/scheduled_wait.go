@@ -0,0 +1,41 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// WaitForScheduledTransaction polls a scheduled transaction every pollInterval
+// until it has completed and returns it; CompletedTransaction holds the ID of
+// the transaction that executed it. A canceled or failed scheduled transaction
+// is returned together with an error. Lookup errors and ctx being done stop
+// the wait, so use a context deadline as the timeout.
+func (s *Service) WaitForScheduledTransaction(ctx context.Context, id string, pollInterval time.Duration) (*ScheduledTransaction, error) {
+	if pollInterval <= 0 {
+		return nil, fmt.Errorf("poll interval must be positive")
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		tx, err := s.GetScheduledTransaction().ID(id).Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case tx.Status == "canceled" || tx.Status == "failed":
+			return tx, fmt.Errorf("scheduled transaction %s %s", id, tx.Status)
+		case tx.IsCompleted:
+			return tx, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFlowService_WaitForScheduledTransaction(t *testing.T) {
+	var polls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tx := ScheduledTransaction{ID: "42", Status: "scheduled"}
+		switch r.URL.Query().Get("id") {
+		case "42":
+			if polls.Add(1) >= 3 {
+				tx.Status = "executed"
+				tx.IsCompleted = true
+				tx.CompletedTransaction = "abc123"
+			}
+		case "43":
+			tx = ScheduledTransaction{ID: "43", Status: "canceled"}
+		case "44":
+			tx.ID = "44"
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ScheduledTransactionsResponse{Data: []ScheduledTransaction{tx}})
+	}))
+	defer server.Close()
+
+	client := &mockClient{server: server}
+	service := NewService(client)
+
+	ctx := context.Background()
+	tx, err := service.WaitForScheduledTransaction(ctx, "42", time.Millisecond)
+	if err != nil {
+		t.Fatalf("WaitForScheduledTransaction failed: %v", err)
+	}
+	if tx.CompletedTransaction != "abc123" {
+		t.Errorf("Expected completed transaction abc123, got %s", tx.CompletedTransaction)
+	}
+	if got := polls.Load(); got != 3 {
+		t.Errorf("Expected 3 polls, got %d", got)
+	}
+
+	tx, err = service.WaitForScheduledTransaction(ctx, "43", time.Millisecond)
+	if err == nil || tx == nil || tx.Status != "canceled" {
+		t.Errorf("Expected canceled transaction with error, got %+v, %v", tx, err)
+	}
+
+	timeout, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	if _, err := service.WaitForScheduledTransaction(timeout, "44", time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded, got %v", err)
+	}
+}
@@ -0,0 +1,99 @@
+package flow
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	// scriptImportRe matches an address import with any address text,
+	// including placeholders such as 0xFUNGIBLETOKENADDRESS
+	scriptImportRe = regexp.MustCompile(`(?m)^([ \t]*)import[ \t]+([A-Za-z_]\w*(?:[ \t]*,[ \t]*[A-Za-z_]\w*)*)[ \t]+from[ \t]+([^\s;]+)`)
+	// scriptStringImportRe matches a string import, e.g. import "FungibleToken"
+	scriptStringImportRe = regexp.MustCompile(`(?m)^([ \t]*)import[ \t]+"([A-Za-z_]\w*)"`)
+	whitespaceRe         = regexp.MustCompile(`\s+`)
+)
+
+// ScriptImports returns the identifiers of the contracts imported by a Cadence
+// script, e.g. A.1654653399040a61.FlowToken, in order of appearance. String
+// imports such as import "FlowToken" are returned by name.
+func ScriptImports(script string) []string {
+	return contractImports(script)
+}
+
+// ReplaceImports rewrites the address of every import of a contract named in
+// addresses, e.g. to move a script between networks or fill in placeholders
+// like 0xFUNGIBLETOKENADDRESS. String imports are rewritten to address
+// imports. Imports of other contracts are left as they are.
+func ReplaceImports(script string, addresses map[string]string) string {
+	blanked := cadenceCommentRe.ReplaceAllStringFunc(script, blankOut)
+
+	type edit struct {
+		start, end int
+		text       string
+	}
+	var edits []edit
+
+	for _, m := range scriptImportRe.FindAllStringSubmatchIndex(blanked, -1) {
+		indent, from := script[m[2]:m[3]], script[m[6]:m[7]]
+		var lines []string
+		changed := false
+		for _, name := range strings.Split(script[m[4]:m[5]], ",") {
+			name = strings.TrimSpace(name)
+			address := from
+			if a, ok := addresses[name]; ok {
+				address, changed = withHexPrefix(a), true
+			}
+			lines = append(lines, indent+"import "+name+" from "+address)
+		}
+		if changed {
+			edits = append(edits, edit{m[0], m[1], strings.Join(lines, "\n")})
+		}
+	}
+	for _, m := range scriptStringImportRe.FindAllStringSubmatchIndex(blanked, -1) {
+		name := script[m[4]:m[5]]
+		if a, ok := addresses[name]; ok {
+			edits = append(edits, edit{m[0], m[1], script[m[2]:m[3]] + "import " + name + " from " + withHexPrefix(a)})
+		}
+	}
+
+	slices.SortFunc(edits, func(a, b edit) int { return b.start - a.start })
+	for _, e := range edits {
+		script = script[:e.start] + e.text + script[e.end:]
+	}
+	return script
+}
+
+// TemplateHash returns a hash that identifies a transaction template across
+// networks and deployments: the HashScript hash of the script with comments
+// removed, import addresses dropped and whitespace collapsed. Executions of
+// the same template on mainnet and testnet share a template hash even though
+// their script hashes differ.
+func TemplateHash(script string) string {
+	script = cadenceCommentRe.ReplaceAllString(script, " ")
+	script = scriptImportRe.ReplaceAllStringFunc(script, func(imp string) string {
+		m := scriptImportRe.FindStringSubmatch(imp)
+		var names []string
+		for _, name := range strings.Split(m[2], ",") {
+			names = append(names, "import "+strings.TrimSpace(name))
+		}
+		return strings.Join(names, "\n")
+	})
+	script = scriptStringImportRe.ReplaceAllString(script, `${1}import ${2}`)
+	script = strings.TrimSpace(whitespaceRe.ReplaceAllString(script, " "))
+	return HashScript(script)
+}
+
+// TemplateHash returns the TemplateHash of the transaction's script
+func (t *TransactionDetails) TemplateHash() string {
+	return TemplateHash(t.Script)
+}
+
+// withHexPrefix adds a 0x prefix to an address that lacks one
+func withHexPrefix(address string) string {
+	if strings.HasPrefix(address, "0x") {
+		return address
+	}
+	return "0x" + address
+}
@@ -0,0 +1,20 @@
+package flow
+
+import (
+	"crypto/sha3"
+	"encoding/hex"
+)
+
+// HashScript returns the hex SHA3-256 of a transaction script exactly as
+// submitted, for grouping executions of the same script locally. Scripts that
+// differ only in whitespace hash differently; see TemplateHash to ignore
+// formatting and import addresses.
+func HashScript(script string) string {
+	sum := sha3.Sum256([]byte(script))
+	return hex.EncodeToString(sum[:])
+}
+
+// ScriptHash returns the HashScript hash of the transaction's script
+func (t *TransactionDetails) ScriptHash() string {
+	return HashScript(t.Script)
+}
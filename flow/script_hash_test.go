@@ -0,0 +1,19 @@
+package flow
+
+import "testing"
+
+func TestHashScript(t *testing.T) {
+	// SHA3-256 of the empty string
+	if got := HashScript(""); got != "a7ffc6f8bf1ed76651c14756a061d662f580ff4de43b49fa82d80a4b80f8434a" {
+		t.Errorf("Expected SHA3-256 of the empty script, got %s", got)
+	}
+
+	script := "transaction { execute { log(\"hi\") } }"
+	tx := TransactionDetails{Script: script}
+	if tx.ScriptHash() != HashScript(script) {
+		t.Errorf("Expected transaction script hash %s, got %s", HashScript(script), tx.ScriptHash())
+	}
+	if HashScript(script+"\n") == HashScript(script) {
+		t.Error("Expected scripts differing in whitespace to hash differently")
+	}
+}
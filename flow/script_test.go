@@ -0,0 +1,75 @@
+package flow
+
+import (
+	"slices"
+	"testing"
+)
+
+const testTransferScript = `import FungibleToken from 0xf233dcee88fe0abe
+import FlowToken, Burner from 0x1654653399040a61
+// import Ignored from 0x01
+import "MetadataViews"
+
+transaction(amount: UFix64, to: Address) {
+    prepare(signer: auth(BorrowValue) &Account) {}
+}`
+
+func TestScriptImports(t *testing.T) {
+	want := []string{
+		"A.f233dcee88fe0abe.FungibleToken",
+		"A.1654653399040a61.FlowToken",
+		"A.1654653399040a61.Burner",
+		"MetadataViews",
+	}
+	if got := ScriptImports(testTransferScript); !slices.Equal(got, want) {
+		t.Errorf("Expected imports %v, got %v", want, got)
+	}
+}
+
+func TestReplaceImports(t *testing.T) {
+	got := ReplaceImports(testTransferScript, map[string]string{
+		"FungibleToken": "9a0766d93b6608b7",
+		"FlowToken":     "0x7e60df042a9c0868",
+		"MetadataViews": "0x631e88ae7f1d7c20",
+		"Ignored":       "0x02",
+	})
+
+	want := []string{
+		"A.9a0766d93b6608b7.FungibleToken",
+		"A.7e60df042a9c0868.FlowToken",
+		"A.1654653399040a61.Burner",
+		"A.631e88ae7f1d7c20.MetadataViews",
+	}
+	if imports := ScriptImports(got); !slices.Equal(imports, want) {
+		t.Errorf("Expected imports %v, got %v\n%s", want, imports, got)
+	}
+
+	placeholder := "import FungibleToken from 0xFUNGIBLETOKENADDRESS\ntransaction {}"
+	got = ReplaceImports(placeholder, map[string]string{"FungibleToken": "0xf233dcee88fe0abe"})
+	if got != "import FungibleToken from 0xf233dcee88fe0abe\ntransaction {}" {
+		t.Errorf("Expected placeholder replaced, got %q", got)
+	}
+}
+
+func TestTemplateHash(t *testing.T) {
+	testnet := ReplaceImports(testTransferScript, map[string]string{
+		"FungibleToken": "0x9a0766d93b6608b7",
+		"FlowToken":     "0x7e60df042a9c0868",
+		"Burner":        "0x7e60df042a9c0868",
+	})
+	reformatted := "// transfer\n" + testTransferScript + "\n\n"
+
+	hash := TemplateHash(testTransferScript)
+	if TemplateHash(testnet) != hash {
+		t.Error("Expected the same template hash across networks")
+	}
+	if TemplateHash(reformatted) != hash {
+		t.Error("Expected the same template hash regardless of comments and whitespace")
+	}
+	if HashScript(testnet) == HashScript(testTransferScript) {
+		t.Error("Expected script hashes to differ across networks")
+	}
+	if TemplateHash(testTransferScript+"\ntransaction {}") == hash {
+		t.Error("Expected a different template hash for a different script")
+	}
+}
@@ -0,0 +1,99 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// AccountStakingRequestBuilder builds a request to get an account's staking activity
+type AccountStakingRequestBuilder struct {
+	service *Service
+	address string
+	height  *uint64
+	limit   *int
+	offset  *int
+}
+
+// GetAccountStaking creates a new account staking request builder. It returns
+// the FT transfers of the account's staking and delegation activity (stakes,
+// unstakes, reward payouts and withdrawals), classified by the staking API.
+// The API does not expose per-node committed, staked or unstaking balances.
+func (s *Service) GetAccountStaking() *AccountStakingRequestBuilder {
+	return &AccountStakingRequestBuilder{service: s}
+}
+
+// Clone returns an independent copy of the account staking request builder
+func (b *AccountStakingRequestBuilder) Clone() *AccountStakingRequestBuilder {
+	c := *b
+	return &c
+}
+
+// Address sets the account address (required)
+func (b *AccountStakingRequestBuilder) Address(address string) *AccountStakingRequestBuilder {
+	b.address = address
+	return b
+}
+
+// Height sets the block height filter (optional)
+func (b *AccountStakingRequestBuilder) Height(height uint64) *AccountStakingRequestBuilder {
+	b.height = &height
+	return b
+}
+
+// Limit sets the number of records to return (optional, default 25, max 100)
+func (b *AccountStakingRequestBuilder) Limit(limit int) *AccountStakingRequestBuilder {
+	b.limit = &limit
+	return b
+}
+
+// Offset sets the pagination offset (optional)
+func (b *AccountStakingRequestBuilder) Offset(offset int) *AccountStakingRequestBuilder {
+	b.offset = &offset
+	return b
+}
+
+// Validate checks the account staking request without making a network call
+func (b *AccountStakingRequestBuilder) Validate() error {
+	if b.address == "" {
+		return fmt.Errorf("account address is required")
+	}
+	if err := validateAddress(b.address); err != nil {
+		return err
+	}
+	return validatePage(b.limit, b.offset)
+}
+
+// Do executes the account staking request
+func (b *AccountStakingRequestBuilder) Do(ctx context.Context) (*TransfersResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	if b.height != nil {
+		query.Set("height", strconv.FormatUint(*b.height, 10))
+	}
+	if b.limit != nil {
+		query.Set("limit", strconv.Itoa(*b.limit))
+	}
+	if b.offset != nil {
+		query.Set("offset", strconv.Itoa(*b.offset))
+	}
+
+	path := fmt.Sprintf("/staking/v1/account/%s/ft/transfer", b.address)
+	resp, err := b.service.client.DoRequest(ctx, http.MethodGet, path, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var transfersResp TransfersResponse
+	if err := b.service.client.DecodeResponse(resp, &transfersResp); err != nil {
+		return nil, err
+	}
+	transfersResp.Data = emptyIfNil(transfersResp.Data)
+
+	return &transfersResp, nil
+}
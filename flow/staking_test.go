@@ -0,0 +1,38 @@
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFlowService_GetAccountStaking(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/staking/v1/account/0x1234/ft/transfer" {
+			t.Errorf("Expected path /staking/v1/account/0x1234/ft/transfer, got %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("limit") != "10" {
+			t.Errorf("Expected limit 10, got %s", r.URL.Query().Get("limit"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(TransfersResponse{Data: []FTTransfer{
+			{Address: "0x1234", Amount: 500, Classifier: "staking", Direction: "withdraw"},
+		}})
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	resp, err := service.GetAccountStaking().Address("0x1234").Limit(10).Do(context.Background())
+	if err != nil {
+		t.Fatalf("GetAccountStaking failed: %v", err)
+	}
+	if len(resp.Data) != 1 || resp.Data[0].Amount != 500 {
+		t.Errorf("Unexpected transfers %+v", resp.Data)
+	}
+
+	if _, err := service.GetAccountStaking().Do(context.Background()); err == nil {
+		t.Error("Expected error without address")
+	}
+}
@@ -0,0 +1,201 @@
+package flow
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Streaming decodes list responses one record at a time instead of through
+// Client.DecodeResponse, which reads the whole body. Non-2xx responses and the
+// envelope's error field are still handed to DecodeResponse so they surface as
+// the client's usual errors.
+
+// strictDecoder is implemented by clients that reject unknown fields, e.g.
+// the root client created with WithStrictDecoding
+type strictDecoder interface {
+	StrictDecoding() bool
+}
+
+// streamPages iterates over the records of a list endpoint page by page,
+// starting at the query's offset and using its limit as the page size
+// (default maxPageSize), until a page comes back short. Records for which
+// keep returns false are skipped but still count towards the page. If a
+// request fails the error is yielded once and iteration stops.
+func streamPages[T any](ctx context.Context, client Client, path string, query url.Values, keep func(*T) bool) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		query = cloneQuery(query)
+		pageSize := maxPageSize
+		if limit, err := strconv.Atoi(query.Get("limit")); err == nil {
+			pageSize = limit
+		}
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		query.Set("limit", strconv.Itoa(pageSize))
+
+		for {
+			query.Set("offset", strconv.Itoa(offset))
+			resp, err := client.DoRequest(ctx, http.MethodGet, path, query)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			n := 0
+			stopped := false
+			for item, err := range streamResponse[T](client, resp) {
+				if err != nil {
+					yield(item, err)
+					return
+				}
+				n++
+				if keep != nil && !keep(&item) {
+					continue
+				}
+				if !yield(item, nil) {
+					stopped = true
+					break
+				}
+			}
+			if stopped || n < pageSize {
+				return
+			}
+			offset += n
+		}
+	}
+}
+
+// streamResponse decodes the records of one list response as they are read
+// from the body. It accepts the {"data": [...]} envelope as well as
+// newline-delimited JSON (application/x-ndjson), one record per line. The body
+// is closed when iteration ends.
+func streamResponse[T any](client Client, resp *http.Response) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			err := client.DecodeResponse(resp, nil)
+			if err == nil {
+				err = fmt.Errorf("unexpected status %d", resp.StatusCode)
+			}
+			yield(zero, err)
+			return
+		}
+		defer resp.Body.Close()
+
+		dec := json.NewDecoder(resp.Body)
+		if s, ok := client.(strictDecoder); ok && s.StrictDecoding() {
+			dec.DisallowUnknownFields()
+		}
+		if mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type")); mediaType == "application/x-ndjson" {
+			for {
+				var item T
+				if err := dec.Decode(&item); errors.Is(err, io.EOF) {
+					return
+				} else if err != nil {
+					yield(zero, fmt.Errorf("failed to decode response: %w", err))
+					return
+				}
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+
+		if err := streamEnvelope(client, resp, dec, func(item T) bool { return yield(item, nil) }); err != nil {
+			yield(zero, err)
+		}
+	}
+}
+
+// streamEnvelope walks a {"data": [...], ...} object, passing each element of
+// data to fn until fn returns false. A top-level error field is handed to the
+// client's DecodeResponse, so a non-empty one is returned as the client's
+// usual error.
+func streamEnvelope[T any](client Client, resp *http.Response, dec *json.Decoder, fn func(T) bool) error {
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+		switch tok {
+		case "data":
+			if tok, err := dec.Token(); err != nil {
+				return fmt.Errorf("failed to decode response: %w", err)
+			} else if tok == nil {
+				continue
+			} else if tok != json.Delim('[') {
+				return fmt.Errorf("failed to decode response: data is %v, not an array", tok)
+			}
+			for dec.More() {
+				var item T
+				if err := dec.Decode(&item); err != nil {
+					return fmt.Errorf("failed to decode response: %w", err)
+				}
+				if !fn(item) {
+					return nil
+				}
+			}
+			if err := expectDelim(dec, ']'); err != nil {
+				return err
+			}
+		case "error":
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return fmt.Errorf("failed to decode response: %w", err)
+			}
+			if err := envelopeError(client, resp, raw); err != nil {
+				return err
+			}
+		default:
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return fmt.Errorf("failed to decode response: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// envelopeError hands an envelope's error field to the client's DecodeResponse
+// as the body of a copy of resp
+func envelopeError(client Client, resp *http.Response, raw json.RawMessage) error {
+	body, err := json.Marshal(map[string]json.RawMessage{"error": raw})
+	if err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	errResp := *resp
+	errResp.Body = io.NopCloser(bytes.NewReader(body))
+	return client.DecodeResponse(&errResp, nil)
+}
+
+// expectDelim reads the next token and checks that it is the delimiter d
+func expectDelim(dec *json.Decoder, d json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	if tok != d {
+		return fmt.Errorf("failed to decode response: expected %v, got %v", d, tok)
+	}
+	return nil
+}
+
+// cloneQuery returns a copy of query that can be modified independently
+func cloneQuery(query url.Values) url.Values {
+	c := make(url.Values, len(query))
+	for k, v := range query {
+		c[k] = append([]string(nil), v...)
+	}
+	return c
+}
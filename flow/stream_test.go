@@ -0,0 +1,135 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestFlowService_StreamFTTransfers(t *testing.T) {
+	var offsets []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if got := query.Get("limit"); got != "2" {
+			t.Errorf("Expected limit 2, got %s", got)
+		}
+		if got := query.Get("token"); got != "A.1654653399040a61.FlowToken" {
+			t.Errorf("Expected token A.1654653399040a61.FlowToken, got %s", got)
+		}
+		offsets = append(offsets, query.Get("offset"))
+
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		var items []string
+		for i := offset; i < min(offset+2, 5); i++ {
+			items = append(items, fmt.Sprintf(`{"amount":%d,"block_height":%d}`, i, 100+i))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"_links":{"next":"?offset=%d"},"data":[%s],"_meta":{}}`, offset+2, strings.Join(items, ","))
+	}))
+	defer server.Close()
+
+	client := &mockClient{server: server}
+	service := NewService(client)
+
+	var amounts []float64
+	for transfer, err := range service.GetFTTransfers().Token("A.1654653399040a61.FlowToken").Limit(2).Stream(context.Background()) {
+		if err != nil {
+			t.Fatalf("Stream failed: %v", err)
+		}
+		amounts = append(amounts, transfer.Amount)
+	}
+
+	if fmt.Sprint(amounts) != "[0 1 2 3 4]" {
+		t.Errorf("Expected amounts [0 1 2 3 4], got %v", amounts)
+	}
+	if fmt.Sprint(offsets) != "[0 2 4]" {
+		t.Errorf("Expected offsets [0 2 4], got %v", offsets)
+	}
+}
+
+func TestFlowService_StreamStopsEarly(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[` + strings.Repeat(`{"id":"a"},`, maxPageSize-1) + `{"id":"a"}]}`))
+	}))
+	defer server.Close()
+
+	client := &mockClient{server: server}
+	service := NewService(client)
+
+	n := 0
+	for _, err := range service.GetTransactions().Stream(context.Background()) {
+		if err != nil {
+			t.Fatalf("Stream failed: %v", err)
+		}
+		if n++; n == 3 {
+			break
+		}
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 request, got %d", requests)
+	}
+}
+
+func TestFlowService_StreamNDJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Write([]byte("{\"nft_id\":1}\n{\"nft_id\":2}\n"))
+	}))
+	defer server.Close()
+
+	client := &mockClient{server: server}
+	service := NewService(client)
+
+	var ids []int64
+	for item, err := range service.GetNFTItems().NFTType("A.0b2a3299cc857e29.TopShot.NFT").Stream(context.Background()) {
+		if err != nil {
+			t.Fatalf("Stream failed: %v", err)
+		}
+		ids = append(ids, item.NFTId)
+	}
+	if fmt.Sprint(ids) != "[1 2]" {
+		t.Errorf("Expected NFT IDs [1 2], got %v", ids)
+	}
+}
+
+func TestFlowService_StreamErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("address") {
+		case "0x02":
+			w.Write([]byte(`{"data":{"not":"an array"}}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`internal error`))
+		}
+	}))
+	defer server.Close()
+
+	client := &mockClient{server: server}
+	service := NewService(client)
+	ctx := context.Background()
+
+	tests := map[string]*NFTTransfersRequestBuilder{
+		"not an array":            service.GetNFTTransfers().Address("0x02"),
+		"status 500":              service.GetNFTTransfers(),
+		"invalid account address": service.GetNFTTransfers().Sender("0xnothex"),
+	}
+	for want, b := range tests {
+		var errs []error
+		for _, err := range b.Stream(ctx) {
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+		if len(errs) != 1 || !strings.Contains(errs[0].Error(), want) {
+			t.Errorf("Expected one error containing %q, got %v", want, errs)
+		}
+	}
+}
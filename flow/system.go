@@ -0,0 +1,36 @@
+package flow
+
+import (
+	"slices"
+	"strings"
+)
+
+// isSystemPayer reports whether a payer is the empty address the protocol
+// uses for system transactions, such as the system chunk transaction that
+// sweeps fees and runs the heartbeat, and scheduled transaction execution
+func isSystemPayer(payer string) bool {
+	return strings.Trim(normalizeAddress(payer), "0") == ""
+}
+
+// IsSystem reports whether the transaction was submitted by the protocol
+// rather than a user
+func (t *Transaction) IsSystem() bool {
+	return isSystemPayer(t.Payer)
+}
+
+// IsSystem reports whether the transaction was submitted by the protocol
+// rather than a user
+func (t *BlockTransaction) IsSystem() bool {
+	return isSystemPayer(t.Payer)
+}
+
+// filterSystem keeps the items whose system flag matches system, or all items
+// if system is nil
+func filterSystem[T any](items []T, system *bool, isSystem func(*T) bool) []T {
+	if system == nil {
+		return items
+	}
+	return slices.DeleteFunc(items, func(item T) bool {
+		return isSystem(&item) != *system
+	})
+}
@@ -0,0 +1,71 @@
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTransaction_IsSystem(t *testing.T) {
+	tests := []struct {
+		payer  string
+		system bool
+	}{
+		{"0x0000000000000000", true},
+		{"", true},
+		{"0x1654653399040a61", false},
+		{"0xe467b9dd11fa00df", false},
+	}
+
+	for _, tt := range tests {
+		tx := Transaction{Payer: tt.payer}
+		if got := tx.IsSystem(); got != tt.system {
+			t.Errorf("Payer %q: expected system %v, got %v", tt.payer, tt.system, got)
+		}
+	}
+}
+
+func TestFlowService_GetBlockTransactionsSystem(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := BlockTransactionsResponse{
+			Data: []BlockTransaction{
+				{TransactionID: "user", Payer: "0x1654653399040a61"},
+				{TransactionID: "system", Payer: "0x0000000000000000"},
+			},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := &mockClient{server: server}
+	service := NewService(client)
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		b    *BlockTransactionsRequestBuilder
+		want []string
+	}{
+		{"all", service.GetBlockTransactions().Height(100), []string{"user", "system"}},
+		{"user only", service.GetBlockTransactions().Height(100).System(false), []string{"user"}},
+		{"system only", service.GetBlockTransactions().Height(100).System(true), []string{"system"}},
+	}
+
+	for _, tt := range tests {
+		result, err := tt.b.Do(ctx)
+		if err != nil {
+			t.Fatalf("%s: GetBlockTransactions failed: %v", tt.name, err)
+		}
+		var got []string
+		for _, tx := range result.Data {
+			got = append(got, tx.TransactionID)
+		}
+		if len(got) != len(tt.want) || (len(got) > 0 && got[0] != tt.want[0]) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// TaxReportOptions configures FullTaxReport
+type TaxReportOptions struct {
+	// AfterHeight resumes a previous sync: only entries above this block height
+	// are included. Pass the LastHeight of the previous report.
+	AfterHeight uint64
+}
+
+// TaxReportTotal sums the entries of one token and direction
+type TaxReportTotal struct {
+	Token     string  `json:"token"`
+	Direction string  `json:"direction"`
+	Count     int     `json:"count"`
+	Amount    float64 `json:"amount"`
+	Fees      float64 `json:"fees"`
+}
+
+// FullTaxReport is a complete, deduplicated tax report for an account
+type FullTaxReport struct {
+	Address string `json:"address"`
+	// Entries are sorted oldest first
+	Entries []TaxReportEntry `json:"entries"`
+	// Totals are sorted by token then direction
+	Totals []TaxReportTotal `json:"totals"`
+	// LastHeight is the highest block height in the report, or AfterHeight if there were no new entries
+	LastHeight uint64 `json:"last_height"`
+}
+
+// FullTaxReport walks every page of an account's tax report, drops duplicate
+// entries (which can appear when new activity shifts pages during the walk),
+// sorts the entries by time, and totals them per token and direction.
+//
+// Set opts.AfterHeight to the LastHeight of a previous report to fetch only
+// newer entries. When the API returns entries newest first, paging stops as
+// soon as a page lies entirely at or below AfterHeight.
+func (s *Service) FullTaxReport(ctx context.Context, address string, opts TaxReportOptions) (*FullTaxReport, error) {
+	if address == "" {
+		return nil, fmt.Errorf("account address is required")
+	}
+
+	seen := make(map[TaxReportEntry]struct{})
+	report := &FullTaxReport{
+		Address:    address,
+		Entries:    []TaxReportEntry{},
+		Totals:     []TaxReportTotal{},
+		LastHeight: opts.AfterHeight,
+	}
+
+	for offset := 0; ; offset += maxPageSize {
+		resp, err := s.GetAccountTaxReport().
+			Address(address).
+			Limit(maxPageSize).
+			Offset(offset).
+			Do(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("fetch tax report at offset %d: %w", offset, err)
+		}
+
+		for _, e := range resp.Data {
+			if e.BlockHeight <= opts.AfterHeight {
+				continue
+			}
+			if _, ok := seen[e]; ok {
+				continue
+			}
+			seen[e] = struct{}{}
+			report.Entries = append(report.Entries, e)
+			report.LastHeight = max(report.LastHeight, e.BlockHeight)
+		}
+
+		if len(resp.Data) < maxPageSize || pageBelowHeight(resp.Data, opts.AfterHeight) {
+			break
+		}
+	}
+
+	sortTaxEntries(report.Entries)
+	report.Totals = totalTaxEntries(report.Entries)
+	return report, nil
+}
+
+// pageBelowHeight reports whether a newest-first page lies entirely at or below height
+func pageBelowHeight(entries []TaxReportEntry, height uint64) bool {
+	if height == 0 || len(entries) == 0 {
+		return false
+	}
+	for i := 1; i < len(entries); i++ {
+		if entries[i].BlockHeight > entries[i-1].BlockHeight {
+			return false
+		}
+	}
+	return entries[0].BlockHeight <= height
+}
+
+// sortTaxEntries sorts entries oldest first, falling back to block height
+// for entries with equal or unparsable timestamps
+func sortTaxEntries(entries []TaxReportEntry) {
+	times := make(map[string]time.Time, len(entries))
+	for _, e := range entries {
+		if _, ok := times[e.Time]; !ok {
+			t, _ := time.Parse(time.RFC3339, e.Time)
+			times[e.Time] = t
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		ti, tj := times[entries[i].Time], times[entries[j].Time]
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		return entries[i].BlockHeight < entries[j].BlockHeight
+	})
+}
+
+// totalTaxEntries sums entries per token and direction
+func totalTaxEntries(entries []TaxReportEntry) []TaxReportTotal {
+	type key struct{ token, direction string }
+	byKey := make(map[key]*TaxReportTotal)
+	for _, e := range entries {
+		k := key{e.Token, e.Direction}
+		t, ok := byKey[k]
+		if !ok {
+			t = &TaxReportTotal{Token: e.Token, Direction: e.Direction}
+			byKey[k] = t
+		}
+		t.Count++
+		t.Amount += e.AbsAmount
+		t.Fees += e.Fee
+	}
+
+	totals := make([]TaxReportTotal, 0, len(byKey))
+	for _, t := range byKey {
+		totals = append(totals, *t)
+	}
+	sort.Slice(totals, func(i, j int) bool {
+		if totals[i].Token != totals[j].Token {
+			return totals[i].Token < totals[j].Token
+		}
+		return totals[i].Direction < totals[j].Direction
+	})
+	return totals
+}
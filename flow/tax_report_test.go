@@ -0,0 +1,127 @@
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// taxReportServer serves total entries newest first, one per block height, and
+// counts requests
+func taxReportServer(t *testing.T, total int, requests *int) *httptest.Server {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		if r.URL.Path != "/flow/v1/account/0x01/tax-report" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		resp := TaxReportResponse{}
+		for i := offset; i < total && i < offset+limit; i++ {
+			height := uint64(total - i)
+			direction := "in"
+			if height%2 == 0 {
+				direction = "out"
+			}
+			resp.Data = append(resp.Data, TaxReportEntry{
+				BlockHeight:     height,
+				Time:            base.Add(time.Duration(height) * time.Minute).Format(time.RFC3339),
+				Token:           "A.1654653399040a61.FlowToken",
+				Direction:       direction,
+				AbsAmount:       1,
+				Fee:             0.001,
+				TransactionHash: fmt.Sprintf("tx%d", height),
+			})
+		}
+		// Simulate a page shift: repeat the last entry of the previous page
+		if offset > 0 {
+			prev := total - offset + 1
+			resp.Data = append([]TaxReportEntry{{
+				BlockHeight:     uint64(prev),
+				Time:            base.Add(time.Duration(prev) * time.Minute).Format(time.RFC3339),
+				Token:           "A.1654653399040a61.FlowToken",
+				Direction:       map[bool]string{true: "out", false: "in"}[prev%2 == 0],
+				AbsAmount:       1,
+				Fee:             0.001,
+				TransactionHash: fmt.Sprintf("tx%d", prev),
+			}}, resp.Data[:len(resp.Data)-1]...)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+func TestFlowService_FullTaxReport(t *testing.T) {
+	requests := 0
+	server := taxReportServer(t, 150, &requests)
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	report, err := service.FullTaxReport(context.Background(), "0x01", TaxReportOptions{})
+	if err != nil {
+		t.Fatalf("FullTaxReport failed: %v", err)
+	}
+
+	// The second page repeats one entry and drops the oldest
+	if len(report.Entries) != 149 {
+		t.Fatalf("Expected 149 deduplicated entries, got %d", len(report.Entries))
+	}
+	for i := 1; i < len(report.Entries); i++ {
+		if report.Entries[i].BlockHeight < report.Entries[i-1].BlockHeight {
+			t.Fatalf("Expected entries sorted oldest first at index %d", i)
+		}
+	}
+	if report.LastHeight != 150 {
+		t.Errorf("Expected last height 150, got %d", report.LastHeight)
+	}
+
+	if len(report.Totals) != 2 {
+		t.Fatalf("Expected 2 totals, got %d", len(report.Totals))
+	}
+	in, out := report.Totals[0], report.Totals[1]
+	if in.Direction != "in" || out.Direction != "out" {
+		t.Errorf("Expected totals sorted by direction, got %s, %s", in.Direction, out.Direction)
+	}
+	if in.Count+out.Count != 149 {
+		t.Errorf("Expected counts to sum to 149, got %d", in.Count+out.Count)
+	}
+	if in.Amount != float64(in.Count) {
+		t.Errorf("Expected amount %d, got %f", in.Count, in.Amount)
+	}
+}
+
+func TestFlowService_FullTaxReportResume(t *testing.T) {
+	requests := 0
+	server := taxReportServer(t, 250, &requests)
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	report, err := service.FullTaxReport(context.Background(), "0x01", TaxReportOptions{AfterHeight: 200})
+	if err != nil {
+		t.Fatalf("FullTaxReport failed: %v", err)
+	}
+
+	if len(report.Entries) != 50 {
+		t.Errorf("Expected 50 new entries, got %d", len(report.Entries))
+	}
+	if report.Entries[0].BlockHeight != 201 {
+		t.Errorf("Expected first new entry at height 201, got %d", report.Entries[0].BlockHeight)
+	}
+	if requests != 2 {
+		t.Errorf("Expected paging to stop after 2 requests, got %d", requests)
+	}
+}
+
+func TestFlowService_FullTaxReportRequiresAddress(t *testing.T) {
+	service := NewService(&mockClient{})
+	if _, err := service.FullTaxReport(context.Background(), "", TaxReportOptions{}); err == nil {
+		t.Error("Expected error when address is not provided")
+	}
+}
@@ -2,10 +2,15 @@ package flow
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
+	"time"
+
+	"github.com/peterargue/find-api/filter"
 )
 
 // Transaction represents a Flow transaction in list format
@@ -88,6 +93,30 @@ type EventOutput struct {
 	TransactionID    string                 `json:"transaction_id"`
 	TransactionIndex int                    `json:"transaction_index"`
 	Name             string                 `json:"name"`
+	// RawFields holds the fields exactly as received, for archival or
+	// re-decoding into typed structs with DecodeFields
+	RawFields json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes an event, keeping the raw bytes of its fields
+func (e *EventOutput) UnmarshalJSON(b []byte) error {
+	type event EventOutput
+	var v struct {
+		event
+		Fields json.RawMessage `json:"fields"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*e = EventOutput(v.event)
+	e.RawFields = v.Fields
+	return unmarshalFields(v.Fields, &e.Fields)
+}
+
+// DecodeFields decodes the event fields into v, a struct whose json tags
+// match the Cadence field names
+func (e *EventOutput) DecodeFields(v any) error {
+	return decodeFields(e.RawFields, e.Fields, v)
 }
 
 // EvmTransactions represents EVM transaction information
@@ -103,20 +132,10 @@ type ImportOutput struct {
 }
 
 // TransactionsResponse represents the response from the transactions list endpoint
-type TransactionsResponse struct {
-	Data  []Transaction          `json:"data"`
-	Links map[string]string      `json:"_links"`
-	Meta  map[string]interface{} `json:"_meta"`
-	Error interface{}            `json:"error,omitempty"`
-}
+type TransactionsResponse = Response[Transaction]
 
 // TransactionResponse represents the response from the transaction details endpoint
-type TransactionResponse struct {
-	Data  []TransactionDetails   `json:"data"`
-	Links map[string]string      `json:"_links"`
-	Meta  map[string]interface{} `json:"_meta"`
-	Error interface{}            `json:"error,omitempty"`
-}
+type TransactionResponse = Response[TransactionDetails]
 
 // TransactionsRequestBuilder builds a request to get transactions
 type TransactionsRequestBuilder struct {
@@ -137,6 +156,8 @@ type TransactionsRequestBuilder struct {
 	status             *string
 	to                 *string
 	typ                *string
+	system             *bool
+	filterErr          error
 }
 
 // GetTransactions creates a new transactions request builder
@@ -144,6 +165,12 @@ func (s *Service) GetTransactions() *TransactionsRequestBuilder {
 	return &TransactionsRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the transactions request builder
+func (b *TransactionsRequestBuilder) Clone() *TransactionsRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Authorizers sets the authorizer address filter (optional)
 func (b *TransactionsRequestBuilder) Authorizers(authorizers string) *TransactionsRequestBuilder {
 	b.authorizers = &authorizers
@@ -156,12 +183,19 @@ func (b *TransactionsRequestBuilder) ContractIdentifier(contractIdentifier strin
 	return b
 }
 
-// From sets the start timestamp filter (optional, ISO 8601 format)
+// From sets the start timestamp filter as a raw query value (optional, ISO 8601 format)
 func (b *TransactionsRequestBuilder) From(from string) *TransactionsRequestBuilder {
 	b.from = &from
 	return b
 }
 
+// FromTime sets the start time filter (optional)
+func (b *TransactionsRequestBuilder) FromTime(from time.Time) *TransactionsRequestBuilder {
+	s := filter.FormatTime(from)
+	b.from = &s
+	return b
+}
+
 // Height sets the block height filter (optional)
 func (b *TransactionsRequestBuilder) Height(height uint64) *TransactionsRequestBuilder {
 	b.height = &height
@@ -228,20 +262,73 @@ func (b *TransactionsRequestBuilder) Status(status string) *TransactionsRequestB
 	return b
 }
 
-// To sets the end timestamp filter (optional, ISO 8601 format)
+// To sets the end timestamp filter as a raw query value (optional, ISO 8601 format)
 func (b *TransactionsRequestBuilder) To(to string) *TransactionsRequestBuilder {
 	b.to = &to
 	return b
 }
 
+// ToTime sets the end time filter (optional)
+func (b *TransactionsRequestBuilder) ToTime(to time.Time) *TransactionsRequestBuilder {
+	s := filter.FormatTime(to)
+	b.to = &s
+	return b
+}
+
 // Type sets the transaction type filter (optional)
 func (b *TransactionsRequestBuilder) Type(typ string) *TransactionsRequestBuilder {
 	b.typ = &typ
 	return b
 }
 
-// Do executes the transactions request
-func (b *TransactionsRequestBuilder) Do(ctx context.Context) (*TransactionsResponse, error) {
+// System limits results to system transactions (true) or user transactions
+// (false) (optional, default both). The filter is applied client-side to each
+// page, so a page may hold fewer than Limit transactions.
+func (b *TransactionsRequestBuilder) System(system bool) *TransactionsRequestBuilder {
+	b.system = &system
+	return b
+}
+
+// Apply applies reusable filters: height, time range and pagination (optional)
+func (b *TransactionsRequestBuilder) Apply(filters ...filter.Filter) *TransactionsRequestBuilder {
+	p := filter.Collect(filters...)
+	if err := p.Unsupported("GetTransactions", filter.FieldHeightRange, filter.FieldTimeRange, filter.FieldPagination); err != nil {
+		b.filterErr = err
+		return b
+	}
+	if err := applySingleHeight(p, "GetTransactions", &b.height); err != nil {
+		b.filterErr = err
+		return b
+	}
+	applyTimeRange(p, &b.from, &b.to)
+	applyPagination(p, &b.limit, &b.offset)
+	return b
+}
+
+// Validate checks the transactions request without making a network call
+func (b *TransactionsRequestBuilder) Validate() error {
+	if b.filterErr != nil {
+		return b.filterErr
+	}
+	for _, address := range []*string{b.payer, b.proposer} {
+		if address == nil {
+			continue
+		}
+		if err := validateAddress(*address); err != nil {
+			return err
+		}
+	}
+	if b.minGas != nil && b.maxGas != nil && *b.minGas > *b.maxGas {
+		return fmt.Errorf("min_gas %d is above max_gas %d", *b.minGas, *b.maxGas)
+	}
+	if b.minEvents != nil && b.maxEvents != nil && *b.minEvents > *b.maxEvents {
+		return fmt.Errorf("min_events %d is above max_events %d", *b.minEvents, *b.maxEvents)
+	}
+	return validatePage(b.limit, b.offset)
+}
+
+// query returns the transactions request's query parameters
+func (b *TransactionsRequestBuilder) query() url.Values {
 	query := url.Values{}
 	if b.authorizers != nil {
 		query.Set("authorizers", *b.authorizers)
@@ -291,8 +378,16 @@ func (b *TransactionsRequestBuilder) Do(ctx context.Context) (*TransactionsRespo
 	if b.typ != nil {
 		query.Set("type", *b.typ)
 	}
+	return query
+}
+
+// Do executes the transactions request
+func (b *TransactionsRequestBuilder) Do(ctx context.Context) (*TransactionsResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
 
-	resp, err := b.service.client.DoRequest(ctx, http.MethodGet, "/flow/v1/transaction", query)
+	resp, err := b.service.client.DoRequest(ctx, http.MethodGet, "/flow/v1/transaction", b.query())
 	if err != nil {
 		return nil, err
 	}
@@ -301,10 +396,26 @@ func (b *TransactionsRequestBuilder) Do(ctx context.Context) (*TransactionsRespo
 	if err := b.service.client.DecodeResponse(resp, &txResp); err != nil {
 		return nil, err
 	}
+	txResp.Data = emptyIfNil(filterSystem(txResp.Data, b.system, (*Transaction).IsSystem))
 
 	return &txResp, nil
 }
 
+// Stream iterates over the matching transactions page by page from the request's
+// offset, decoding one record at a time instead of whole pages so memory stays
+// flat during backfills. Limit sets the page size (default 100). If a request
+// fails the error is yielded once and iteration stops.
+func (b *TransactionsRequestBuilder) Stream(ctx context.Context) iter.Seq2[Transaction, error] {
+	if err := b.Validate(); err != nil {
+		return func(yield func(Transaction, error) bool) { yield(Transaction{}, err) }
+	}
+	var keep func(*Transaction) bool
+	if system := b.system; system != nil {
+		keep = func(tx *Transaction) bool { return tx.IsSystem() == *system }
+	}
+	return streamPages[Transaction](ctx, b.service.client, "/flow/v1/transaction", b.query(), keep)
+}
+
 // TransactionRequestBuilder builds a request to get a specific transaction
 type TransactionRequestBuilder struct {
 	service       *Service
@@ -317,6 +428,12 @@ func (s *Service) GetTransaction() *TransactionRequestBuilder {
 	return &TransactionRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the transaction request builder
+func (b *TransactionRequestBuilder) Clone() *TransactionRequestBuilder {
+	c := *b
+	return &c
+}
+
 // ID sets the transaction ID (required)
 func (b *TransactionRequestBuilder) ID(id string) *TransactionRequestBuilder {
 	b.id = id
@@ -329,10 +446,18 @@ func (b *TransactionRequestBuilder) IncludeEvents(include bool) *TransactionRequ
 	return b
 }
 
+// Validate checks the transaction request without making a network call
+func (b *TransactionRequestBuilder) Validate() error {
+	if b.id == "" {
+		return fmt.Errorf("transaction ID is required")
+	}
+	return nil
+}
+
 // Do executes the transaction request
 func (b *TransactionRequestBuilder) Do(ctx context.Context) (*TransactionResponse, error) {
-	if b.id == "" {
-		return nil, fmt.Errorf("transaction ID is required")
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	path := fmt.Sprintf("/flow/v1/transaction/%s", b.id)
@@ -352,6 +477,7 @@ func (b *TransactionRequestBuilder) Do(ctx context.Context) (*TransactionRespons
 	if err := b.service.client.DecodeResponse(resp, &txResp); err != nil {
 		return nil, err
 	}
+	txResp.Data = emptyIfNil(txResp.Data)
 
 	return &txResp, nil
 }
@@ -381,12 +507,7 @@ type ScheduledTransaction struct {
 }
 
 // ScheduledTransactionsResponse represents the response from the scheduled transactions endpoint
-type ScheduledTransactionsResponse struct {
-	Data  []ScheduledTransaction `json:"data"`
-	Links map[string]string      `json:"_links"`
-	Meta  map[string]interface{} `json:"_meta"`
-	Error interface{}            `json:"error,omitempty"`
-}
+type ScheduledTransactionsResponse = Response[ScheduledTransaction]
 
 // ScheduledTransactionsRequestBuilder builds a request to get scheduled transactions
 type ScheduledTransactionsRequestBuilder struct {
@@ -415,6 +536,12 @@ func (s *Service) GetScheduledTransactions() *ScheduledTransactionsRequestBuilde
 	return &ScheduledTransactionsRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the scheduled transactions request builder
+func (b *ScheduledTransactionsRequestBuilder) Clone() *ScheduledTransactionsRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Completed sets the completed filter (optional)
 func (b *ScheduledTransactionsRequestBuilder) Completed(completed bool) *ScheduledTransactionsRequestBuilder {
 	b.completed = &completed
@@ -518,8 +645,25 @@ func (b *ScheduledTransactionsRequestBuilder) Status(status string) *ScheduledTr
 	return b
 }
 
+// Validate checks the scheduled transactions request without making a network call
+func (b *ScheduledTransactionsRequestBuilder) Validate() error {
+	if b.owner != nil {
+		if err := validateAddress(*b.owner); err != nil {
+			return err
+		}
+	}
+	if b.heightFrom != nil && b.heightTo != nil && *b.heightFrom > *b.heightTo {
+		return fmt.Errorf("height_from %d is after height_to %d", *b.heightFrom, *b.heightTo)
+	}
+	return validatePage(b.limit, b.offset)
+}
+
 // Do executes the scheduled transactions request
 func (b *ScheduledTransactionsRequestBuilder) Do(ctx context.Context) (*ScheduledTransactionsResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
 	query := url.Values{}
 	if b.completed != nil {
 		query.Set("completed", strconv.FormatBool(*b.completed))
@@ -582,6 +726,264 @@ func (b *ScheduledTransactionsRequestBuilder) Do(ctx context.Context) (*Schedule
 	if err := b.service.client.DecodeResponse(resp, &scheduledResp); err != nil {
 		return nil, err
 	}
+	scheduledResp.Data = emptyIfNil(scheduledResp.Data)
 
 	return &scheduledResp, nil
 }
+
+// ScheduledTransactionRequestBuilder builds a request to get a specific scheduled transaction
+type ScheduledTransactionRequestBuilder struct {
+	service *Service
+	id      string
+}
+
+// GetScheduledTransaction creates a new scheduled transaction request builder
+func (s *Service) GetScheduledTransaction() *ScheduledTransactionRequestBuilder {
+	return &ScheduledTransactionRequestBuilder{service: s}
+}
+
+// Clone returns an independent copy of the scheduled transaction request builder
+func (b *ScheduledTransactionRequestBuilder) Clone() *ScheduledTransactionRequestBuilder {
+	c := *b
+	return &c
+}
+
+// ID sets the scheduled transaction ID (required)
+func (b *ScheduledTransactionRequestBuilder) ID(id string) *ScheduledTransactionRequestBuilder {
+	b.id = id
+	return b
+}
+
+// Validate checks the scheduled transaction request without making a network call
+func (b *ScheduledTransactionRequestBuilder) Validate() error {
+	if b.id == "" {
+		return fmt.Errorf("scheduled transaction ID is required")
+	}
+	return nil
+}
+
+// Do executes the scheduled transaction request, using the scheduled
+// transactions listing filtered by ID
+func (b *ScheduledTransactionRequestBuilder) Do(ctx context.Context) (*ScheduledTransaction, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	wrapped, err := b.service.GetScheduledTransactions().ID(b.id).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range wrapped.Data {
+		if wrapped.Data[i].ID == b.id {
+			return &wrapped.Data[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("scheduled transaction %s not found", b.id)
+}
+
+// ScheduledTransactionHandler is a contract that handles scheduled transactions
+type ScheduledTransactionHandler struct {
+	Handler         string `json:"handler"`
+	HandlerContract string `json:"handler_contract"`
+	HandlerUUID     int    `json:"handler_uuid"`
+	Owner           string `json:"owner"`
+	// Pending is the number of scheduled transactions not yet executed
+	Pending int `json:"pending"`
+	// Completed is the number of scheduled transactions already executed
+	Completed int `json:"completed"`
+}
+
+// ScheduledTransactionHandlersResponse holds scheduled transaction handlers
+type ScheduledTransactionHandlersResponse = Response[ScheduledTransactionHandler]
+
+// ScheduledTransactionHandlersRequestBuilder builds a request to list scheduled transaction handlers
+type ScheduledTransactionHandlersRequestBuilder struct {
+	service            *Service
+	contractIdentifier *string
+	owner              *string
+	limit              *int
+	offset             *int
+}
+
+// GetScheduledTransactionHandlers creates a new scheduled transaction handlers request builder
+func (s *Service) GetScheduledTransactionHandlers() *ScheduledTransactionHandlersRequestBuilder {
+	return &ScheduledTransactionHandlersRequestBuilder{service: s}
+}
+
+// Clone returns an independent copy of the scheduled transaction handlers request builder
+func (b *ScheduledTransactionHandlersRequestBuilder) Clone() *ScheduledTransactionHandlersRequestBuilder {
+	c := *b
+	return &c
+}
+
+// ContractIdentifier sets the handler contract filter (optional)
+func (b *ScheduledTransactionHandlersRequestBuilder) ContractIdentifier(contractIdentifier string) *ScheduledTransactionHandlersRequestBuilder {
+	b.contractIdentifier = &contractIdentifier
+	return b
+}
+
+// Owner sets the owner filter (optional)
+func (b *ScheduledTransactionHandlersRequestBuilder) Owner(owner string) *ScheduledTransactionHandlersRequestBuilder {
+	b.owner = &owner
+	return b
+}
+
+// Limit sets the number of records to return (optional, default 25, max 100)
+func (b *ScheduledTransactionHandlersRequestBuilder) Limit(limit int) *ScheduledTransactionHandlersRequestBuilder {
+	b.limit = &limit
+	return b
+}
+
+// Offset sets the pagination offset (optional)
+func (b *ScheduledTransactionHandlersRequestBuilder) Offset(offset int) *ScheduledTransactionHandlersRequestBuilder {
+	b.offset = &offset
+	return b
+}
+
+// Validate checks the scheduled transaction handlers request without making a network call
+func (b *ScheduledTransactionHandlersRequestBuilder) Validate() error {
+	if b.owner != nil {
+		if err := validateAddress(*b.owner); err != nil {
+			return err
+		}
+	}
+	return validatePage(b.limit, b.offset)
+}
+
+// Do executes the scheduled transaction handlers request. The API has no
+// handler listing, so every scheduled transaction matching the filters is
+// fetched and grouped by handler on the client before Limit and Offset apply.
+func (b *ScheduledTransactionHandlersRequestBuilder) Do(ctx context.Context) (*ScheduledTransactionHandlersResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	list := b.service.GetScheduledTransactions()
+	if b.contractIdentifier != nil {
+		list.ContractIdentifier(*b.contractIdentifier)
+	}
+	if b.owner != nil {
+		list.Owner(*b.owner)
+	}
+	txs, err := collectPages(func(offset int) ([]ScheduledTransaction, error) {
+		resp, err := list.Clone().Limit(maxPageSize).Offset(offset).Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	type handlerKey struct {
+		owner   string
+		handler string
+		uuid    int
+	}
+	handlers := []ScheduledTransactionHandler{}
+	index := make(map[handlerKey]int)
+	for _, tx := range txs {
+		key := handlerKey{owner: tx.Owner, handler: tx.Handler, uuid: tx.HandlerUUID}
+		i, ok := index[key]
+		if !ok {
+			i = len(handlers)
+			index[key] = i
+			handlers = append(handlers, ScheduledTransactionHandler{
+				Handler:         tx.Handler,
+				HandlerContract: tx.HandlerContract,
+				HandlerUUID:     tx.HandlerUUID,
+				Owner:           tx.Owner,
+			})
+		}
+		switch {
+		case tx.IsCompleted:
+			handlers[i].Completed++
+		case tx.Status != "canceled" && tx.Status != "failed":
+			handlers[i].Pending++
+		}
+	}
+
+	return &ScheduledTransactionHandlersResponse{Data: paginate(handlers, b.limit, b.offset)}, nil
+}
+
+// TransactionEventsResponse holds the events of a transaction
+type TransactionEventsResponse = Response[EventOutput]
+
+// TransactionEventsRequestBuilder builds a request to get the events of a transaction
+type TransactionEventsRequestBuilder struct {
+	service *Service
+	id      string
+	name    *string
+	limit   *int
+	offset  *int
+}
+
+// GetTransactionEvents creates a new transaction events request builder
+func (s *Service) GetTransactionEvents() *TransactionEventsRequestBuilder {
+	return &TransactionEventsRequestBuilder{service: s}
+}
+
+// Clone returns an independent copy of the transaction events request builder
+func (b *TransactionEventsRequestBuilder) Clone() *TransactionEventsRequestBuilder {
+	c := *b
+	return &c
+}
+
+// ID sets the transaction ID (required)
+func (b *TransactionEventsRequestBuilder) ID(id string) *TransactionEventsRequestBuilder {
+	b.id = id
+	return b
+}
+
+// Name sets the event type filter, e.g. A.1654653399040a61.FlowToken.TokensDeposited (optional)
+func (b *TransactionEventsRequestBuilder) Name(name string) *TransactionEventsRequestBuilder {
+	b.name = &name
+	return b
+}
+
+// Limit sets the number of records to return (optional, default 25, max 100)
+func (b *TransactionEventsRequestBuilder) Limit(limit int) *TransactionEventsRequestBuilder {
+	b.limit = &limit
+	return b
+}
+
+// Offset sets the pagination offset (optional)
+func (b *TransactionEventsRequestBuilder) Offset(offset int) *TransactionEventsRequestBuilder {
+	b.offset = &offset
+	return b
+}
+
+// Validate checks the transaction events request without making a network call
+func (b *TransactionEventsRequestBuilder) Validate() error {
+	if b.id == "" {
+		return fmt.Errorf("transaction ID is required")
+	}
+	return validatePage(b.limit, b.offset)
+}
+
+// Do executes the transaction events request. Events are read from the
+// GetTransaction response with IncludeEvents; Name, Limit and Offset are
+// applied on the client.
+func (b *TransactionEventsRequestBuilder) Do(ctx context.Context) (*TransactionEventsResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	txResp, err := b.service.GetTransaction().ID(b.id).IncludeEvents(true).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events := []EventOutput{}
+	for _, tx := range txResp.Data {
+		for _, event := range tx.Events {
+			if b.name == nil || event.Name == *b.name {
+				events = append(events, event)
+			}
+		}
+	}
+
+	return &TransactionEventsResponse{Data: paginate(events, b.limit, b.offset)}, nil
+}
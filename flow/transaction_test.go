@@ -6,7 +6,11 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
+	"time"
+
+	"github.com/peterargue/find-api/txerror"
 )
 
 func TestFlowService_GetTransactions(t *testing.T) {
@@ -112,6 +116,31 @@ func TestFlowService_GetTransactionsWithFilters(t *testing.T) {
 	}
 }
 
+func TestFlowService_GetTransactionsTimeRange(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if got := query.Get("from"); got != "2024-01-01T00:00:00Z" {
+			t.Errorf("Expected from 2024-01-01T00:00:00Z, got %s", got)
+		}
+		if got := query.Get("to"); got != "2024-01-02T00:00:00Z" {
+			t.Errorf("Expected to 2024-01-02T00:00:00Z, got %s", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(TransactionsResponse{})
+	}))
+	defer server.Close()
+
+	client := &mockClient{server: server}
+	service := NewService(client)
+
+	from := time.Date(2024, 1, 1, 1, 0, 0, 0, time.FixedZone("CET", 3600))
+	to := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	if _, err := service.GetTransactions().FromTime(from).ToTime(to).Do(context.Background()); err != nil {
+		t.Fatalf("GetTransactions failed: %v", err)
+	}
+}
+
 func TestFlowService_GetTransaction(t *testing.T) {
 	txID := "abc123def456"
 
@@ -221,6 +250,153 @@ func TestFlowService_GetScheduledTransactions(t *testing.T) {
 	}
 }
 
+func TestFlowService_GetScheduledTransaction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/flow/v1/scheduled-transaction" {
+			t.Errorf("Expected path /flow/v1/scheduled-transaction, got %s", r.URL.Path)
+		}
+		if id := r.URL.Query().Get("id"); id != "42" && id != "7" {
+			t.Errorf("Expected id 42 or 7, got %s", id)
+		}
+
+		resp := ScheduledTransactionsResponse{
+			Data: []ScheduledTransaction{
+				{ID: "42", Owner: "0x1654653399040a61", Status: "scheduled", Priority: "high"},
+			},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := &mockClient{server: server}
+	service := NewService(client)
+
+	ctx := context.Background()
+	tx, err := service.GetScheduledTransaction().ID("42").Do(ctx)
+	if err != nil {
+		t.Fatalf("GetScheduledTransaction failed: %v", err)
+	}
+	if tx.ID != "42" {
+		t.Errorf("Expected ID 42, got %s", tx.ID)
+	}
+	if tx.Status != "scheduled" {
+		t.Errorf("Expected status scheduled, got %s", tx.Status)
+	}
+
+	if _, err := service.GetScheduledTransaction().ID("7").Do(ctx); err == nil {
+		t.Error("Expected error when the response does not contain the ID")
+	}
+}
+
+func TestFlowService_GetScheduledTransactionHandlers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/flow/v1/scheduled-transaction" {
+			t.Errorf("Expected path /flow/v1/scheduled-transaction, got %s", r.URL.Path)
+		}
+		if owner := r.URL.Query().Get("owner"); owner != "0x1654653399040a61" {
+			t.Errorf("Expected owner 0x1654653399040a61, got %s", owner)
+		}
+
+		handler := ScheduledTransaction{
+			Handler:         "A.1654653399040a61.Scheduler.Handler",
+			HandlerContract: "A.1654653399040a61.Scheduler",
+			HandlerUUID:     1234,
+			Owner:           "0x1654653399040a61",
+		}
+		var txs []ScheduledTransaction
+		for i := range 5 {
+			tx := handler
+			tx.ID = strconv.Itoa(i)
+			tx.Status = "scheduled"
+			if i < 2 {
+				tx.Status = "executed"
+				tx.IsCompleted = true
+			}
+			if i == 4 {
+				tx.Status = "canceled"
+			}
+			txs = append(txs, tx)
+		}
+		other := handler
+		other.HandlerUUID = 5678
+		txs = append(txs, other)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ScheduledTransactionsResponse{Data: txs})
+	}))
+	defer server.Close()
+
+	client := &mockClient{server: server}
+	service := NewService(client)
+
+	ctx := context.Background()
+	result, err := service.GetScheduledTransactionHandlers().Owner("0x1654653399040a61").Do(ctx)
+	if err != nil {
+		t.Fatalf("GetScheduledTransactionHandlers failed: %v", err)
+	}
+
+	if len(result.Data) != 2 {
+		t.Fatalf("Expected 2 handlers, got %d", len(result.Data))
+	}
+	h := result.Data[0]
+	if h.HandlerUUID != 1234 || h.Pending != 2 || h.Completed != 2 {
+		t.Errorf("Expected handler 1234 with 2 pending and 2 completed, got %+v", h)
+	}
+
+	result, err = service.GetScheduledTransactionHandlers().Owner("0x1654653399040a61").Offset(1).Do(ctx)
+	if err != nil {
+		t.Fatalf("GetScheduledTransactionHandlers failed: %v", err)
+	}
+	if len(result.Data) != 1 || result.Data[0].HandlerUUID != 5678 {
+		t.Errorf("Expected only handler 5678 after offset 1, got %+v", result.Data)
+	}
+}
+
+func TestFlowService_GetTransactionEvents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/flow/v1/transaction/abc123" {
+			t.Errorf("Expected path /flow/v1/transaction/abc123, got %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("include_events"); got != "true" {
+			t.Errorf("Expected include_events true, got %s", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[{"id":"abc123","events":[
+			{"name":"A.1654653399040a61.FlowToken.TokensWithdrawn","event_index":0,"transaction_id":"abc123"},
+			{"name":"A.1654653399040a61.FlowToken.TokensDeposited","event_index":1,"transaction_id":"abc123","fields":{"amount":"0.5"}},
+			{"name":"A.1654653399040a61.FlowToken.TokensDeposited","event_index":3,"transaction_id":"abc123","fields":{"amount":"1.5"}}
+		]}]}`))
+	}))
+	defer server.Close()
+
+	client := &mockClient{server: server}
+	service := NewService(client)
+
+	ctx := context.Background()
+	result, err := service.GetTransactionEvents().
+		ID("abc123").
+		Name("A.1654653399040a61.FlowToken.TokensDeposited").
+		Offset(1).
+		Do(ctx)
+	if err != nil {
+		t.Fatalf("GetTransactionEvents failed: %v", err)
+	}
+
+	if len(result.Data) != 1 {
+		t.Fatalf("Expected 1 event, got %d", len(result.Data))
+	}
+	if result.Data[0].EventIndex != 3 || result.Data[0].Fields["amount"] != "1.5" {
+		t.Errorf("Expected event 3 with amount 1.5, got %+v", result.Data[0])
+	}
+
+	if _, err := service.GetTransactionEvents().Do(ctx); err == nil {
+		t.Error("Expected error when transaction ID is not provided")
+	}
+}
+
 func TestFlowService_TransactionRequiredFields(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
 	defer server.Close()
@@ -234,4 +410,54 @@ func TestFlowService_TransactionRequiredFields(t *testing.T) {
 	if err == nil {
 		t.Error("Expected error when transaction ID is not provided")
 	}
+
+	// Test GetScheduledTransaction without ID
+	_, err = service.GetScheduledTransaction().Do(ctx)
+	if err == nil {
+		t.Error("Expected error when scheduled transaction ID is not provided")
+	}
+}
+
+func TestTransaction_ExecutionError(t *testing.T) {
+	tx := Transaction{
+		Error:     "[Error Code: 1103] The account with address (0x1) uses 101 bytes of storage which is over its capacity",
+		ErrorCode: "1103",
+	}
+
+	txErr := tx.ExecutionError()
+	if txErr == nil {
+		t.Fatal("Expected execution error, got nil")
+	}
+	if txErr.Code != txerror.CodeStorageCapacityExceeded {
+		t.Errorf("Expected code %d, got %d", txerror.CodeStorageCapacityExceeded, txErr.Code)
+	}
+	if !txerror.IsStorageExceeded(tx) {
+		t.Error("Expected IsStorageExceeded to be true")
+	}
+
+	if (Transaction{Status: "Sealed"}).ExecutionError() != nil {
+		t.Error("Expected nil execution error for successful transaction")
+	}
+}
+
+func TestEventOutput_RawFields(t *testing.T) {
+	var tx TransactionDetails
+	body := `{"id":"abc","events":[{"name":"A.1.Token.Deposited","event_index":2,"fields":{"amount":"1.5"}}]}`
+	if err := json.Unmarshal([]byte(body), &tx); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	e := tx.Events[0]
+	if e.EventIndex != 2 || e.Fields["amount"] != "1.5" {
+		t.Errorf("Expected decoded event and fields, got %+v", e)
+	}
+	if string(e.RawFields) != `{"amount":"1.5"}` {
+		t.Errorf("Expected raw fields kept, got %s", e.RawFields)
+	}
+
+	var typed struct {
+		Amount string `json:"amount"`
+	}
+	if err := e.DecodeFields(&typed); err != nil || typed.Amount != "1.5" {
+		t.Errorf("Expected amount 1.5, got %q (%v)", typed.Amount, err)
+	}
 }
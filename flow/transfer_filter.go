@@ -0,0 +1,156 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// The transfer endpoints only filter by token or collection, address, height
+// and transaction on the server. The other transfer filters are applied on the
+// client: the request fetches every page matching the server-side filters,
+// keeps the matching records and then applies Limit and Offset to them (all
+// matches are returned without a Limit), so narrow requests with server-side
+// filters where possible.
+
+// transferFilter holds the transfer filters applied on the client
+type transferFilter struct {
+	// account is the address Direction is relative to; without it the
+	// direction reported in each record is used
+	account   string
+	sender    *string
+	receiver  *string
+	direction *string
+	minAmount *float64
+	maxAmount *float64
+	from      *string
+	to        *string
+}
+
+// active reports whether any client-side filter is set
+func (f transferFilter) active() bool {
+	return f.sender != nil || f.receiver != nil || f.direction != nil ||
+		f.minAmount != nil || f.maxAmount != nil || f.from != nil || f.to != nil
+}
+
+// validate checks the filter values without making a network call
+func (f transferFilter) validate() error {
+	for _, address := range []*string{f.sender, f.receiver} {
+		if address == nil {
+			continue
+		}
+		if err := validateAddress(*address); err != nil {
+			return err
+		}
+	}
+	if err := validateDirection(f.direction); err != nil {
+		return err
+	}
+	if err := validateAmountRange(f.minAmount, f.maxAmount); err != nil {
+		return err
+	}
+	if f.from != nil {
+		if _, err := time.Parse(time.RFC3339, *f.from); err != nil {
+			return fmt.Errorf("from time %q must be RFC 3339", *f.from)
+		}
+	}
+	if f.to != nil {
+		if _, err := time.Parse(time.RFC3339, *f.to); err != nil {
+			return fmt.Errorf("to time %q must be RFC 3339", *f.to)
+		}
+	}
+	return nil
+}
+
+// match reports whether a transfer with the given fields passes the filter
+func (f transferFilter) match(sender, receiver, direction string, amount float64, timestamp string) bool {
+	if f.sender != nil && normalizeAddress(sender) != normalizeAddress(*f.sender) {
+		return false
+	}
+	if f.receiver != nil && normalizeAddress(receiver) != normalizeAddress(*f.receiver) {
+		return false
+	}
+	if f.direction != nil {
+		if f.account != "" {
+			direction = DirectionOut
+			if normalizeAddress(receiver) == normalizeAddress(f.account) {
+				direction = DirectionIn
+			}
+		}
+		if direction != *f.direction {
+			return false
+		}
+	}
+	if f.minAmount != nil && amount < *f.minAmount {
+		return false
+	}
+	if f.maxAmount != nil && amount > *f.maxAmount {
+		return false
+	}
+	if f.from != nil || f.to != nil {
+		t, err := time.Parse(time.RFC3339, timestamp)
+		if err != nil {
+			return false
+		}
+		if f.from != nil {
+			if from, _ := time.Parse(time.RFC3339, *f.from); t.Before(from) {
+				return false
+			}
+		}
+		if f.to != nil {
+			if to, _ := time.Parse(time.RFC3339, *f.to); t.After(to) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// matchFT reports whether a fungible token transfer passes the filter
+func (f transferFilter) matchFT(t *FTTransfer) bool {
+	return f.match(t.Sender, t.Receiver, t.Direction, t.Amount, t.Timestamp)
+}
+
+// matchNFT reports whether an NFT transfer passes the filter
+func (f transferFilter) matchNFT(t *NFTTransfer) bool {
+	return f.match(t.Sender, t.Receiver, t.Direction, 0, t.Timestamp)
+}
+
+// filterPages fetches every page of a list endpoint for query and returns the
+// records for which keep returns true, with limit and offset applied to them
+func filterPages[T any](ctx context.Context, client Client, path string, query url.Values, limit, offset *int, keep func(*T) bool) (*Response[T], error) {
+	query = cloneQuery(query)
+	query.Set("limit", strconv.Itoa(maxPageSize))
+	all, err := collectPages(func(offset int) ([]T, error) {
+		query.Set("offset", strconv.Itoa(offset))
+		resp, err := client.DoRequest(ctx, http.MethodGet, path, query)
+		if err != nil {
+			return nil, err
+		}
+		var page Response[T]
+		if err := client.DecodeResponse(resp, &page); err != nil {
+			return nil, err
+		}
+		return page.Data, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	matched := slices.DeleteFunc(all, func(item T) bool { return !keep(&item) })
+	return &Response[T]{Data: paginate(matched, limit, offset)}, nil
+}
+
+// setPage sets the limit and offset query parameters, if given
+func setPage(query url.Values, limit, offset *int) {
+	if limit != nil {
+		query.Set("limit", strconv.Itoa(*limit))
+	}
+	if offset != nil {
+		query.Set("offset", strconv.Itoa(*offset))
+	}
+}
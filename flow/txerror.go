@@ -0,0 +1,23 @@
+package flow
+
+import "github.com/peterargue/find-api/txerror"
+
+// ExecutionError decodes the transaction's error code and message, or returns nil if it succeeded
+func (t Transaction) ExecutionError() *txerror.Error {
+	return txerror.Parse(t.ErrorCode, t.Error)
+}
+
+// ExecutionError decodes the transaction's error code and message, or returns nil if it succeeded
+func (t TransactionDetails) ExecutionError() *txerror.Error {
+	return txerror.Parse(t.ErrorCode, t.Error)
+}
+
+// ExecutionError decodes the transaction's error code and message, or returns nil if it succeeded
+func (t BlockTransaction) ExecutionError() *txerror.Error {
+	return txerror.Parse(t.ErrorCode, t.Error)
+}
+
+// ExecutionError decodes the transaction's error code and message, or returns nil if it succeeded
+func (t AccountTransaction) ExecutionError() *txerror.Error {
+	return txerror.Parse(t.ErrorCode, t.Error)
+}
@@ -0,0 +1,71 @@
+package flow
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// flowAddressRe matches a Flow address, with or without the 0x prefix
+var flowAddressRe = regexp.MustCompile(`^(0x)?[0-9a-fA-F]{1,16}$`)
+
+// validateAddress checks that address is a Flow address
+func validateAddress(address string) error {
+	if !flowAddressRe.MatchString(address) {
+		return fmt.Errorf("invalid account address %q", address)
+	}
+	return nil
+}
+
+// Transfer directions accepted by Direction on transfer builders
+const (
+	DirectionIn  = "in"
+	DirectionOut = "out"
+)
+
+// validateDirection checks an optional transfer direction
+func validateDirection(direction *string) error {
+	if direction != nil && *direction != DirectionIn && *direction != DirectionOut {
+		return fmt.Errorf("direction %q must be %q or %q", *direction, DirectionIn, DirectionOut)
+	}
+	return nil
+}
+
+// validateAmountRange checks optional, inclusive transfer amount bounds
+func validateAmountRange(min, max *float64) error {
+	if min != nil && *min < 0 {
+		return fmt.Errorf("min_amount %v is negative", *min)
+	}
+	if max != nil && *max < 0 {
+		return fmt.Errorf("max_amount %v is negative", *max)
+	}
+	if min != nil && max != nil && *min > *max {
+		return fmt.Errorf("min_amount %v is above max_amount %v", *min, *max)
+	}
+	return nil
+}
+
+// validatePage checks optional limit and offset values against the bounds
+// accepted by the list endpoints
+func validatePage(limit, offset *int) error {
+	if limit != nil && (*limit < 1 || *limit > maxPageSize) {
+		return fmt.Errorf("limit %d is outside 1-%d", *limit, maxPageSize)
+	}
+	if offset != nil && *offset < 0 {
+		return fmt.Errorf("offset %d is negative", *offset)
+	}
+	return nil
+}
+
+// checkHeightRange validates a required, inclusive height range
+func checkHeightRange(from, to uint64) error {
+	if from == 0 {
+		return fmt.Errorf("from_height is required")
+	}
+	if to == 0 {
+		return fmt.Errorf("to_height is required")
+	}
+	if from > to {
+		return fmt.Errorf("from_height %d is after to_height %d", from, to)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package flow
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRequestBuilders_Validate(t *testing.T) {
+	service := NewService(nil)
+
+	tests := []struct {
+		name    string
+		err     error
+		wantErr string
+	}{
+		{"valid account", service.GetAccountTransactions().Address("0x1654653399040a61").Limit(100).Validate(), ""},
+		{"address without prefix", service.GetAccount().Address("1654653399040a61").Validate(), ""},
+		{"missing address", service.GetAccount().Validate(), "account address is required"},
+		{"malformed address", service.GetAccountFTs().Address("0xnothex").Validate(), "invalid account address"},
+		{"limit above max", service.GetBlocks().Limit(101).Validate(), "limit 101 is outside 1-100"},
+		{"zero limit", service.GetContracts().Limit(0).Validate(), "limit 0 is outside 1-100"},
+		{"negative offset", service.GetNFTCollections().Offset(-1).Validate(), "offset -1 is negative"},
+		{"inverted range", service.GetContractEvents().Identifier("A.1654653399040a61.FlowToken").FromHeight(20).ToHeight(10).Validate(), "from_height 20 is after to_height 10"},
+		{"merged limit above page size", service.GetEvents().Names("A.1654653399040a61.FlowToken.TokensDeposited").FromHeight(1).ToHeight(2).Limit(500).Validate(), ""},
+		{"malformed evm address", service.GetEvmToken().Address("0x1234").Validate(), "invalid EVM address"},
+		{"gas bounds", service.GetTransactions().MinGas(10).MaxGas(5).Validate(), "min_gas 10 is above max_gas 5"},
+		{"unknown direction", service.GetFTTransfers().Direction("both").Validate(), `direction "both" must be "in" or "out"`},
+		{"amount bounds", service.GetAccountFTTransfers().Address("0x1654653399040a61").MinAmount(100).MaxAmount(10).Validate(), "min_amount 100 is above max_amount 10"},
+		{"malformed sender", service.GetFTTransfers().Sender("0xnothex").Validate(), "invalid account address"},
+		{"no required fields", service.GetEpochStatus().Validate(), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			switch {
+			case tt.wantErr == "" && tt.err != nil:
+				t.Errorf("Expected no error, got %v", tt.err)
+			case tt.wantErr != "" && (tt.err == nil || !strings.Contains(tt.err.Error(), tt.wantErr)):
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, tt.err)
+			}
+		})
+	}
+}
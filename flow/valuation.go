@@ -0,0 +1,143 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// PriceSource returns the current USD price of one unit of a token. ok is
+// false when no price is known for the token.
+type PriceSource interface {
+	USDPrice(ctx context.Context, token string) (price float64, ok bool, err error)
+}
+
+// PriceSourceFunc adapts a function to a PriceSource
+type PriceSourceFunc func(ctx context.Context, token string) (float64, bool, error)
+
+// USDPrice calls f
+func (f PriceSourceFunc) USDPrice(ctx context.Context, token string) (float64, bool, error) {
+	return f(ctx, token)
+}
+
+// priceLookback is the number of recent transfers searched for a price
+const priceLookback = 25
+
+// TransferPriceSource prices tokens with the approx_usd_price of their most
+// recent transfer that carries one. The API has no dedicated price endpoint, so
+// prices are approximate and tokens without priced transfers are unpriced.
+func (s *Service) TransferPriceSource() PriceSource {
+	return PriceSourceFunc(func(ctx context.Context, token string) (float64, bool, error) {
+		resp, err := s.GetFTTransfers().Token(token).Limit(priceLookback).Do(ctx)
+		if err != nil {
+			return 0, false, err
+		}
+		for _, t := range resp.Data {
+			if t.ApproxUSDPrice > 0 {
+				return t.ApproxUSDPrice, true, nil
+			}
+		}
+		return 0, false, nil
+	})
+}
+
+// ValuedHolding is a token balance with its USD value
+type ValuedHolding struct {
+	Token    string  `json:"token"`
+	Balance  float64 `json:"balance"`
+	USDPrice float64 `json:"usd_price"`
+	USDValue float64 `json:"usd_value"`
+	// Priced is false when no price is known; USDPrice and USDValue are then zero
+	Priced bool `json:"priced"`
+}
+
+// Valuation is a set of valued holdings and their total
+type Valuation struct {
+	// Holdings are sorted by USD value, highest first, followed by unpriced tokens
+	Holdings []ValuedHolding `json:"holdings"`
+	// TotalUSD is the sum of the priced holdings
+	TotalUSD float64 `json:"total_usd"`
+	// Unpriced lists the tokens excluded from TotalUSD because no price is known
+	Unpriced []string `json:"unpriced"`
+}
+
+// ValueFTHoldings values each holding with prices and computes the total.
+// Each distinct token is priced once.
+func ValueFTHoldings(ctx context.Context, prices PriceSource, holdings []FTHolding) (*Valuation, error) {
+	balances := make([]tokenBalance, len(holdings))
+	for i, h := range holdings {
+		balances[i] = tokenBalance{token: h.Token, balance: h.Balance.Float64()}
+	}
+	return valueBalances(ctx, prices, balances)
+}
+
+// ValueFTCollections values each vault with prices and computes the total.
+// Each distinct token is priced once.
+func ValueFTCollections(ctx context.Context, prices PriceSource, collections []AccountFTCollection) (*Valuation, error) {
+	balances := make([]tokenBalance, len(collections))
+	for i, c := range collections {
+		balance, err := c.Balance.Float64()
+		if err != nil {
+			return nil, fmt.Errorf("parse %s balance %q: %w", c.Token, c.Balance, err)
+		}
+		balances[i] = tokenBalance{token: c.Token, balance: balance}
+	}
+	return valueBalances(ctx, prices, balances)
+}
+
+// Valuation values the portfolio's FT holdings with prices
+func (p *Portfolio) Valuation(ctx context.Context, prices PriceSource) (*Valuation, error) {
+	return ValueFTHoldings(ctx, prices, p.FTHoldings)
+}
+
+type tokenBalance struct {
+	token   string
+	balance float64
+}
+
+type tokenPrice struct {
+	price float64
+	ok    bool
+}
+
+func valueBalances(ctx context.Context, prices PriceSource, balances []tokenBalance) (*Valuation, error) {
+	if prices == nil {
+		return nil, fmt.Errorf("price source is required")
+	}
+
+	v := &Valuation{Holdings: []ValuedHolding{}, Unpriced: []string{}}
+	cache := make(map[string]tokenPrice)
+	for _, b := range balances {
+		p, seen := cache[b.token]
+		if !seen {
+			price, ok, err := prices.USDPrice(ctx, b.token)
+			if err != nil {
+				return nil, fmt.Errorf("price %s: %w", b.token, err)
+			}
+			p = tokenPrice{price: price, ok: ok}
+			cache[b.token] = p
+			if !ok {
+				v.Unpriced = append(v.Unpriced, b.token)
+			}
+		}
+
+		h := ValuedHolding{Token: b.token, Balance: b.balance}
+		if p.ok {
+			h.Priced = true
+			h.USDPrice = p.price
+			h.USDValue = b.balance * p.price
+			v.TotalUSD += h.USDValue
+		}
+		v.Holdings = append(v.Holdings, h)
+	}
+
+	sort.SliceStable(v.Holdings, func(i, j int) bool {
+		a, b := v.Holdings[i], v.Holdings[j]
+		if a.Priced != b.Priced {
+			return a.Priced
+		}
+		return a.USDValue > b.USDValue
+	})
+	sort.Strings(v.Unpriced)
+	return v, nil
+}
@@ -0,0 +1,108 @@
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func staticPrices(prices map[string]float64, calls map[string]int) PriceSource {
+	return PriceSourceFunc(func(ctx context.Context, token string) (float64, bool, error) {
+		calls[token]++
+		p, ok := prices[token]
+		return p, ok, nil
+	})
+}
+
+func TestValueFTHoldings(t *testing.T) {
+	calls := map[string]int{}
+	prices := staticPrices(map[string]float64{"FLOW": 0.5, "USDC": 1}, calls)
+
+	v, err := ValueFTHoldings(context.Background(), prices, []FTHolding{
+		{Token: "FLOW", Balance: 100},
+		{Token: "MEME", Balance: 1e9},
+		{Token: "USDC", Balance: 75},
+		{Token: "FLOW", Balance: 10},
+	})
+	if err != nil {
+		t.Fatalf("ValueFTHoldings failed: %v", err)
+	}
+
+	if v.TotalUSD != 130 {
+		t.Errorf("Expected total 130, got %g", v.TotalUSD)
+	}
+	if len(v.Unpriced) != 1 || v.Unpriced[0] != "MEME" {
+		t.Errorf("Expected MEME unpriced, got %v", v.Unpriced)
+	}
+	if calls["FLOW"] != 1 {
+		t.Errorf("Expected FLOW priced once, got %d", calls["FLOW"])
+	}
+
+	order := []string{"USDC", "FLOW", "FLOW", "MEME"}
+	for i, h := range v.Holdings {
+		if h.Token != order[i] {
+			t.Fatalf("Expected order %v, got %+v", order, v.Holdings)
+		}
+	}
+	if last := v.Holdings[3]; last.Priced || last.USDValue != 0 {
+		t.Errorf("Expected unpriced holding with zero value, got %+v", last)
+	}
+}
+
+func TestValueFTCollections(t *testing.T) {
+	prices := staticPrices(map[string]float64{"FLOW": 2}, map[string]int{})
+
+	v, err := ValueFTCollections(context.Background(), prices, []AccountFTCollection{{Token: "FLOW", Balance: "1.5"}})
+	if err != nil {
+		t.Fatalf("ValueFTCollections failed: %v", err)
+	}
+	if v.TotalUSD != 3 || len(v.Unpriced) != 0 {
+		t.Errorf("Expected total 3 with nothing unpriced, got %+v", v)
+	}
+
+	if _, err := ValueFTCollections(context.Background(), prices, []AccountFTCollection{{Token: "FLOW", Balance: "x"}}); err == nil {
+		t.Error("Expected error for invalid balance")
+	}
+}
+
+func TestValueFTHoldings_PriceError(t *testing.T) {
+	failing := PriceSourceFunc(func(ctx context.Context, token string) (float64, bool, error) {
+		return 0, false, errors.New("boom")
+	})
+	if _, err := ValueFTHoldings(context.Background(), failing, []FTHolding{{Token: "FLOW"}}); err == nil {
+		t.Error("Expected price error")
+	}
+	if _, err := ValueFTHoldings(context.Background(), nil, nil); err == nil {
+		t.Error("Expected error for missing price source")
+	}
+}
+
+func TestFlowService_TransferPriceSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/flow/v1/ft/transfer" {
+			t.Errorf("Expected path /flow/v1/ft/transfer, got %s", r.URL.Path)
+		}
+		resp := TransfersResponse{}
+		if r.URL.Query().Get("token") == "A.1654653399040a61.FlowToken" {
+			resp.Data = []FTTransfer{{ApproxUSDPrice: 0}, {ApproxUSDPrice: 0.72}}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	prices := NewService(&mockClient{server: server}).TransferPriceSource()
+
+	price, ok, err := prices.USDPrice(context.Background(), "A.1654653399040a61.FlowToken")
+	if err != nil || !ok || price != 0.72 {
+		t.Errorf("Expected price 0.72, got %g %v %v", price, ok, err)
+	}
+
+	_, ok, err = prices.USDPrice(context.Background(), "A.0000000000000000.Unknown")
+	if err != nil || ok {
+		t.Errorf("Expected unpriced token, got %v %v", ok, err)
+	}
+}
@@ -0,0 +1,158 @@
+package findapi
+
+import (
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+)
+
+// RequestInfo describes a completed API request
+type RequestInfo struct {
+	Method string
+	// Endpoint is the route template, e.g. "/flow/v1/account/{address}"
+	Endpoint string
+	Path     string
+	// Params holds the values of the template's path parameters
+	Params map[string]string
+	Query  url.Values
+	// StatusCode is the final response status, or 0 if no response was received
+	StatusCode int
+	// Duration is the total time taken, including retries
+	Duration time.Duration
+	Err      error
+}
+
+// slowRequestHook is a latency budget registered with OnSlowRequest
+type slowRequestHook struct {
+	threshold time.Duration
+	fn        func(RequestInfo)
+}
+
+// OnSlowRequest calls fn after every request that took longer than threshold,
+// including retries. It may be given several times, e.g. to warn and to page at
+// different budgets. fn is called synchronously on the requesting goroutine.
+func OnSlowRequest(threshold time.Duration, fn func(info RequestInfo)) ClientOption {
+	return func(c *Client) {
+		if fn != nil {
+			c.slowHooks = append(c.slowHooks, slowRequestHook{threshold: threshold, fn: fn})
+		}
+	}
+}
+
+// DefaultLatencyBuckets are the histogram bucket bounds used by WithLatencyHistogram
+var DefaultLatencyBuckets = []time.Duration{
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+}
+
+// WithLatencyHistogram records the duration of every request in a histogram per
+// endpoint, readable with Client.LatencyHistograms. Buckets are upper bounds and
+// default to DefaultLatencyBuckets.
+func WithLatencyHistogram(buckets ...time.Duration) ClientOption {
+	return func(c *Client) {
+		if len(buckets) == 0 {
+			buckets = DefaultLatencyBuckets
+		}
+		bounds := append([]time.Duration(nil), buckets...)
+		sort.Slice(bounds, func(i, j int) bool { return bounds[i] < bounds[j] })
+		c.latencies = &latencyRecorder{buckets: bounds, endpoints: make(map[string]*LatencyHistogram)}
+	}
+}
+
+// LatencyHistogram is a distribution of request durations for one endpoint
+type LatencyHistogram struct {
+	// Buckets are the upper bounds of each bucket
+	Buckets []time.Duration
+	// Counts has one entry per bucket plus a final entry for slower requests
+	Counts []int
+	Count  int
+	Sum    time.Duration
+	Max    time.Duration
+}
+
+// Mean returns the average request duration
+func (h LatencyHistogram) Mean() time.Duration {
+	if h.Count == 0 {
+		return 0
+	}
+	return h.Sum / time.Duration(h.Count)
+}
+
+// LatencyHistograms returns a snapshot of the latency histograms keyed by
+// method and endpoint template, e.g. "GET /flow/v1/account/{address}". It is
+// nil unless the client was created with WithLatencyHistogram.
+func (c *Client) LatencyHistograms() map[string]LatencyHistogram {
+	if c.latencies == nil {
+		return nil
+	}
+	return c.latencies.snapshot()
+}
+
+// latencyRecorder accumulates per-endpoint latency histograms
+type latencyRecorder struct {
+	buckets []time.Duration
+
+	mu        sync.Mutex
+	endpoints map[string]*LatencyHistogram
+}
+
+func (l *latencyRecorder) observe(endpoint string, d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	h, ok := l.endpoints[endpoint]
+	if !ok {
+		h = &LatencyHistogram{Buckets: l.buckets, Counts: make([]int, len(l.buckets)+1)}
+		l.endpoints[endpoint] = h
+	}
+	i := sort.Search(len(l.buckets), func(i int) bool { return d <= l.buckets[i] })
+	h.Counts[i]++
+	h.Count++
+	h.Sum += d
+	h.Max = max(h.Max, d)
+}
+
+func (l *latencyRecorder) snapshot() map[string]LatencyHistogram {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	out := make(map[string]LatencyHistogram, len(l.endpoints))
+	for k, h := range l.endpoints {
+		cp := *h
+		cp.Counts = append([]int(nil), h.Counts...)
+		out[k] = cp
+	}
+	return out
+}
+
+// observeRequest feeds a completed request to the latency histogram and slow request hooks
+func (c *Client) observeRequest(rt route, path string, query url.Values, start time.Time, statusCode int, err error) {
+	if c.latencies == nil && len(c.slowHooks) == 0 {
+		return
+	}
+	d := time.Since(start)
+	if c.latencies != nil {
+		c.latencies.observe(rt.method+" "+rt.template, d)
+	}
+	for _, h := range c.slowHooks {
+		if d <= h.threshold {
+			continue
+		}
+		h.fn(RequestInfo{
+			Method:     rt.method,
+			Endpoint:   rt.template,
+			Path:       path,
+			Params:     routeParams(rt.template, path),
+			Query:      query,
+			StatusCode: statusCode,
+			Duration:   d,
+			Err:        err,
+		})
+	}
+}
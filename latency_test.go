@@ -0,0 +1,97 @@
+package findapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_OnSlowRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/slow") {
+			time.Sleep(30 * time.Millisecond)
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var slow, verySlow []RequestInfo
+	client := NewClient("", "",
+		WithBaseURL(server.URL),
+		WithToken("token", time.Now().Add(time.Hour).Unix()),
+		OnSlowRequest(20*time.Millisecond, func(info RequestInfo) { slow = append(slow, info) }),
+		OnSlowRequest(time.Minute, func(info RequestInfo) { verySlow = append(verySlow, info) }),
+	)
+
+	ctx := context.Background()
+	for _, path := range []string{"/flow/v1/account/0x1234", "/flow/v1/account/slow"} {
+		resp, err := client.DoRequest(ctx, http.MethodGet, path, url.Values{"limit": {"5"}})
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	if len(verySlow) != 0 {
+		t.Errorf("Expected no calls above a minute, got %d", len(verySlow))
+	}
+	if len(slow) != 1 {
+		t.Fatalf("Expected 1 slow request, got %d", len(slow))
+	}
+
+	info := slow[0]
+	if info.Endpoint != "/flow/v1/account/{address}" {
+		t.Errorf("Expected endpoint template, got %s", info.Endpoint)
+	}
+	if info.Params["address"] != "slow" {
+		t.Errorf("Expected address param slow, got %v", info.Params)
+	}
+	if info.Query.Get("limit") != "5" || info.Method != http.MethodGet || info.StatusCode != http.StatusOK {
+		t.Errorf("Unexpected request info: %+v", info)
+	}
+	if info.Duration < 30*time.Millisecond {
+		t.Errorf("Expected duration of at least 30ms, got %s", info.Duration)
+	}
+}
+
+func TestClient_WithLatencyHistogram(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/status/v1/stat" {
+			time.Sleep(20 * time.Millisecond)
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("", "", WithBaseURL(server.URL), WithLatencyHistogram(10*time.Millisecond, time.Minute))
+
+	ctx := context.Background()
+	for _, path := range []string{"/public/v1/account/0x1", "/public/v1/account/0x2", "/status/v1/stat"} {
+		resp, err := client.DoRequest(ctx, http.MethodGet, path, nil)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	hists := client.LatencyHistograms()
+	account := hists["GET /public/v1/account/{address}"]
+	if account.Count != 2 || account.Counts[0] != 2 {
+		t.Errorf("Expected 2 fast account requests, got %+v", account)
+	}
+	stat := hists["GET /status/v1/stat"]
+	if stat.Count != 1 || stat.Counts[1] != 1 || stat.Max < 20*time.Millisecond {
+		t.Errorf("Expected 1 slow stat request in the second bucket, got %+v", stat)
+	}
+	if stat.Mean() != stat.Sum {
+		t.Errorf("Expected mean of a single request to equal its duration")
+	}
+
+	if NewClient("", "").LatencyHistograms() != nil {
+		t.Error("Expected nil histograms when not enabled")
+	}
+}
@@ -0,0 +1,363 @@
+// Package market provides token market data (assets, swap pairs, swaps and
+// prices) from the API's DeFi endpoints.
+package market
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Client is an interface for making HTTP requests to the API
+type Client interface {
+	DoRequest(ctx context.Context, method, path string, query url.Values) (*http.Response, error)
+	DoRequestWithBody(ctx context.Context, method, path string, query url.Values, body any) (*http.Response, error)
+	DecodeResponse(resp *http.Response, v any) error
+}
+
+// Service handles operations for the DeFi market endpoints
+type Service struct {
+	client Client
+}
+
+// NewService creates a new market service
+func NewService(client Client) *Service {
+	return &Service{client: client}
+}
+
+// Swap directions accepted by GetLatestSwap
+const (
+	DirectionLeft  = "left"
+	DirectionRight = "right"
+)
+
+// Asset represents a listed token
+type Asset struct {
+	ID                string        `json:"id"`
+	Name              string        `json:"name"`
+	Symbol            string        `json:"symbol"`
+	TotalSupply       string        `json:"totalSupply"`
+	CirculatingSupply string        `json:"circulatingSupply"`
+	CoinGeckoID       string        `json:"coinGeckoId,omitempty"`
+	CoinMarketCapID   string        `json:"coinMarketCapId,omitempty"`
+	Metadata          AssetMetadata `json:"metadata"`
+}
+
+// AssetMetadata holds an asset's tags and social links
+type AssetMetadata struct {
+	Socials map[string]interface{} `json:"socials"`
+	Tags    []string               `json:"tags"`
+}
+
+// Pair represents a swap pair on a DEX
+type Pair struct {
+	ID                      string `json:"id"`
+	Asset0ID                string `json:"asset0Id"`
+	Asset1ID                string `json:"asset1Id"`
+	DexKey                  string `json:"dexKey"`
+	FeeBps                  int    `json:"feeBps"`
+	CreatedAtBlockNumber    uint64 `json:"createdAtBlockNumber"`
+	CreatedAtBlockTimestamp int64  `json:"createdAtBlockTimestamp"`
+	CreatedAtTxnID          string `json:"createdAtTxnId"`
+}
+
+// Swap represents a swap on a pair
+type Swap struct {
+	Asset0ID  string `json:"asset0Id"`
+	Asset1ID  string `json:"asset1Id"`
+	Direction string `json:"direction"`
+	// PriceNative is the price of asset0 in units of asset1
+	PriceNative float64     `json:"priceNative"`
+	SwapType    string      `json:"swapType"`
+	Timestamp   int64       `json:"timestamp"`
+	Amounts     SwapAmounts `json:"amounts"`
+}
+
+// SwapAmounts holds the amounts moved by a swap
+type SwapAmounts struct {
+	Asset0In  float64 `json:"asset0In"`
+	Asset0Out float64 `json:"asset0Out"`
+	Asset1In  float64 `json:"asset1In"`
+	Asset1Out float64 `json:"asset1Out"`
+}
+
+// Block identifies a block in market data
+type Block struct {
+	BlockNumber    uint64 `json:"blockNumber"`
+	BlockTimestamp int64  `json:"blockTimestamp"`
+}
+
+// Event represents a market event, such as a swap or a liquidity change
+type Event struct {
+	EventType   string                 `json:"eventType"`
+	PairID      string                 `json:"pairId"`
+	TxnID       string                 `json:"txnId"`
+	TxnIndex    string                 `json:"txnIndex"`
+	EventIndex  string                 `json:"eventIndex"`
+	Maker       string                 `json:"maker"`
+	Amount0     string                 `json:"amount0"`
+	Amount1     string                 `json:"amount1"`
+	Asset0In    string                 `json:"asset0In"`
+	Asset0Out   string                 `json:"asset0Out"`
+	Asset1In    string                 `json:"asset1In"`
+	Asset1Out   string                 `json:"asset1Out"`
+	PriceNative float64                `json:"priceNative"`
+	Block       Block                  `json:"block"`
+	Reserves    Reserves               `json:"reserves"`
+	Metadata    map[string]interface{} `json:"metadata"`
+}
+
+// Reserves holds a pair's reserves after an event
+type Reserves struct {
+	Asset0 string `json:"asset0"`
+	Asset1 string `json:"asset1"`
+}
+
+// AssetRequestBuilder builds a request to get an asset
+type AssetRequestBuilder struct {
+	service *Service
+	id      string
+}
+
+// GetAsset creates a new asset request builder
+func (s *Service) GetAsset() *AssetRequestBuilder {
+	return &AssetRequestBuilder{service: s}
+}
+
+// Clone returns an independent copy of the asset request builder
+func (b *AssetRequestBuilder) Clone() *AssetRequestBuilder {
+	c := *b
+	return &c
+}
+
+// ID sets the asset ID (required)
+func (b *AssetRequestBuilder) ID(id string) *AssetRequestBuilder {
+	b.id = id
+	return b
+}
+
+// Validate checks the asset request without making a network call
+func (b *AssetRequestBuilder) Validate() error {
+	if b.id == "" {
+		return fmt.Errorf("asset ID is required")
+	}
+	return nil
+}
+
+// Do executes the asset request
+func (b *AssetRequestBuilder) Do(ctx context.Context) (*Asset, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("id", b.id)
+
+	var assets []Asset
+	if err := b.service.get(ctx, "/defi/v1/asset", query, &assets); err != nil {
+		return nil, err
+	}
+	if len(assets) == 0 {
+		return nil, fmt.Errorf("asset %s not found", b.id)
+	}
+
+	return &assets[0], nil
+}
+
+// PairRequestBuilder builds a request to get a swap pair
+type PairRequestBuilder struct {
+	service *Service
+	id      string
+}
+
+// GetPair creates a new pair request builder
+func (s *Service) GetPair() *PairRequestBuilder {
+	return &PairRequestBuilder{service: s}
+}
+
+// Clone returns an independent copy of the pair request builder
+func (b *PairRequestBuilder) Clone() *PairRequestBuilder {
+	c := *b
+	return &c
+}
+
+// ID sets the pair ID (required)
+func (b *PairRequestBuilder) ID(id string) *PairRequestBuilder {
+	b.id = id
+	return b
+}
+
+// Validate checks the pair request without making a network call
+func (b *PairRequestBuilder) Validate() error {
+	if b.id == "" {
+		return fmt.Errorf("pair ID is required")
+	}
+	return nil
+}
+
+// Do executes the pair request
+func (b *PairRequestBuilder) Do(ctx context.Context) (*Pair, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("id", b.id)
+
+	var pair Pair
+	if err := b.service.get(ctx, "/defi/v1/pair", query, &pair); err != nil {
+		return nil, err
+	}
+
+	return &pair, nil
+}
+
+// LatestSwapRequestBuilder builds a request to get the latest swap on a pair
+type LatestSwapRequestBuilder struct {
+	service   *Service
+	pairID    string
+	direction string
+}
+
+// GetLatestSwap creates a new latest swap request builder
+func (s *Service) GetLatestSwap() *LatestSwapRequestBuilder {
+	return &LatestSwapRequestBuilder{service: s}
+}
+
+// Clone returns an independent copy of the latest swap request builder
+func (b *LatestSwapRequestBuilder) Clone() *LatestSwapRequestBuilder {
+	c := *b
+	return &c
+}
+
+// PairID sets the pair ID (required)
+func (b *LatestSwapRequestBuilder) PairID(id string) *LatestSwapRequestBuilder {
+	b.pairID = id
+	return b
+}
+
+// Direction sets the swap direction, DirectionLeft or DirectionRight (required)
+func (b *LatestSwapRequestBuilder) Direction(direction string) *LatestSwapRequestBuilder {
+	b.direction = direction
+	return b
+}
+
+// Validate checks the latest swap request without making a network call
+func (b *LatestSwapRequestBuilder) Validate() error {
+	if b.pairID == "" {
+		return fmt.Errorf("pair ID is required")
+	}
+	if b.direction == "" {
+		return fmt.Errorf("swap direction is required")
+	}
+	return nil
+}
+
+// Do executes the latest swap request
+func (b *LatestSwapRequestBuilder) Do(ctx context.Context) (*Swap, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("id", b.pairID)
+	query.Set("direction", b.direction)
+
+	var swaps []Swap
+	if err := b.service.get(ctx, "/defi/v1/latest-swap", query, &swaps); err != nil {
+		return nil, err
+	}
+	if len(swaps) == 0 {
+		return nil, fmt.Errorf("no %s swaps for pair %s", b.direction, b.pairID)
+	}
+
+	return &swaps[0], nil
+}
+
+// EventsRequestBuilder builds a request to get market events in a block range
+type EventsRequestBuilder struct {
+	service   *Service
+	fromBlock *uint64
+	toBlock   *uint64
+}
+
+// GetEvents creates a new market events request builder
+func (s *Service) GetEvents() *EventsRequestBuilder {
+	return &EventsRequestBuilder{service: s}
+}
+
+// Clone returns an independent copy of the market events request builder
+func (b *EventsRequestBuilder) Clone() *EventsRequestBuilder {
+	c := *b
+	return &c
+}
+
+// FromBlock sets the first block of the range (required)
+func (b *EventsRequestBuilder) FromBlock(block uint64) *EventsRequestBuilder {
+	b.fromBlock = &block
+	return b
+}
+
+// ToBlock sets the last block of the range (required)
+func (b *EventsRequestBuilder) ToBlock(block uint64) *EventsRequestBuilder {
+	b.toBlock = &block
+	return b
+}
+
+// Validate checks the market events request without making a network call
+func (b *EventsRequestBuilder) Validate() error {
+	if b.fromBlock == nil {
+		return fmt.Errorf("from block is required")
+	}
+	if b.toBlock == nil {
+		return fmt.Errorf("to block is required")
+	}
+	if *b.fromBlock > *b.toBlock {
+		return fmt.Errorf("from block %d is after to block %d", *b.fromBlock, *b.toBlock)
+	}
+	return nil
+}
+
+// Do executes the market events request
+func (b *EventsRequestBuilder) Do(ctx context.Context) ([]Event, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("fromBlock", strconv.FormatUint(*b.fromBlock, 10))
+	query.Set("toBlock", strconv.FormatUint(*b.toBlock, 10))
+
+	var events []Event
+	if err := b.service.get(ctx, "/defi/v1/events", query, &events); err != nil {
+		return nil, err
+	}
+	if events == nil {
+		events = []Event{}
+	}
+
+	return events, nil
+}
+
+// GetLatestBlock returns the latest block indexed for market data
+func (s *Service) GetLatestBlock(ctx context.Context) (*Block, error) {
+	var blocks []Block
+	if err := s.get(ctx, "/defi/v1/latest-block", nil, &blocks); err != nil {
+		return nil, err
+	}
+	if len(blocks) == 0 {
+		return nil, fmt.Errorf("no market blocks indexed")
+	}
+	return &blocks[0], nil
+}
+
+// get issues a GET request and decodes the response into v
+func (s *Service) get(ctx context.Context, path string, query url.Values, v any) error {
+	resp, err := s.client.DoRequest(ctx, http.MethodGet, path, query)
+	if err != nil {
+		return err
+	}
+	return s.client.DecodeResponse(resp, v)
+}
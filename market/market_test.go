@@ -0,0 +1,84 @@
+package market
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+// mockClient implements the Client interface for testing
+type mockClient struct {
+	server *httptest.Server
+}
+
+func (m *mockClient) DoRequest(ctx context.Context, method, path string, query url.Values) (*http.Response, error) {
+	return m.DoRequestWithBody(ctx, method, path, query, nil)
+}
+
+func (m *mockClient) DoRequestWithBody(ctx context.Context, method, path string, query url.Values, body any) (*http.Response, error) {
+	u, err := url.Parse(m.server.URL + path)
+	if err != nil {
+		return nil, err
+	}
+	u.RawQuery = query.Encode()
+
+	var bodyReader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		bodyReader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bodyReader)
+	if err != nil {
+		return nil, err
+	}
+	return http.DefaultClient.Do(req)
+}
+
+func (m *mockClient) DecodeResponse(resp *http.Response, v any) error {
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, body)
+	}
+	return json.Unmarshal(body, v)
+}
+
+func TestMarketService_GetAsset(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/defi/v1/asset" {
+			t.Errorf("Expected path /defi/v1/asset, got %s", r.URL.Path)
+		}
+		if id := r.URL.Query().Get("id"); id != "A.1654653399040a61.FlowToken" {
+			t.Errorf("Expected asset id, got %s", id)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id":"A.1654653399040a61.FlowToken","name":"Flow","symbol":"FLOW","totalSupply":"1600000000","metadata":{"tags":["native"]}}]`)
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	asset, err := service.GetAsset().ID("A.1654653399040a61.FlowToken").Do(context.Background())
+	if err != nil {
+		t.Fatalf("GetAsset failed: %v", err)
+	}
+	if asset.Symbol != "FLOW" || asset.TotalSupply != "1600000000" || len(asset.Metadata.Tags) != 1 {
+		t.Errorf("Unexpected asset %+v", asset)
+	}
+
+	if _, err := service.GetAsset().Do(context.Background()); err == nil {
+		t.Error("Expected error without asset ID")
+	}
+}
@@ -0,0 +1,69 @@
+package market
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+)
+
+// swapEventType is the event type of swaps in market events
+const swapEventType = "swap"
+
+// PricePoint is the price of a pair's asset0 in units of asset1 at a point in time
+type PricePoint struct {
+	BlockNumber uint64  `json:"block_number,omitempty"`
+	Timestamp   int64   `json:"timestamp"`
+	Price       float64 `json:"price"`
+}
+
+// Price returns the current price of a pair: the price of the most recent
+// swap in either direction. If neither direction has a swap, the last error
+// is returned.
+func (s *Service) Price(ctx context.Context, pairID string) (*PricePoint, error) {
+	var (
+		latest  *Swap
+		lastErr error
+	)
+	for _, dir := range []string{DirectionLeft, DirectionRight} {
+		swap, err := s.GetLatestSwap().PairID(pairID).Direction(dir).Do(ctx)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if latest == nil || swap.Timestamp > latest.Timestamp {
+			latest = swap
+		}
+	}
+	if latest == nil {
+		return nil, lastErr
+	}
+	return &PricePoint{Timestamp: latest.Timestamp, Price: latest.PriceNative}, nil
+}
+
+// PriceHistory returns the price of a pair after each swap in a block range,
+// in block order
+func (s *Service) PriceHistory(ctx context.Context, pairID string, fromBlock, toBlock uint64) ([]PricePoint, error) {
+	if pairID == "" {
+		return nil, fmt.Errorf("pair ID is required")
+	}
+
+	events, err := s.GetEvents().FromBlock(fromBlock).ToBlock(toBlock).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	points := []PricePoint{}
+	for _, e := range events {
+		if e.PairID != pairID || e.EventType != swapEventType || e.PriceNative == 0 {
+			continue
+		}
+		points = append(points, PricePoint{
+			BlockNumber: e.Block.BlockNumber,
+			Timestamp:   e.Block.BlockTimestamp,
+			Price:       e.PriceNative,
+		})
+	}
+	slices.SortStableFunc(points, func(a, b PricePoint) int { return cmp.Compare(a.BlockNumber, b.BlockNumber) })
+	return points, nil
+}
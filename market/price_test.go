@@ -0,0 +1,78 @@
+package market
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMarketService_Price(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/defi/v1/latest-swap" {
+			t.Errorf("Expected path /defi/v1/latest-swap, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("direction") {
+		case DirectionLeft:
+			fmt.Fprint(w, `[{"direction":"left","priceNative":0.5,"timestamp":100}]`)
+		case DirectionRight:
+			fmt.Fprint(w, `[{"direction":"right","priceNative":0.6,"timestamp":200}]`)
+		}
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	price, err := service.Price(context.Background(), "pair-1")
+	if err != nil {
+		t.Fatalf("Price failed: %v", err)
+	}
+	if price.Price != 0.6 || price.Timestamp != 200 {
+		t.Errorf("Expected the most recent swap price, got %+v", price)
+	}
+}
+
+func TestMarketService_PriceError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	if _, err := service.Price(context.Background(), "pair-1"); err == nil {
+		t.Error("Expected error when no swaps can be fetched")
+	}
+}
+
+func TestMarketService_PriceHistory(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/defi/v1/events" {
+			t.Errorf("Expected path /defi/v1/events, got %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("fromBlock") != "10" || q.Get("toBlock") != "20" {
+			t.Errorf("Expected blocks 10-20, got %s-%s", q.Get("fromBlock"), q.Get("toBlock"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{"eventType":"swap","pairId":"pair-1","priceNative":0.7,"block":{"blockNumber":15,"blockTimestamp":1500}},
+			{"eventType":"join","pairId":"pair-1","priceNative":0.9,"block":{"blockNumber":12}},
+			{"eventType":"swap","pairId":"pair-2","priceNative":3,"block":{"blockNumber":11}},
+			{"eventType":"swap","pairId":"pair-1","priceNative":0.65,"block":{"blockNumber":11,"blockTimestamp":1100}}
+		]`)
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	points, err := service.PriceHistory(context.Background(), "pair-1", 10, 20)
+	if err != nil {
+		t.Fatalf("PriceHistory failed: %v", err)
+	}
+	if len(points) != 2 {
+		t.Fatalf("Expected 2 price points, got %d", len(points))
+	}
+	if points[0].BlockNumber != 11 || points[0].Price != 0.65 || points[1].Price != 0.7 {
+		t.Errorf("Unexpected price points %+v", points)
+	}
+}
@@ -0,0 +1,98 @@
+package findapi
+
+import "fmt"
+
+// Chain IDs of the Flow networks
+const (
+	MainnetChainID  = "flow-mainnet"
+	TestnetChainID  = "flow-testnet"
+	EmulatorChainID = "flow-emulator"
+)
+
+// Block heights of the Flow networks
+const (
+	// MainnetGenesisHeight is the root height of Mainnet 1, the first mainnet spork.
+	// No block data exists below it.
+	MainnetGenesisHeight uint64 = 7601063
+	// MainnetSporkRootHeight is the root height of the current mainnet spork (Mainnet 26)
+	MainnetSporkRootHeight uint64 = 88226267
+
+	// TestnetSporkRootHeight is the root height of the current testnet spork (Testnet 52)
+	TestnetSporkRootHeight uint64 = 211176670
+)
+
+// Network describes a Flow network and the block heights data is available from
+type Network struct {
+	Name    string
+	ChainID string
+	// GenesisHeight is the lowest height with indexed data
+	GenesisHeight uint64
+	// SporkRootHeight is the root height of the current spork. Heights below it
+	// belong to earlier sporks.
+	SporkRootHeight uint64
+	// Spork names the current spork
+	Spork string
+	// BaseURL is the API endpoint serving the network
+	BaseURL string
+}
+
+var (
+	// Mainnet is the Flow mainnet
+	Mainnet = Network{
+		Name:            "mainnet",
+		ChainID:         MainnetChainID,
+		GenesisHeight:   MainnetGenesisHeight,
+		SporkRootHeight: MainnetSporkRootHeight,
+		Spork:           "mainnet26",
+		BaseURL:         FindApiURL,
+	}
+
+	// Testnet is the Flow testnet. Only the current spork is indexed.
+	Testnet = Network{
+		Name:            "testnet",
+		ChainID:         TestnetChainID,
+		GenesisHeight:   TestnetSporkRootHeight,
+		SporkRootHeight: TestnetSporkRootHeight,
+		Spork:           "testnet52",
+		BaseURL:         TestnetFindApiURL,
+	}
+)
+
+// HeightError is returned when a requested height predates a network's indexed data
+type HeightError struct {
+	Network  string
+	Height   uint64
+	Earliest uint64
+}
+
+func (e *HeightError) Error() string {
+	return fmt.Sprintf("height %d predates %s data, which starts at height %d", e.Height, e.Network, e.Earliest)
+}
+
+// IsHeightError checks if an error is a height error
+func IsHeightError(err error) bool {
+	_, ok := err.(*HeightError)
+	return ok
+}
+
+// ValidateHeight returns a HeightError if h is below the network's genesis
+// height, where queries would silently return empty results
+func (n Network) ValidateHeight(h uint64) error {
+	if h < n.GenesisHeight {
+		return &HeightError{Network: n.Name, Height: h, Earliest: n.GenesisHeight}
+	}
+	return nil
+}
+
+// ValidateRange validates both ends of a height range and their order
+func (n Network) ValidateRange(from, to uint64) error {
+	if to < from {
+		return fmt.Errorf("end height %d is before start height %d", to, from)
+	}
+	return n.ValidateHeight(from)
+}
+
+// InCurrentSpork reports whether h is at or above the current spork's root height
+func (n Network) InCurrentSpork(h uint64) bool {
+	return h >= n.SporkRootHeight
+}
@@ -0,0 +1,79 @@
+package findapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestNetwork_ValidateHeight(t *testing.T) {
+	if err := Mainnet.ValidateHeight(MainnetGenesisHeight); err != nil {
+		t.Errorf("Expected genesis height to be valid, got %v", err)
+	}
+
+	err := Mainnet.ValidateHeight(100)
+	if !IsHeightError(err) {
+		t.Fatalf("Expected HeightError, got %v", err)
+	}
+	if he := err.(*HeightError); he.Earliest != MainnetGenesisHeight || he.Network != "mainnet" {
+		t.Errorf("Unexpected height error: %+v", he)
+	}
+
+	if err := Testnet.ValidateHeight(MainnetSporkRootHeight); err == nil {
+		t.Error("Expected testnet height before its spork root to be rejected")
+	}
+}
+
+func TestNetwork_ValidateRange(t *testing.T) {
+	if err := Mainnet.ValidateRange(MainnetSporkRootHeight, MainnetSporkRootHeight+10); err != nil {
+		t.Errorf("Expected valid range, got %v", err)
+	}
+	if err := Mainnet.ValidateRange(MainnetSporkRootHeight+10, MainnetSporkRootHeight); err == nil || IsHeightError(err) {
+		t.Errorf("Expected order error, got %v", err)
+	}
+	if err := Mainnet.ValidateRange(1, MainnetSporkRootHeight); !IsHeightError(err) {
+		t.Errorf("Expected HeightError, got %v", err)
+	}
+}
+
+func TestNetwork_InCurrentSpork(t *testing.T) {
+	if !Mainnet.InCurrentSpork(MainnetSporkRootHeight) {
+		t.Error("Expected spork root height to be in the current spork")
+	}
+	if Mainnet.InCurrentSpork(MainnetSporkRootHeight - 1) {
+		t.Error("Expected height below spork root to be in an earlier spork")
+	}
+}
+
+func TestClient_WithNetwork(t *testing.T) {
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Write([]byte(`{"blocks":[]}`))
+	}))
+	defer server.Close()
+
+	if got := NewClient("", "").Network(); got.Name != "mainnet" {
+		t.Errorf("Expected mainnet by default, got %s", got.Name)
+	}
+
+	client := NewClient("", "", WithNetwork(Testnet))
+	if client.Network().ChainID != TestnetChainID || client.baseURL != TestnetFindApiURL {
+		t.Errorf("Expected testnet base URL, got %s (%s)", client.baseURL, client.Network().ChainID)
+	}
+
+	client = NewClient("", "", WithNetwork(Testnet), WithBaseURL(server.URL), WithToken("token", time.Now().Add(time.Hour).Unix()))
+	resp, err := client.DoRequest(context.Background(), http.MethodGet, "/simple/v1/blocks", nil)
+	if err != nil {
+		t.Fatalf("DoRequest failed: %v", err)
+	}
+	resp.Body.Close()
+	if gotPath != "/simple/v1/blocks" {
+		t.Errorf("Expected request to the overridden base URL, got %q", gotPath)
+	}
+	if got := client.Network().Name; got != "testnet" {
+		t.Errorf("Expected testnet to be kept with an overridden base URL, got %q", got)
+	}
+}
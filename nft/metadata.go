@@ -0,0 +1,275 @@
+// Package nft normalises NFT metadata into typed structs.
+//
+// The API returns an NFT's metadata as a raw map resolved from its
+// MetadataViews. Views may appear nested under their view names (display,
+// traits, royalties, ...) or flattened onto the top level, with camelCase or
+// snake_case keys. ParseMetadata accepts all of these shapes.
+package nft
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Metadata is the typed form of an NFT's MetadataViews
+type Metadata struct {
+	Name        string    `json:"name,omitempty"`
+	Description string    `json:"description,omitempty"`
+	Thumbnail   string    `json:"thumbnail,omitempty"`
+	ExternalURL string    `json:"external_url,omitempty"`
+	Serial      *uint64   `json:"serial,omitempty"`
+	Editions    []Edition `json:"editions,omitempty"`
+	Royalties   []Royalty `json:"royalties,omitempty"`
+	Traits      []Trait   `json:"traits,omitempty"`
+
+	// Raw is the metadata as returned by the API
+	Raw map[string]any `json:"-"`
+}
+
+// Edition is an entry of the Editions view
+type Edition struct {
+	Name   string  `json:"name,omitempty"`
+	Number uint64  `json:"number"`
+	Max    *uint64 `json:"max,omitempty"`
+}
+
+// Royalty is a cut of the Royalties view
+type Royalty struct {
+	Receiver    string  `json:"receiver"`
+	Cut         float64 `json:"cut"`
+	Description string  `json:"description,omitempty"`
+}
+
+// Trait is an entry of the Traits view
+type Trait struct {
+	Name        string  `json:"name"`
+	Value       any     `json:"value"`
+	DisplayType string  `json:"display_type,omitempty"`
+	Rarity      *Rarity `json:"rarity,omitempty"`
+}
+
+// Rarity is the optional rarity of a trait
+type Rarity struct {
+	Score       *float64 `json:"score,omitempty"`
+	Max         *float64 `json:"max,omitempty"`
+	Description string   `json:"description,omitempty"`
+}
+
+// ValueString returns the trait value formatted as a string
+func (t Trait) ValueString() string {
+	switch v := t.Value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Trait returns the trait with the given name, compared case-insensitively
+func (m *Metadata) Trait(name string) (Trait, bool) {
+	for _, t := range m.Traits {
+		if strings.EqualFold(t.Name, name) {
+			return t, true
+		}
+	}
+	return Trait{}, false
+}
+
+// ParseMetadata extracts the standard MetadataViews fields from raw metadata.
+// Missing or malformed fields are left empty; it never fails.
+func ParseMetadata(raw map[string]any) Metadata {
+	m := Metadata{Raw: raw}
+	if raw == nil {
+		return m
+	}
+
+	display := object(lookup(raw, "display"))
+	if display == nil {
+		display = raw
+	}
+	m.Name = str(lookup(display, "name"))
+	m.Description = str(lookup(display, "description"))
+	m.Thumbnail = fileURL(lookup(display, "thumbnail"))
+	if m.Thumbnail == "" {
+		m.Thumbnail = fileURL(lookup(raw, "thumbnail"))
+	}
+
+	m.ExternalURL = fileURL(lookup(raw, "externalURL", "external_url"))
+	m.Serial = uintPtr(unwrap(lookup(raw, "serial"), "number"))
+	m.Editions = parseEditions(unwrap(lookup(raw, "editions"), "infoList", "info_list"))
+	m.Royalties = parseRoyalties(unwrap(lookup(raw, "royalties"), "cutInfos", "cut_infos"))
+	m.Traits = parseTraits(unwrap(lookup(raw, "traits"), "traits"))
+
+	return m
+}
+
+func parseEditions(v any) []Edition {
+	var editions []Edition
+	for _, item := range list(v) {
+		e := object(item)
+		n := uintPtr(lookup(e, "number"))
+		if n == nil {
+			continue
+		}
+		editions = append(editions, Edition{
+			Name:   str(lookup(e, "name")),
+			Number: *n,
+			Max:    uintPtr(lookup(e, "max")),
+		})
+	}
+	return editions
+}
+
+func parseRoyalties(v any) []Royalty {
+	var royalties []Royalty
+	for _, item := range list(v) {
+		r := object(item)
+		if r == nil {
+			continue
+		}
+		cut, _ := number(lookup(r, "cut"))
+		royalties = append(royalties, Royalty{
+			Receiver:    str(unwrap(lookup(r, "receiver"), "address")),
+			Cut:         cut,
+			Description: str(lookup(r, "description")),
+		})
+	}
+	return royalties
+}
+
+func parseTraits(v any) []Trait {
+	// Traits may also be a plain name -> value map
+	if obj := object(v); obj != nil {
+		traits := make([]Trait, 0, len(obj))
+		for name, value := range obj {
+			traits = append(traits, Trait{Name: name, Value: value})
+		}
+		sort.Slice(traits, func(i, j int) bool { return traits[i].Name < traits[j].Name })
+		return traits
+	}
+
+	var traits []Trait
+	for _, item := range list(v) {
+		t := object(item)
+		name := str(lookup(t, "name"))
+		if name == "" {
+			continue
+		}
+		trait := Trait{
+			Name:        name,
+			Value:       lookup(t, "value"),
+			DisplayType: str(lookup(t, "displayType", "display_type")),
+		}
+		if r := object(lookup(t, "rarity")); r != nil {
+			trait.Rarity = &Rarity{
+				Score:       floatPtr(lookup(r, "score")),
+				Max:         floatPtr(lookup(r, "max")),
+				Description: str(lookup(r, "description")),
+			}
+		}
+		traits = append(traits, trait)
+	}
+	return traits
+}
+
+// lookup returns the first of keys present in m
+func lookup(m map[string]any, keys ...string) any {
+	for _, k := range keys {
+		if v, ok := m[k]; ok && v != nil {
+			return v
+		}
+	}
+	return nil
+}
+
+// unwrap returns the field of a view object if v is an object holding one of
+// keys, or v itself otherwise
+func unwrap(v any, keys ...string) any {
+	if obj := object(v); obj != nil {
+		if inner := lookup(obj, keys...); inner != nil {
+			return inner
+		}
+	}
+	return v
+}
+
+func object(v any) map[string]any {
+	m, _ := v.(map[string]any)
+	return m
+}
+
+func list(v any) []any {
+	l, _ := v.([]any)
+	return l
+}
+
+func str(v any) string {
+	switch s := v.(type) {
+	case string:
+		return s
+	case float64:
+		return strconv.FormatFloat(s, 'f', -1, 64)
+	}
+	return ""
+}
+
+// fileURL resolves an HTTPFile ({url}), IPFSFile ({cid, path}) or plain string
+func fileURL(v any) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	obj := object(v)
+	if obj == nil {
+		return ""
+	}
+	if u := str(lookup(obj, "url")); u != "" {
+		return u
+	}
+	if cid := str(lookup(obj, "cid")); cid != "" {
+		if path := str(lookup(obj, "path")); path != "" {
+			return "ipfs://" + cid + "/" + strings.TrimPrefix(path, "/")
+		}
+		return "ipfs://" + cid
+	}
+	return ""
+}
+
+// number converts a JSON number or numeric string to a float64
+func number(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case string:
+		f, err := strconv.ParseFloat(n, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func floatPtr(v any) *float64 {
+	if f, ok := number(v); ok {
+		return &f
+	}
+	return nil
+}
+
+func uintPtr(v any) *uint64 {
+	switch n := v.(type) {
+	case float64:
+		if n >= 0 {
+			u := uint64(n)
+			return &u
+		}
+	case string:
+		if u, err := strconv.ParseUint(n, 10, 64); err == nil {
+			return &u
+		}
+	}
+	return nil
+}
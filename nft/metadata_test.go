@@ -0,0 +1,95 @@
+package nft
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func decode(t *testing.T, s string) map[string]any {
+	t.Helper()
+	var m map[string]any
+	if err := json.Unmarshal([]byte(s), &m); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	return m
+}
+
+func TestParseMetadata_Views(t *testing.T) {
+	m := ParseMetadata(decode(t, `{
+		"display": {"name": "Moment #12", "description": "A dunk", "thumbnail": {"cid": "bafy", "path": "/img.png"}},
+		"externalURL": {"url": "https://example.com/12"},
+		"serial": {"number": 12},
+		"editions": {"infoList": [{"name": "Series 1", "number": 12, "max": 5000}]},
+		"royalties": {"cutInfos": [{"receiver": {"address": "0xf8d6e0586b0a20c7"}, "cut": "0.05", "description": "creator"}]},
+		"traits": {"traits": [
+			{"name": "Background", "value": "Blue", "displayType": "String", "rarity": {"score": 10, "max": 100, "description": "rare"}},
+			{"name": "Level", "value": 3, "displayType": "Number"}
+		]}
+	}`))
+
+	if m.Name != "Moment #12" || m.Description != "A dunk" {
+		t.Errorf("Unexpected display: %q %q", m.Name, m.Description)
+	}
+	if m.Thumbnail != "ipfs://bafy/img.png" {
+		t.Errorf("Expected ipfs thumbnail, got %s", m.Thumbnail)
+	}
+	if m.ExternalURL != "https://example.com/12" {
+		t.Errorf("Expected external URL, got %s", m.ExternalURL)
+	}
+	if m.Serial == nil || *m.Serial != 12 {
+		t.Errorf("Expected serial 12, got %v", m.Serial)
+	}
+	if len(m.Editions) != 1 || m.Editions[0].Number != 12 || m.Editions[0].Max == nil || *m.Editions[0].Max != 5000 {
+		t.Errorf("Unexpected editions: %+v", m.Editions)
+	}
+	if len(m.Royalties) != 1 || m.Royalties[0].Receiver != "0xf8d6e0586b0a20c7" || m.Royalties[0].Cut != 0.05 {
+		t.Errorf("Unexpected royalties: %+v", m.Royalties)
+	}
+
+	if len(m.Traits) != 2 {
+		t.Fatalf("Expected 2 traits, got %+v", m.Traits)
+	}
+	bg, ok := m.Trait("background")
+	if !ok || bg.ValueString() != "Blue" || bg.Rarity == nil || *bg.Rarity.Score != 10 {
+		t.Errorf("Unexpected background trait: %+v", bg)
+	}
+	level, _ := m.Trait("Level")
+	if level.ValueString() != "3" || level.DisplayType != "Number" {
+		t.Errorf("Unexpected level trait: %+v", level)
+	}
+	if _, ok := m.Trait("Missing"); ok {
+		t.Error("Expected missing trait to not be found")
+	}
+}
+
+func TestParseMetadata_Flat(t *testing.T) {
+	m := ParseMetadata(decode(t, `{
+		"name": "Flovatar #1",
+		"thumbnail": "https://example.com/1.svg",
+		"external_url": "https://example.com/1",
+		"serial": "1",
+		"traits": {"eyes": "green", "mouth": "smile"}
+	}`))
+
+	if m.Name != "Flovatar #1" || m.Thumbnail != "https://example.com/1.svg" || m.ExternalURL != "https://example.com/1" {
+		t.Errorf("Unexpected flat fields: %+v", m)
+	}
+	if m.Serial == nil || *m.Serial != 1 {
+		t.Errorf("Expected serial 1, got %v", m.Serial)
+	}
+	if len(m.Traits) != 2 || m.Traits[0].Name != "eyes" || m.Traits[1].ValueString() != "smile" {
+		t.Errorf("Unexpected traits: %+v", m.Traits)
+	}
+}
+
+func TestParseMetadata_Empty(t *testing.T) {
+	m := ParseMetadata(nil)
+	if m.Name != "" || m.Traits != nil {
+		t.Errorf("Expected empty metadata, got %+v", m)
+	}
+
+	m = ParseMetadata(map[string]any{"traits": "garbage", "royalties": 5})
+	if m.Traits != nil || m.Royalties != nil {
+		t.Errorf("Expected malformed views to be ignored, got %+v", m)
+	}
+}
@@ -0,0 +1,96 @@
+package findapi
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimitStatus is the rate limit quota reported by the API's most recent
+// response
+type RateLimitStatus struct {
+	// Limit is the number of requests allowed per window
+	Limit int
+	// Remaining is the number of requests left in the current window
+	Remaining int
+	// Reset is when the current window ends, zero if not reported
+	Reset time.Time
+	// UpdatedAt is when the response carrying these values arrived
+	UpdatedAt time.Time
+}
+
+// WithRateLimitCallback calls fn with the rate limit status of every response
+// that reports one, so schedulers can pace themselves before hitting 429s
+func WithRateLimitCallback(fn func(RateLimitStatus)) ClientOption {
+	return func(c *Client) {
+		c.rateLimits.onUpdate = fn
+	}
+}
+
+// RateLimitStatus returns the rate limit status reported by the most recent
+// response that carried rate limit headers, and false if none has yet
+func (c *Client) RateLimitStatus() (RateLimitStatus, bool) {
+	return c.rateLimits.get()
+}
+
+// rateLimitTracker holds the latest rate limit status seen by a client
+type rateLimitTracker struct {
+	mu       sync.Mutex
+	status   RateLimitStatus
+	seen     bool
+	onUpdate func(RateLimitStatus)
+}
+
+func (t *rateLimitTracker) get() (RateLimitStatus, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.status, t.seen
+}
+
+// observe records the rate limit headers of a response, if it has any
+func (t *rateLimitTracker) observe(resp *http.Response) {
+	status, ok := parseRateLimitHeaders(resp.Header, time.Now())
+	if !ok {
+		return
+	}
+	t.mu.Lock()
+	t.status = status
+	t.seen = true
+	t.mu.Unlock()
+
+	if t.onUpdate != nil {
+		t.onUpdate(status)
+	}
+}
+
+// parseRateLimitHeaders reads X-RateLimit-Limit/Remaining/Reset or their
+// unprefixed RateLimit-* equivalents. Reset may be a Unix timestamp or a
+// number of seconds from now.
+func parseRateLimitHeaders(h http.Header, now time.Time) (RateLimitStatus, bool) {
+	get := func(name string) (int64, bool) {
+		v := h.Get("X-RateLimit-" + name)
+		if v == "" {
+			v = h.Get("RateLimit-" + name)
+		}
+		n, err := strconv.ParseInt(v, 10, 64)
+		return n, err == nil
+	}
+
+	limit, hasLimit := get("Limit")
+	remaining, hasRemaining := get("Remaining")
+	if !hasLimit && !hasRemaining {
+		return RateLimitStatus{}, false
+	}
+
+	status := RateLimitStatus{Limit: int(limit), Remaining: int(remaining), UpdatedAt: now}
+	if reset, ok := get("Reset"); ok {
+		// Values this large are timestamps rather than delays
+		if reset > 1_000_000_000 {
+			status.Reset = time.Unix(reset, 0)
+		} else {
+			status.Reset = now.Add(time.Duration(reset) * time.Second)
+		}
+	}
+	return status, true
+}
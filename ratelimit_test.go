@@ -0,0 +1,60 @@
+package findapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestClient_RateLimitStatus(t *testing.T) {
+	reset := time.Now().Add(time.Minute).Unix()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset, 10))
+		w.Write([]byte(`{"blocks":[]}`))
+	}))
+	defer server.Close()
+
+	var updates []RateLimitStatus
+	client := NewClient("", "", WithBaseURL(server.URL), WithToken("token", time.Now().Add(time.Hour).Unix()),
+		WithRateLimitCallback(func(s RateLimitStatus) { updates = append(updates, s) }))
+
+	if _, ok := client.RateLimitStatus(); ok {
+		t.Error("Expected no rate limit status before the first request")
+	}
+	if _, err := client.Simple.GetBlocks().Height(1).Do(context.Background()); err != nil {
+		t.Fatalf("GetBlocks failed: %v", err)
+	}
+
+	status, ok := client.RateLimitStatus()
+	if !ok {
+		t.Fatal("Expected rate limit status after a request")
+	}
+	if status.Limit != 100 || status.Remaining != 42 || status.Reset.Unix() != reset {
+		t.Errorf("Expected 42/100 resetting at %d, got %+v", reset, status)
+	}
+	if len(updates) != 1 || updates[0] != status {
+		t.Errorf("Expected one callback with the status, got %v", updates)
+	}
+}
+
+func TestParseRateLimitHeaders(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	h := http.Header{}
+	h.Set("RateLimit-Limit", "10")
+	h.Set("RateLimit-Remaining", "0")
+	h.Set("RateLimit-Reset", "30")
+	status, ok := parseRateLimitHeaders(h, now)
+	if !ok || status.Limit != 10 || status.Remaining != 0 || !status.Reset.Equal(now.Add(30*time.Second)) {
+		t.Errorf("Expected 0/10 resetting in 30s, got %+v", status)
+	}
+
+	if _, ok := parseRateLimitHeaders(http.Header{}, now); ok {
+		t.Error("Expected no status without rate limit headers")
+	}
+}
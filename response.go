@@ -0,0 +1,42 @@
+package findapi
+
+import "reflect"
+
+// IsEmpty reports whether a response returned by a Do method contains no records.
+// It inspects the response's Data slice (or, for simple API responses, its single
+// result slice such as Blocks or Events). A nil response is empty.
+//
+// Do methods always return non-nil result slices, so ranging over or re-encoding
+// an empty response is safe; IsEmpty is a convenience for the length check.
+func IsEmpty(resp any) bool {
+	v := reflect.ValueOf(resp)
+	if !v.IsValid() {
+		return true
+	}
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return true
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Slice:
+		return v.Len() == 0
+	case reflect.Struct:
+	default:
+		return false
+	}
+
+	if data := v.FieldByName("Data"); data.IsValid() && data.Kind() == reflect.Slice {
+		return data.Len() == 0
+	}
+
+	// Simple API responses hold their records in a single differently named slice
+	for i := 0; i < v.NumField(); i++ {
+		if f := v.Field(i); f.Kind() == reflect.Slice {
+			return f.Len() == 0
+		}
+	}
+	return false
+}
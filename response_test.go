@@ -0,0 +1,78 @@
+package findapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/peterargue/find-api/flow"
+	"github.com/peterargue/find-api/simple"
+)
+
+func TestIsEmpty(t *testing.T) {
+	tests := []struct {
+		name string
+		resp any
+		want bool
+	}{
+		{"nil", nil, true},
+		{"nil pointer", (*flow.BlockResponse)(nil), true},
+		{"nil data", &flow.BlockResponse{}, true},
+		{"empty data", &flow.BlockResponse{Data: []flow.Block{}}, true},
+		{"data", &flow.BlockResponse{Data: []flow.Block{{Height: 1}}}, false},
+		{"simple empty", &simple.EventsResponse{}, true},
+		{"simple events", &simple.EventsResponse{Events: []simple.Event{{Name: "A"}}}, false},
+		{"slice", []int{}, true},
+		{"non-response", 42, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsEmpty(tt.resp); got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClient_EmptyDataIsNonNil(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if serveTestToken(w, r) {
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/simple/v1/events" {
+			json.NewEncoder(w).Encode(map[string]any{"events": nil})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{"data": nil})
+	}))
+	defer server.Close()
+
+	client := NewClient("user", "pass", WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	blocks, err := client.Flow.GetBlocks().Do(ctx)
+	if err != nil {
+		t.Fatalf("GetBlocks failed: %v", err)
+	}
+	if blocks.Data == nil {
+		t.Error("Expected non-nil Data slice")
+	}
+	if b, _ := json.Marshal(blocks.Data); string(b) != "[]" {
+		t.Errorf("Expected empty data to encode as [], got %s", b)
+	}
+
+	events, err := client.Simple.GetEvents().Name("A.1.Foo.Bar").FromHeight(1).ToHeight(2).Do(ctx)
+	if err != nil {
+		t.Fatalf("GetEvents failed: %v", err)
+	}
+	if events.Events == nil {
+		t.Error("Expected non-nil Events slice")
+	}
+	if !IsEmpty(events) {
+		t.Error("Expected IsEmpty to be true")
+	}
+}
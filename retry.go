@@ -0,0 +1,113 @@
+package findapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"slices"
+	"syscall"
+	"time"
+)
+
+const (
+	// retryBaseDelay is the initial backoff delay between transient retries
+	retryBaseDelay = 250 * time.Millisecond
+	// retryMaxDelay caps the backoff delay between transient retries
+	retryMaxDelay = 5 * time.Second
+	// defaultMaxRetries is the number of retries after the first attempt
+	defaultMaxRetries = 2
+)
+
+// defaultRetryStatusCodes are the gateway errors retried for idempotent requests
+var defaultRetryStatusCodes = []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}
+
+// WithMaxRetries sets how many times a request is retried after the first
+// attempt when it is rate limited or, for idempotent requests, fails
+// transiently (default 2). Zero disables retries.
+func WithMaxRetries(n int) ClientOption {
+	return func(c *Client) {
+		c.maxRetries = max(n, 0)
+	}
+}
+
+// WithRetryStatusCodes replaces the response status codes that idempotent
+// requests are retried on (default 502, 503 and 504). Rate limit responses
+// (429) are always retried according to Retry-After.
+func WithRetryStatusCodes(codes ...int) ClientOption {
+	return func(c *Client) {
+		c.retryStatusCodes = slices.Clone(codes)
+	}
+}
+
+// WithMaxRateLimitWait caps the total time a request spends waiting out 429
+// responses across its retries. When the next Retry-After would exceed the
+// remaining budget the request fails with a RateLimitError instead of
+// sleeping, so batch jobs fail predictably.
+func WithMaxRateLimitWait(d time.Duration) ClientOption {
+	return func(c *Client) {
+		c.maxRateLimitWait = d
+	}
+}
+
+// canWaitForRateLimit reports whether waiting retryAfter more keeps a request
+// within the rate limit budget and before its context deadline
+func (c *Client) canWaitForRateLimit(ctx context.Context, waited, retryAfter time.Duration) bool {
+	if c.maxRateLimitWait > 0 && waited+retryAfter > c.maxRateLimitWait {
+		return false
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < retryAfter {
+		return false
+	}
+	return true
+}
+
+// isIdempotent reports whether requests with the given method are safe to retry
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// isRetryStatus reports whether the status code is configured as a temporary failure worth retrying
+func (c *Client) isRetryStatus(code int) bool {
+	return slices.Contains(c.retryStatusCodes, code)
+}
+
+// isTransientError reports whether a transport error is likely to succeed on retry
+func isTransientError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return false
+}
+
+// backoffDelay returns the jittered exponential backoff delay for the given attempt (0-based)
+func backoffDelay(attempt int) time.Duration {
+	return DefaultBackoff().Delay(attempt)
+}
+
+// sleepContext waits for the given duration or until the context is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
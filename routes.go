@@ -0,0 +1,170 @@
+package findapi
+
+import (
+	"net/http"
+	"strings"
+)
+
+// authRequirement describes how requests to a route are authenticated
+type authRequirement int
+
+const (
+	// authBearer routes require a JWT bearer token
+	authBearer authRequirement = iota
+	// authNone routes are public and are sent without credentials
+	authNone
+	// authBasic routes authenticate with username and password
+	authBasic
+)
+
+// route is an entry in the endpoint registry
+type route struct {
+	method   string
+	template string
+	auth     authRequirement
+}
+
+// routes is the registry of known API endpoints. Templates use {name} for
+// path parameters. Requests to paths not listed here require a bearer token.
+var routes = []route{
+	// Auth
+	{http.MethodPost, "/auth/v1/generate", authBasic},
+
+	// Public
+	{http.MethodGet, "/public/v1/account/{address}", authNone},
+	{http.MethodGet, "/public/v1/epoch/payout", authNone},
+	{http.MethodGet, "/public/v1/resolver", authNone},
+	{http.MethodGet, "/status/v1/count", authNone},
+	{http.MethodGet, "/status/v1/epoch/stat", authNone},
+	{http.MethodGet, "/status/v1/epoch/status", authNone},
+	{http.MethodGet, "/status/v1/flow/stat", authNone},
+	{http.MethodGet, "/status/v1/stat", authNone},
+	{http.MethodGet, "/status/v1/stat/trend", authNone},
+	{http.MethodGet, "/status/v1/tokenomics", authNone},
+
+	// Simple
+	{http.MethodGet, "/simple/v1/blocks", authBearer},
+	{http.MethodGet, "/simple/v1/events", authBearer},
+	{http.MethodGet, "/simple/v1/transaction", authBearer},
+	{http.MethodGet, "/simple/v1/transaction/events", authBearer},
+
+	// Flow
+	{http.MethodGet, "/flow/v1/account", authBearer},
+	{http.MethodGet, "/flow/v1/account/{address}", authBearer},
+	{http.MethodGet, "/flow/v1/account/{address}/ft", authBearer},
+	{http.MethodGet, "/flow/v1/account/{address}/ft/holding", authBearer},
+	{http.MethodGet, "/flow/v1/account/{address}/ft/transfer", authBearer},
+	{http.MethodGet, "/flow/v1/account/{address}/ft/{token}", authBearer},
+	{http.MethodGet, "/flow/v1/account/{address}/ft/{token}/transfer", authBearer},
+	{http.MethodGet, "/flow/v1/account/{address}/nft", authBearer},
+	{http.MethodGet, "/flow/v1/account/{address}/nft/{nft_type}", authBearer},
+	{http.MethodGet, "/flow/v1/account/{address}/tax-report", authBearer},
+	{http.MethodGet, "/flow/v1/account/{address}/transaction", authBearer},
+	{http.MethodGet, "/flow/v1/block", authBearer},
+	{http.MethodGet, "/flow/v1/block/{height}", authBearer},
+	{http.MethodGet, "/flow/v1/block/{height}/service-event", authBearer},
+	{http.MethodGet, "/flow/v1/block/{height}/transaction", authBearer},
+	{http.MethodGet, "/flow/v1/contract", authBearer},
+	{http.MethodGet, "/flow/v1/contract/{identifier}", authBearer},
+	{http.MethodGet, "/flow/v1/contract/{identifier}/{id}", authBearer},
+	{http.MethodGet, "/flow/v1/evm/token", authBearer},
+	{http.MethodGet, "/flow/v1/evm/token/{address}", authBearer},
+	{http.MethodGet, "/flow/v1/evm/transaction", authBearer},
+	{http.MethodGet, "/flow/v1/evm/transaction/{hash}", authBearer},
+	{http.MethodGet, "/flow/v1/ft", authBearer},
+	{http.MethodGet, "/flow/v1/ft/transfer", authBearer},
+	{http.MethodGet, "/flow/v1/ft/{token}", authBearer},
+	{http.MethodGet, "/flow/v1/ft/{token}/account/{address}", authBearer},
+	{http.MethodGet, "/flow/v1/ft/{token}/holding", authBearer},
+	{http.MethodGet, "/flow/v1/nft", authBearer},
+	{http.MethodGet, "/flow/v1/nft/transfer", authBearer},
+	{http.MethodGet, "/flow/v1/nft/{nft_type}", authBearer},
+	{http.MethodGet, "/flow/v1/nft/{nft_type}/holding", authBearer},
+	{http.MethodGet, "/flow/v1/nft/{nft_type}/item/{id}", authBearer},
+	{http.MethodGet, "/flow/v1/node", authBearer},
+	{http.MethodGet, "/flow/v1/node/{node_id}", authBearer},
+	{http.MethodGet, "/flow/v1/node/{node_id}/reward/delegation", authBearer},
+	{http.MethodGet, "/flow/v1/scheduled-transaction", authBearer},
+	{http.MethodGet, "/flow/v1/transaction", authBearer},
+	{http.MethodGet, "/flow/v1/transaction/{id}", authBearer},
+
+	// DeFi
+	{http.MethodGet, "/defi/v1/asset", authBearer},
+	{http.MethodGet, "/defi/v1/events", authBearer},
+	{http.MethodGet, "/defi/v1/latest-block", authBearer},
+	{http.MethodGet, "/defi/v1/latest-swap", authBearer},
+	{http.MethodGet, "/defi/v1/pair", authBearer},
+
+	// Staking
+	{http.MethodGet, "/staking/v1/account/{address}/ft/transfer", authBearer},
+	{http.MethodGet, "/staking/v1/delegator", authBearer},
+
+	// NFT
+	{http.MethodGet, "/nft/v0/{nft_type}/item", authBearer},
+}
+
+// matchRoute returns the registry entry for a request, or a bearer-authenticated
+// route using the raw path as its template if the path is not registered.
+// Literal segments take precedence over parameters, so "/flow/v1/ft/transfer"
+// matches its own entry rather than "/flow/v1/ft/{token}".
+func matchRoute(method, path string) route {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	best := -1
+	bestLiterals := -1
+	for i, r := range routes {
+		if r.method != method {
+			continue
+		}
+		literals, ok := matchTemplate(r.template, segments)
+		if ok && literals > bestLiterals {
+			best, bestLiterals = i, literals
+		}
+	}
+	if best < 0 {
+		return route{method: method, template: path, auth: authBearer}
+	}
+	return routes[best]
+}
+
+// matchTemplate reports whether path segments match a route template and how
+// many literal segments matched
+func matchTemplate(template string, segments []string) (int, bool) {
+	parts := strings.Split(strings.Trim(template, "/"), "/")
+	if len(parts) != len(segments) {
+		return 0, false
+	}
+	literals := 0
+	for i, p := range parts {
+		if strings.HasPrefix(p, "{") && strings.HasSuffix(p, "}") {
+			if segments[i] == "" {
+				return 0, false
+			}
+			continue
+		}
+		if p != segments[i] {
+			return 0, false
+		}
+		literals++
+	}
+	return literals, true
+}
+
+// routeParams extracts the values of a template's {name} parameters from a path
+func routeParams(template, path string) map[string]string {
+	parts := strings.Split(strings.Trim(template, "/"), "/")
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) != len(segments) {
+		return nil
+	}
+	var params map[string]string
+	for i, p := range parts {
+		if strings.HasPrefix(p, "{") && strings.HasSuffix(p, "}") {
+			if params == nil {
+				params = make(map[string]string)
+			}
+			params[p[1:len(p)-1]] = segments[i]
+		}
+	}
+	return params
+}
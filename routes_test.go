@@ -0,0 +1,45 @@
+package findapi
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestMatchRoute(t *testing.T) {
+	tests := []struct {
+		method   string
+		path     string
+		template string
+		auth     authRequirement
+	}{
+		{http.MethodPost, "/auth/v1/generate", "/auth/v1/generate", authBasic},
+		{http.MethodGet, "/public/v1/resolver", "/public/v1/resolver", authNone},
+		{http.MethodGet, "/public/v1/account/0x1234", "/public/v1/account/{address}", authNone},
+		{http.MethodGet, "/flow/v1/ft/transfer", "/flow/v1/ft/transfer", authBearer},
+		{http.MethodGet, "/flow/v1/ft/A.1654653399040a61.FlowToken", "/flow/v1/ft/{token}", authBearer},
+		{http.MethodGet, "/flow/v1/account/0x1/ft/A.1.Token/transfer", "/flow/v1/account/{address}/ft/{token}/transfer", authBearer},
+		{http.MethodGet, "/flow/v1/account/0x1/nft/A.1.TopShot.NFT", "/flow/v1/account/{address}/nft/{nft_type}", authBearer},
+		{http.MethodGet, "/unknown/v1/thing", "/unknown/v1/thing", authBearer},
+		{http.MethodPost, "/public/v1/resolver", "/public/v1/resolver", authBearer},
+	}
+
+	for _, tt := range tests {
+		r := matchRoute(tt.method, tt.path)
+		if r.template != tt.template {
+			t.Errorf("%s %s: expected template %s, got %s", tt.method, tt.path, tt.template, r.template)
+		}
+		if r.auth != tt.auth {
+			t.Errorf("%s %s: expected auth %d, got %d", tt.method, tt.path, tt.auth, r.auth)
+		}
+	}
+}
+
+func TestRouteParams(t *testing.T) {
+	params := routeParams("/flow/v1/account/{address}/ft/{token}/transfer", "/flow/v1/account/0x1/ft/A.1.Token/transfer")
+	if params["address"] != "0x1" || params["token"] != "A.1.Token" || len(params) != 2 {
+		t.Errorf("Unexpected params: %v", params)
+	}
+	if params := routeParams("/flow/v1/ft/transfer", "/flow/v1/ft/transfer"); params != nil {
+		t.Errorf("Expected no params for a literal route, got %v", params)
+	}
+}
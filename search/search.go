@@ -0,0 +1,119 @@
+// Package search resolves arbitrary strings (transaction IDs, block IDs,
+// addresses, node IDs, contract identifiers) into the entities they refer to.
+package search
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Client is an interface for making HTTP requests to the API
+type Client interface {
+	DoRequest(ctx context.Context, method, path string, query url.Values) (*http.Response, error)
+	DoRequestWithBody(ctx context.Context, method, path string, query url.Values, body any) (*http.Response, error)
+	DecodeResponse(resp *http.Response, v any) error
+}
+
+// Service handles operations for the search (resolver) endpoint
+type Service struct {
+	client Client
+}
+
+// NewService creates a new search service
+func NewService(client Client) *Service {
+	return &Service{client: client}
+}
+
+// Kind is the type of entity a result refers to
+type Kind string
+
+// Kinds of resolved entities, named after the API's sources
+const (
+	KindTransaction Kind = "transactions"
+	KindBlock       Kind = "blocks"
+	KindAccount     Kind = "accounts"
+	KindContract    Kind = "contracts"
+	KindNode        Kind = "epoch_nodes"
+)
+
+// Result is an entity matching a query
+type Result struct {
+	// ID is the entity's identifier: a transaction or block ID, an account
+	// address, a node ID or a contract identifier
+	ID string `json:"id"`
+	// Kind is the entity type, as reported in the API's source field
+	Kind Kind `json:"source"`
+}
+
+// Response represents the response from the resolver endpoint
+type Response struct {
+	Data  []Result               `json:"data"`
+	Links map[string]string      `json:"_links"`
+	Meta  map[string]interface{} `json:"_meta"`
+	Error interface{}            `json:"error,omitempty"`
+}
+
+// Of returns the results of a kind
+func (r *Response) Of(kind Kind) []Result {
+	var out []Result
+	for _, res := range r.Data {
+		if res.Kind == kind {
+			out = append(out, res)
+		}
+	}
+	return out
+}
+
+// QueryRequestBuilder builds a search request
+type QueryRequestBuilder struct {
+	service *Service
+	query   string
+}
+
+// Query creates a new search request builder for a keyword
+func (s *Service) Query(query string) *QueryRequestBuilder {
+	return &QueryRequestBuilder{service: s, query: query}
+}
+
+// Clone returns an independent copy of the search request builder
+func (b *QueryRequestBuilder) Clone() *QueryRequestBuilder {
+	c := *b
+	return &c
+}
+
+// Validate checks the search request without making a network call
+func (b *QueryRequestBuilder) Validate() error {
+	if strings.TrimSpace(b.query) == "" {
+		return fmt.Errorf("search query is required")
+	}
+	return nil
+}
+
+// Do executes the search request. The resolver endpoint is public and does
+// not require credentials.
+func (b *QueryRequestBuilder) Do(ctx context.Context) (*Response, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("id", strings.TrimSpace(b.query))
+
+	resp, err := b.service.client.DoRequest(ctx, http.MethodGet, "/public/v1/resolver", query)
+	if err != nil {
+		return nil, err
+	}
+
+	var searchResp Response
+	if err := b.service.client.DecodeResponse(resp, &searchResp); err != nil {
+		return nil, err
+	}
+	if searchResp.Data == nil {
+		searchResp.Data = []Result{}
+	}
+
+	return &searchResp, nil
+}
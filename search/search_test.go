@@ -0,0 +1,107 @@
+package search
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+// mockClient implements the Client interface for testing
+type mockClient struct {
+	server *httptest.Server
+}
+
+func (m *mockClient) DoRequest(ctx context.Context, method, path string, query url.Values) (*http.Response, error) {
+	return m.DoRequestWithBody(ctx, method, path, query, nil)
+}
+
+func (m *mockClient) DoRequestWithBody(ctx context.Context, method, path string, query url.Values, body any) (*http.Response, error) {
+	u, err := url.Parse(m.server.URL + path)
+	if err != nil {
+		return nil, err
+	}
+	u.RawQuery = query.Encode()
+
+	var bodyReader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		bodyReader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bodyReader)
+	if err != nil {
+		return nil, err
+	}
+	return http.DefaultClient.Do(req)
+}
+
+func (m *mockClient) DecodeResponse(resp *http.Response, v any) error {
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, body)
+	}
+	return json.Unmarshal(body, v)
+}
+
+func TestSearchService_Query(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/public/v1/resolver" {
+			t.Errorf("Expected path /public/v1/resolver, got %s", r.URL.Path)
+		}
+		if id := r.URL.Query().Get("id"); id != "abc123" {
+			t.Errorf("Expected id abc123, got %s", id)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data":[{"id":"abc123","source":"transactions"},{"id":"abc123","source":"blocks"}]}`)
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	resp, err := service.Query("  abc123 ").Do(context.Background())
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+
+	if len(resp.Data) != 2 {
+		t.Fatalf("Expected 2 results, got %d", len(resp.Data))
+	}
+	if txs := resp.Of(KindTransaction); len(txs) != 1 || txs[0].ID != "abc123" {
+		t.Errorf("Expected 1 transaction result, got %+v", txs)
+	}
+	if accounts := resp.Of(KindAccount); len(accounts) != 0 {
+		t.Errorf("Expected no account results, got %+v", accounts)
+	}
+}
+
+func TestSearchService_QueryEmpty(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":null}`)
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	resp, err := service.Query("nothing").Do(context.Background())
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	if resp.Data == nil || len(resp.Data) != 0 {
+		t.Errorf("Expected empty non-nil results, got %v", resp.Data)
+	}
+
+	if _, err := service.Query(" ").Do(context.Background()); err == nil {
+		t.Error("Expected error for empty query")
+	}
+}
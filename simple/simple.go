@@ -1,16 +1,24 @@
 package simple
 
 import (
+	"cmp"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"regexp"
+	"slices"
 	"strconv"
+
+	"github.com/peterargue/find-api/filter"
+	"github.com/peterargue/find-api/txerror"
 )
 
 // Client is an interface for making HTTP requests to the API
 type Client interface {
 	DoRequest(ctx context.Context, method, path string, query url.Values) (*http.Response, error)
+	DoRequestWithBody(ctx context.Context, method, path string, query url.Values, body any) (*http.Response, error)
 	DecodeResponse(resp *http.Response, v any) error
 }
 
@@ -24,6 +32,15 @@ func NewService(client Client) *Service {
 	return &Service{client: client}
 }
 
+// emptyIfNil returns s, or an empty slice if s is nil. Every Do method returns
+// non-nil result slices, even when the API returns no records.
+func emptyIfNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
+
 // Block represents a Flow blockchain block
 type Block struct {
 	Height       uint64          `json:"height"`
@@ -51,6 +68,43 @@ type Event struct {
 	Timestamp       string                 `json:"timestamp"`
 	TransactionHash string                 `json:"transaction_hash"`
 	Fields          map[string]interface{} `json:"fields"`
+	// RawFields holds the fields exactly as received, for archival or
+	// re-decoding into typed structs with DecodeFields
+	RawFields json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes an event, keeping the raw bytes of its fields
+func (e *Event) UnmarshalJSON(b []byte) error {
+	type event Event
+	var v struct {
+		event
+		Fields json.RawMessage `json:"fields"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*e = Event(v.event)
+	e.RawFields = v.Fields
+	if len(v.Fields) > 0 {
+		if err := json.Unmarshal(v.Fields, &e.Fields); err != nil {
+			return fmt.Errorf("invalid event fields: %w", err)
+		}
+	}
+	return nil
+}
+
+// DecodeFields decodes the event fields into v, a struct whose json tags
+// match the Cadence field names. The raw fields are used when available, so
+// large numbers keep their precision.
+func (e *Event) DecodeFields(v any) error {
+	data := []byte(e.RawFields)
+	if len(data) == 0 {
+		var err error
+		if data, err = json.Marshal(e.Fields); err != nil {
+			return err
+		}
+	}
+	return json.Unmarshal(data, v)
 }
 
 // EventsResponse represents the response from the events endpoint
@@ -81,6 +135,11 @@ type Transaction struct {
 	TransactionBody        *TransactionBody       `json:"transaction_body,omitempty"`
 }
 
+// ExecutionError decodes the transaction's error code and message, or returns nil if it succeeded
+func (t Transaction) ExecutionError() *txerror.Error {
+	return txerror.Parse(t.ErrorCode, t.Error)
+}
+
 // TransactionBody contains the transaction script body
 type TransactionBody struct {
 	Body string `json:"body"`
@@ -110,6 +169,27 @@ type TransactionEventsResponse struct {
 	Events []SimpleEvent `json:"events"`
 }
 
+// Account represents a lightweight view of a Flow account
+type Account struct {
+	Address     string                 `json:"address"`
+	BlockHeight uint64                 `json:"block_height"`
+	COAAddress  string                 `json:"coa_address"`
+	COAHeight   uint64                 `json:"coa_height"`
+	EvmAccounts []EvmAccount           `json:"evmAccounts"`
+	Profile     map[string]interface{} `json:"profile,omitempty"`
+}
+
+// EvmAccount represents an EVM account linked to a Flow account
+type EvmAccount struct {
+	Address string `json:"address"`
+	Name    string `json:"name"`
+}
+
+// accountResponse is the response from the public account endpoint
+type accountResponse struct {
+	Data []Account `json:"data"`
+}
+
 // BlocksRequestBuilder builds a request to get blocks
 type BlocksRequestBuilder struct {
 	service *Service
@@ -122,6 +202,12 @@ func (s *Service) GetBlocks() *BlocksRequestBuilder {
 	return &BlocksRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the blocks request builder
+func (b *BlocksRequestBuilder) Clone() *BlocksRequestBuilder {
+	c := *b
+	return &c
+}
+
 // Height sets the block height (required)
 func (b *BlocksRequestBuilder) Height(height uint64) *BlocksRequestBuilder {
 	b.height = height
@@ -134,12 +220,20 @@ func (b *BlocksRequestBuilder) Offset(offset int) *BlocksRequestBuilder {
 	return b
 }
 
-// Do executes the blocks request
-func (b *BlocksRequestBuilder) Do(ctx context.Context) (*BlocksResponse, error) {
+// Validate checks the blocks request without making a network call
+func (b *BlocksRequestBuilder) Validate() error {
 	if b.height == 0 {
 		// TODO: we should be able to get the genesis block, but the API currently returns an error
 		// {"error":"Field 'Height' failed on the 'required' tag"}
-		return nil, fmt.Errorf("height is required")
+		return fmt.Errorf("height is required")
+	}
+	return validateOffset(b.offset)
+}
+
+// Do executes the blocks request
+func (b *BlocksRequestBuilder) Do(ctx context.Context) (*BlocksResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	query := url.Values{}
@@ -157,17 +251,66 @@ func (b *BlocksRequestBuilder) Do(ctx context.Context) (*BlocksResponse, error)
 	if err := b.service.client.DecodeResponse(resp, &blocksResp); err != nil {
 		return nil, err
 	}
+	blocksResp.Blocks = emptyIfNil(blocksResp.Blocks)
 
 	return &blocksResp, nil
 }
 
+// latestBlockResponse is the subset of the flow blocks response used to find the chain head
+type latestBlockResponse struct {
+	Data []struct {
+		Height uint64 `json:"height"`
+	} `json:"data"`
+}
+
+// GetLatestHeight returns the most recent indexed block height, e.g. to bound
+// the FromHeight/ToHeight window of GetEvents. The simple API has no head
+// endpoint, so the height is read from the flow blocks endpoint.
+func (s *Service) GetLatestHeight(ctx context.Context) (uint64, error) {
+	query := url.Values{}
+	query.Set("limit", "1")
+	resp, err := s.client.DoRequest(ctx, http.MethodGet, "/flow/v1/block", query)
+	if err != nil {
+		return 0, err
+	}
+	var head latestBlockResponse
+	if err := s.client.DecodeResponse(resp, &head); err != nil {
+		return 0, err
+	}
+	if len(head.Data) == 0 {
+		return 0, fmt.Errorf("no blocks indexed")
+	}
+	return head.Data[0].Height, nil
+}
+
+// GetLatestBlock returns the newest indexed block, fetched from the simple API
+// at the height reported by GetLatestHeight
+func (s *Service) GetLatestBlock(ctx context.Context) (*Block, error) {
+	height, err := s.GetLatestHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks, err := s.GetBlocks().Height(height).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i, b := range blocks.Blocks {
+		if b.Height == height {
+			return &blocks.Blocks[i], nil
+		}
+	}
+	return nil, fmt.Errorf("block %d not indexed by the simple API", height)
+}
+
 // EventsRequestBuilder builds a request to get events
 type EventsRequestBuilder struct {
 	service    *Service
-	name       string
+	names      []string
 	fromHeight uint64
 	toHeight   uint64
 	offset     *int
+	filterErr  error
 }
 
 // GetEvents creates a new events request builder
@@ -175,9 +318,24 @@ func (s *Service) GetEvents() *EventsRequestBuilder {
 	return &EventsRequestBuilder{service: s}
 }
 
-// Name sets the event name to filter by (required)
+// Clone returns an independent copy of the events request builder
+func (b *EventsRequestBuilder) Clone() *EventsRequestBuilder {
+	c := *b
+	c.names = slices.Clone(b.names)
+	return &c
+}
+
+// Name sets the event name to filter by (required unless Names is used)
 func (b *EventsRequestBuilder) Name(name string) *EventsRequestBuilder {
-	b.name = name
+	b.names = []string{name}
+	return b
+}
+
+// Names adds event names to filter by, e.g. a token's Deposited and Withdrawn
+// events. The endpoint accepts one name, so one request is made per name and
+// the results are merged.
+func (b *EventsRequestBuilder) Names(names ...string) *EventsRequestBuilder {
+	b.names = append(b.names, names...)
 	return b
 }
 
@@ -199,21 +357,92 @@ func (b *EventsRequestBuilder) Offset(offset int) *EventsRequestBuilder {
 	return b
 }
 
-// Do executes the events request
-// Returns up to 100 events per request, ordered from oldest to newest
-func (b *EventsRequestBuilder) Do(ctx context.Context) (*EventsResponse, error) {
-	if b.name == "" {
-		return nil, fmt.Errorf("event name is required")
+// Apply applies reusable filters: height range and offset (optional)
+func (b *EventsRequestBuilder) Apply(filters ...filter.Filter) *EventsRequestBuilder {
+	p := filter.Collect(filters...)
+	if err := p.Unsupported("GetEvents", filter.FieldHeightRange, filter.FieldPagination); err != nil {
+		b.filterErr = err
+		return b
+	}
+	if p.Limit != nil {
+		b.filterErr = fmt.Errorf("GetEvents does not support a limit; pages hold up to 100 events")
+		return b
+	}
+	if p.FromHeight != nil {
+		b.fromHeight = *p.FromHeight
+	}
+	if p.ToHeight != nil {
+		b.toHeight = *p.ToHeight
+	}
+	if p.Offset != nil {
+		b.offset = p.Offset
+	}
+	return b
+}
+
+// Validate checks the events request without making a network call
+func (b *EventsRequestBuilder) Validate() error {
+	if b.filterErr != nil {
+		return b.filterErr
+	}
+	if len(b.eventNames()) == 0 {
+		return fmt.Errorf("event name is required")
 	}
 	if b.fromHeight == 0 {
-		return nil, fmt.Errorf("from_height is required")
+		return fmt.Errorf("from_height is required")
 	}
 	if b.toHeight == 0 {
-		return nil, fmt.Errorf("to_height is required")
+		return fmt.Errorf("to_height is required")
+	}
+	if b.fromHeight > b.toHeight {
+		return fmt.Errorf("from_height %d is after to_height %d", b.fromHeight, b.toHeight)
+	}
+	return validateOffset(b.offset)
+}
+
+// eventNames returns the distinct, non-empty event names, sorted
+func (b *EventsRequestBuilder) eventNames() []string {
+	names := slices.DeleteFunc(slices.Clone(b.names), func(n string) bool { return n == "" })
+	slices.Sort(names)
+	return slices.Compact(names)
+}
+
+// Do executes the events request
+// Returns up to 100 events per request, ordered from oldest to newest. With
+// several names, each name returns up to 100 events from the offset and the
+// merged events are ordered by block height and event index.
+func (b *EventsRequestBuilder) Do(ctx context.Context) (*EventsResponse, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
+	names := b.eventNames()
+	if len(names) == 1 {
+		return b.fetch(ctx, names[0])
+	}
+
+	merged := &EventsResponse{Events: []Event{}}
+	for _, name := range names {
+		eventsResp, err := b.fetch(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("fetch %s events: %w", name, err)
+		}
+		merged.Events = append(merged.Events, eventsResp.Events...)
+	}
+	slices.SortStableFunc(merged.Events, func(x, y Event) int {
+		if c := cmp.Compare(x.BlockHeight, y.BlockHeight); c != 0 {
+			return c
+		}
+		return cmp.Compare(x.EventIndex, y.EventIndex)
+	})
+
+	return merged, nil
+}
+
+// fetch requests the events of a single name
+func (b *EventsRequestBuilder) fetch(ctx context.Context, name string) (*EventsResponse, error) {
 	query := url.Values{}
-	query.Set("name", b.name)
+	query.Set("name", name)
 	query.Set("from_height", strconv.FormatUint(b.fromHeight, 10))
 	query.Set("to_height", strconv.FormatUint(b.toHeight, 10))
 	if b.offset != nil {
@@ -229,6 +458,7 @@ func (b *EventsRequestBuilder) Do(ctx context.Context) (*EventsResponse, error)
 	if err := b.service.client.DecodeResponse(resp, &eventsResp); err != nil {
 		return nil, err
 	}
+	eventsResp.Events = emptyIfNil(eventsResp.Events)
 
 	return &eventsResp, nil
 }
@@ -244,16 +474,30 @@ func (s *Service) GetTransaction() *TransactionRequestBuilder {
 	return &TransactionRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the transaction request builder
+func (b *TransactionRequestBuilder) Clone() *TransactionRequestBuilder {
+	c := *b
+	return &c
+}
+
 // ID sets the transaction ID (required)
 func (b *TransactionRequestBuilder) ID(id string) *TransactionRequestBuilder {
 	b.id = id
 	return b
 }
 
+// Validate checks the transaction request without making a network call
+func (b *TransactionRequestBuilder) Validate() error {
+	if b.id == "" {
+		return fmt.Errorf("transaction ID is required")
+	}
+	return nil
+}
+
 // Do executes the transaction request
 func (b *TransactionRequestBuilder) Do(ctx context.Context) (*TransactionsResponse, error) {
-	if b.id == "" {
-		return nil, fmt.Errorf("transaction ID is required")
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	query := url.Values{}
@@ -268,6 +512,7 @@ func (b *TransactionRequestBuilder) Do(ctx context.Context) (*TransactionsRespon
 	if err := b.service.client.DecodeResponse(resp, &txResp); err != nil {
 		return nil, err
 	}
+	txResp.Transactions = emptyIfNil(txResp.Transactions)
 
 	return &txResp, nil
 }
@@ -284,6 +529,12 @@ func (s *Service) GetTransactionEvents() *TransactionEventsRequestBuilder {
 	return &TransactionEventsRequestBuilder{service: s}
 }
 
+// Clone returns an independent copy of the transaction events request builder
+func (b *TransactionEventsRequestBuilder) Clone() *TransactionEventsRequestBuilder {
+	c := *b
+	return &c
+}
+
 // TransactionID sets the transaction ID (required)
 func (b *TransactionEventsRequestBuilder) TransactionID(id string) *TransactionEventsRequestBuilder {
 	b.transactionID = id
@@ -296,10 +547,18 @@ func (b *TransactionEventsRequestBuilder) Offset(offset int) *TransactionEventsR
 	return b
 }
 
+// Validate checks the transaction events request without making a network call
+func (b *TransactionEventsRequestBuilder) Validate() error {
+	if b.transactionID == "" {
+		return fmt.Errorf("transaction ID is required")
+	}
+	return validateOffset(b.offset)
+}
+
 // Do executes the transaction events request
 func (b *TransactionEventsRequestBuilder) Do(ctx context.Context) (*TransactionEventsResponse, error) {
-	if b.transactionID == "" {
-		return nil, fmt.Errorf("transaction ID is required")
+	if err := b.Validate(); err != nil {
+		return nil, err
 	}
 
 	query := url.Values{}
@@ -317,6 +576,85 @@ func (b *TransactionEventsRequestBuilder) Do(ctx context.Context) (*TransactionE
 	if err := b.service.client.DecodeResponse(resp, &eventsResp); err != nil {
 		return nil, err
 	}
+	eventsResp.Events = emptyIfNil(eventsResp.Events)
 
 	return &eventsResp, nil
 }
+
+// AccountRequestBuilder builds a request to get an account
+type AccountRequestBuilder struct {
+	service *Service
+	address string
+}
+
+// GetAccount creates a new account request builder. The simple API has no
+// account endpoint, so the account is read from the public account endpoint:
+// its creation height, linked EVM accounts and profile, without the balances,
+// keys and storage of the flow account details.
+func (s *Service) GetAccount() *AccountRequestBuilder {
+	return &AccountRequestBuilder{service: s}
+}
+
+// Clone returns an independent copy of the account request builder
+func (b *AccountRequestBuilder) Clone() *AccountRequestBuilder {
+	c := *b
+	return &c
+}
+
+// Address sets the account address (required)
+func (b *AccountRequestBuilder) Address(address string) *AccountRequestBuilder {
+	b.address = address
+	return b
+}
+
+// Validate checks the account request without making a network call
+func (b *AccountRequestBuilder) Validate() error {
+	if b.address == "" {
+		return fmt.Errorf("account address is required")
+	}
+	return validateAddress(b.address)
+}
+
+// Do executes the account request
+func (b *AccountRequestBuilder) Do(ctx context.Context) (*Account, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/public/v1/account/%s", b.address)
+	resp, err := b.service.client.DoRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var accountResp accountResponse
+	if err := b.service.client.DecodeResponse(resp, &accountResp); err != nil {
+		return nil, err
+	}
+	if len(accountResp.Data) == 0 {
+		return nil, fmt.Errorf("account %s not found", b.address)
+	}
+
+	account := accountResp.Data[0]
+	account.EvmAccounts = emptyIfNil(account.EvmAccounts)
+	return &account, nil
+}
+
+// flowAddressRe matches a Flow address, with or without the 0x prefix
+var flowAddressRe = regexp.MustCompile(`^(0x)?[0-9a-fA-F]{1,16}$`)
+
+// validateAddress checks that address is a Flow address
+func validateAddress(address string) error {
+	if !flowAddressRe.MatchString(address) {
+		return fmt.Errorf("invalid account address %q", address)
+	}
+	return nil
+}
+
+// validateOffset checks an optional pagination offset
+func validateOffset(offset *int) error {
+	if offset != nil && *offset < 0 {
+		return fmt.Errorf("offset %d is negative", *offset)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package simple
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -9,6 +10,8 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+
+	"github.com/peterargue/find-api/filter"
 )
 
 // mockClient implements the Client interface for testing
@@ -17,17 +20,29 @@ type mockClient struct {
 }
 
 func (m *mockClient) DoRequest(ctx context.Context, method, path string, query url.Values) (*http.Response, error) {
+	return m.DoRequestWithBody(ctx, method, path, query, nil)
+}
+
+func (m *mockClient) DoRequestWithBody(ctx context.Context, method, path string, query url.Values, body any) (*http.Response, error) {
 	u, err := url.Parse(m.server.URL + path)
 	if err != nil {
 		return nil, err
 	}
 	u.RawQuery = query.Encode()
 
-	req, err := http.NewRequestWithContext(ctx, method, u.String(), nil)
+	var bodyReader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		bodyReader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bodyReader)
 	if err != nil {
 		return nil, err
 	}
-
 	return http.DefaultClient.Do(req)
 }
 
@@ -115,6 +130,56 @@ func TestSimpleService_GetBlocks(t *testing.T) {
 	}
 }
 
+func TestSimpleService_GetLatestBlock(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/flow/v1/block":
+			if r.URL.Query().Get("limit") != "1" {
+				t.Errorf("Expected limit 1, got %s", r.URL.Query().Get("limit"))
+			}
+			fmt.Fprint(w, `{"data":[{"height":500}]}`)
+		case "/simple/v1/blocks":
+			if r.URL.Query().Get("height") != "500" {
+				t.Errorf("Expected height 500, got %s", r.URL.Query().Get("height"))
+			}
+			json.NewEncoder(w).Encode(BlocksResponse{Blocks: []Block{{Height: 500, ID: "head"}}})
+		default:
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	block, err := service.GetLatestBlock(context.Background())
+	if err != nil {
+		t.Fatalf("GetLatestBlock failed: %v", err)
+	}
+	if block.Height != 500 || block.ID != "head" {
+		t.Errorf("Expected block 500, got %+v", block)
+	}
+}
+
+func TestSimpleService_GetLatestHeight(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/flow/v1/block" {
+			t.Errorf("Expected path /flow/v1/block, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data":[{"height":123456}]}`)
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	height, err := service.GetLatestHeight(context.Background())
+	if err != nil {
+		t.Fatalf("GetLatestHeight failed: %v", err)
+	}
+	if height != 123456 {
+		t.Errorf("Expected height 123456, got %d", height)
+	}
+}
+
 func TestSimpleService_GetEvents(t *testing.T) {
 	// Create mock server
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -183,6 +248,56 @@ func TestSimpleService_GetEvents(t *testing.T) {
 	}
 }
 
+func TestSimpleService_GetEventsNames(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var resp EventsResponse
+		switch name := r.URL.Query().Get("name"); name {
+		case "A.test.Token.Deposited":
+			resp.Events = []Event{
+				{BlockHeight: 150, EventIndex: 1, Name: name},
+				{BlockHeight: 170, EventIndex: 0, Name: name},
+			}
+		case "A.test.Token.Withdrawn":
+			resp.Events = []Event{
+				{BlockHeight: 150, EventIndex: 0, Name: name},
+				{BlockHeight: 160, EventIndex: 2, Name: name},
+			}
+		default:
+			t.Errorf("Unexpected event name %s", name)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := &mockClient{server: server}
+	service := NewService(client)
+
+	ctx := context.Background()
+	result, err := service.GetEvents().
+		Names("A.test.Token.Deposited", "A.test.Token.Withdrawn", "A.test.Token.Deposited").
+		FromHeight(100).
+		ToHeight(200).
+		Do(ctx)
+	if err != nil {
+		t.Fatalf("GetEvents failed: %v", err)
+	}
+
+	if len(result.Events) != 4 {
+		t.Fatalf("Expected 4 events, got %d", len(result.Events))
+	}
+	want := []struct {
+		height uint64
+		index  int
+	}{{150, 0}, {150, 1}, {160, 2}, {170, 0}}
+	for i, w := range want {
+		if result.Events[i].BlockHeight != w.height || result.Events[i].EventIndex != w.index {
+			t.Errorf("Event %d: expected %d/%d, got %d/%d", i, w.height, w.index, result.Events[i].BlockHeight, result.Events[i].EventIndex)
+		}
+	}
+}
+
 func TestSimpleService_GetTransaction(t *testing.T) {
 	txID := "b03b47104a675dd2d594a8dd85cdc313586678f508fe67c4de0604f0a4920562"
 
@@ -241,6 +356,33 @@ func TestSimpleService_GetTransaction(t *testing.T) {
 	}
 }
 
+func TestSimpleService_GetEventsApply(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("from_height") != "100" || q.Get("to_height") != "200" || q.Get("offset") != "25" {
+			t.Errorf("Expected heights and offset from filters, got %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(EventsResponse{})
+	}))
+	defer server.Close()
+
+	service := NewService(&mockClient{server: server})
+	ctx := context.Background()
+
+	_, err := service.GetEvents().Name("A.test.Event").Apply(filter.Heights(100, 200), filter.Page(0, 25)).Do(ctx)
+	if err != nil {
+		t.Fatalf("GetEvents failed: %v", err)
+	}
+
+	if _, err := service.GetEvents().Name("A.test.Event").Apply(filter.Address("0x1")).Do(ctx); err == nil {
+		t.Error("Expected error for unsupported address filter")
+	}
+	if _, err := service.GetEvents().Name("A.test.Event").Apply(filter.Heights(1, 2), filter.Page(10, 0)).Do(ctx); err == nil {
+		t.Error("Expected error for unsupported limit")
+	}
+}
+
 func TestSimpleService_GetTransactionEvents(t *testing.T) {
 	txID := "b03b47104a675dd2d594a8dd85cdc313586678f508fe67c4de0604f0a4920562"
 
@@ -293,6 +435,41 @@ func TestSimpleService_GetTransactionEvents(t *testing.T) {
 	}
 }
 
+func TestSimpleService_GetAccount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/public/v1/account/0x1654653399040a61" {
+			t.Errorf("Expected path /public/v1/account/0x1654653399040a61, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[{"address":"0x1654653399040a61","block_height":7601063,"coa_address":"0x0000000000000000000000023f946ffbc8829bfd"}]}`))
+	}))
+	defer server.Close()
+
+	client := &mockClient{server: server}
+	service := NewService(client)
+
+	ctx := context.Background()
+	account, err := service.GetAccount().Address("0x1654653399040a61").Do(ctx)
+	if err != nil {
+		t.Fatalf("GetAccount failed: %v", err)
+	}
+
+	if account.BlockHeight != 7601063 {
+		t.Errorf("Expected block height 7601063, got %d", account.BlockHeight)
+	}
+	if account.COAAddress == "" {
+		t.Error("Expected COA address")
+	}
+	if account.EvmAccounts == nil {
+		t.Error("Expected non-nil EVM accounts")
+	}
+
+	if _, err := service.GetAccount().Do(ctx); err == nil {
+		t.Error("Expected error when address is not provided")
+	}
+}
+
 func TestService_WithOffset(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
@@ -335,3 +512,73 @@ func TestService_WithOffset(t *testing.T) {
 		t.Errorf("Expected 1 block, got %d", len(result.Blocks))
 	}
 }
+
+func TestSimpleService_Validate(t *testing.T) {
+	service := NewService(nil)
+
+	if err := service.GetEvents().Name("A.1654653399040a61.FlowToken.TokensDeposited").FromHeight(1).ToHeight(2).Validate(); err != nil {
+		t.Errorf("Expected valid events request, got %v", err)
+	}
+	if err := service.GetEvents().Name("A.1654653399040a61.FlowToken.TokensDeposited").FromHeight(2).ToHeight(1).Validate(); err == nil {
+		t.Error("Expected error for inverted range")
+	}
+	if err := service.GetEvents().Names("", "").FromHeight(1).ToHeight(2).Validate(); err == nil {
+		t.Error("Expected error without event names")
+	}
+	if err := service.GetAccount().Address("0xzz").Validate(); err == nil {
+		t.Error("Expected error for malformed address")
+	}
+	if err := service.GetTransactionEvents().TransactionID("abc").Offset(-5).Validate(); err == nil {
+		t.Error("Expected error for negative offset")
+	}
+}
+
+func TestSimpleService_GetEventsClone(t *testing.T) {
+	service := NewService(nil)
+	// The second Names call leaves spare capacity, so clones that appended
+	// to a shared slice would overwrite each other's names
+	template := service.GetEvents().Names("A", "B", "C").Names("D").FromHeight(1).ToHeight(2)
+
+	first := template.Clone().Names("E")
+	second := template.Clone().Names("F")
+	if got := first.names[len(first.names)-1]; got != "E" {
+		t.Errorf("Expected first clone to keep name E, got %s", got)
+	}
+	if got := second.names[len(second.names)-1]; got != "F" {
+		t.Errorf("Expected second clone to keep name F, got %s", got)
+	}
+	if len(template.names) != 4 {
+		t.Errorf("Expected template to keep 4 names, got %v", template.names)
+	}
+}
+
+func TestEvent_RawFields(t *testing.T) {
+	body := `{"name":"A.1.Token.Deposited","block_height":5,"fields":{"amount":"1.5","id":18446744073709551615}}`
+	var e Event
+	if err := json.Unmarshal([]byte(body), &e); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if e.Name != "A.1.Token.Deposited" || e.Fields["amount"] != "1.5" {
+		t.Errorf("Expected decoded event and fields, got %+v", e)
+	}
+	if string(e.RawFields) != `{"amount":"1.5","id":18446744073709551615}` {
+		t.Errorf("Expected raw fields kept byte for byte, got %s", e.RawFields)
+	}
+
+	var typed struct {
+		Amount string `json:"amount"`
+		ID     uint64 `json:"id"`
+	}
+	if err := e.DecodeFields(&typed); err != nil {
+		t.Fatalf("DecodeFields failed: %v", err)
+	}
+	if typed.ID != 18446744073709551615 {
+		t.Errorf("Expected id to keep its precision, got %d", typed.ID)
+	}
+
+	// Events built in code without raw fields decode from the map
+	e = Event{Fields: map[string]interface{}{"amount": "2.0"}}
+	if err := e.DecodeFields(&typed); err != nil || typed.Amount != "2.0" {
+		t.Errorf("Expected amount 2.0, got %q (%v)", typed.Amount, err)
+	}
+}
@@ -0,0 +1,96 @@
+package findapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// flightGroup collapses concurrent identical requests into a single upstream call
+type flightGroup struct {
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+// flight is an in-progress or completed upstream call shared by its waiters
+type flight struct {
+	done chan struct{}
+	resp *http.Response
+	body []byte
+	err  error
+}
+
+// WithRequestDeduplication collapses concurrent GET requests for the same URL
+// into a single upstream call. Every caller receives its own copy of the
+// response body, so results can be decoded independently, and a caller whose
+// context is cancelled stops waiting without failing the others. Requests are
+// only shared while in flight; nothing is cached afterwards.
+func WithRequestDeduplication() ClientOption {
+	return func(c *Client) {
+		c.flights = &flightGroup{flights: make(map[string]*flight)}
+	}
+}
+
+// do executes fn once per key among concurrent callers and returns a private
+// copy of the response to each of them. The shared call runs on a context
+// detached from every caller, bounded by timeout if set, so one caller giving
+// up does not fail the others; each caller waits only as long as its own ctx.
+func (g *flightGroup) do(ctx context.Context, key string, timeout time.Duration, fn func(context.Context) (*http.Response, error)) (*http.Response, error) {
+	g.mu.Lock()
+	f, ok := g.flights[key]
+	if !ok {
+		f = &flight{done: make(chan struct{})}
+		g.flights[key] = f
+		go g.run(context.WithoutCancel(ctx), key, f, timeout, fn)
+	}
+	g.mu.Unlock()
+
+	select {
+	case <-f.done:
+		return f.response(ctx)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// run performs the shared call for a flight and releases its waiters
+func (g *flightGroup) run(ctx context.Context, key string, f *flight, timeout time.Duration, fn func(context.Context) (*http.Response, error)) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	f.resp, f.err = fn(ctx)
+	if f.err == nil {
+		f.body, f.err = io.ReadAll(f.resp.Body)
+		f.resp.Body.Close()
+		if f.err != nil {
+			f.err = fmt.Errorf("failed to read response: %w", f.err)
+		}
+	}
+
+	g.mu.Lock()
+	delete(g.flights, key)
+	g.mu.Unlock()
+	close(f.done)
+}
+
+// response returns a copy of the shared response bound to the caller's context
+func (f *flight) response(ctx context.Context) (*http.Response, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	resp := new(http.Response)
+	*resp = *f.resp
+	resp.Header = f.resp.Header.Clone()
+	resp.Body = io.NopCloser(bytes.NewReader(f.body))
+	if f.resp.Request != nil {
+		resp.Request = f.resp.Request.WithContext(ctx)
+	}
+	return resp, nil
+}
@@ -0,0 +1,203 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/peterargue/find-api/eventsync"
+)
+
+// fakeDriver is a minimal database/sql driver understanding the statements
+// the store issues, so the sync logic can be tested without a SQLite driver
+type fakeDriver struct{}
+
+// fakeDBs holds the state of each fake database by DSN
+var fakeDBs sync.Map
+
+func init() {
+	sql.Register("storetest", fakeDriver{})
+}
+
+type fakeDB struct {
+	mu     sync.Mutex
+	rows   map[string]map[string][]driver.Value
+	order  map[string][]string
+	states map[string][2]int64
+	nextID int
+	// failHeight makes inserting a transfer at that height fail
+	failHeight int64
+}
+
+// openFakeDB opens an empty fake database and the store on top of it
+func openFakeDB(t *testing.T) (*Store, *fakeDB) {
+	t.Helper()
+	fdb := &fakeDB{rows: map[string]map[string][]driver.Value{}, order: map[string][]string{}, states: map[string][2]int64{}}
+	fakeDBs.Store(t.Name(), fdb)
+	db, err := sql.Open("storetest", t.Name())
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	st, err := Open(context.Background(), db)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	return st, fdb
+}
+
+// table returns the rows of a table in insertion order
+func (f *fakeDB) table(name string) [][]driver.Value {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var out [][]driver.Value
+	for _, key := range f.order[name] {
+		out = append(out, f.rows[name][key])
+	}
+	return out
+}
+
+func (fakeDriver) Open(dsn string) (driver.Conn, error) {
+	db, ok := fakeDBs.Load(dsn)
+	if !ok {
+		return nil, fmt.Errorf("unknown database %s", dsn)
+	}
+	return &fakeConn{db: db.(*fakeDB)}, nil
+}
+
+type fakeConn struct{ db *fakeDB }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{db: c.db, query: strings.Join(strings.Fields(query), " ")}, nil
+}
+func (c *fakeConn) Close() error              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) { return fakeTx{}, nil }
+
+// fakeTx accepts transactions without isolating them; statements apply as
+// they are executed
+type fakeTx struct{}
+
+func (fakeTx) Commit() error   { return nil }
+func (fakeTx) Rollback() error { return nil }
+
+type fakeStmt struct {
+	db    *fakeDB
+	query string
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	q := s.query
+	switch {
+	case strings.HasPrefix(q, "CREATE "):
+	case strings.HasPrefix(q, "INSERT INTO sync_state "):
+		s.db.states[args[0].(string)] = [2]int64{args[1].(int64), args[2].(int64)}
+	case strings.HasPrefix(q, "DELETE FROM ft_transfers "):
+		// Matches rows by token and an inclusive block height range
+		var kept []string
+		for _, key := range s.db.order["ft_transfers"] {
+			row := s.db.rows["ft_transfers"][key]
+			height := row[1].(int64)
+			if row[0] == args[0] && height >= args[1].(int64) && height <= args[2].(int64) {
+				delete(s.db.rows["ft_transfers"], key)
+				continue
+			}
+			kept = append(kept, key)
+		}
+		s.db.order["ft_transfers"] = kept
+	case strings.HasPrefix(q, "INSERT INTO ft_transfers "):
+		if args[1].(int64) == s.db.failHeight {
+			return nil, fmt.Errorf("insert failed at height %d", s.db.failHeight)
+		}
+		// Rows are keyed by a surrogate id, so equal transfers are all kept
+		s.db.nextID++
+		key := fmt.Sprint(s.db.nextID)
+		if s.db.rows["ft_transfers"] == nil {
+			s.db.rows["ft_transfers"] = map[string][]driver.Value{}
+		}
+		s.db.rows["ft_transfers"][key] = args
+		s.db.order["ft_transfers"] = append(s.db.order["ft_transfers"], key)
+	case strings.HasPrefix(q, "INSERT OR IGNORE INTO "), strings.HasPrefix(q, "INSERT OR REPLACE INTO "):
+		table := strings.Fields(q)[4]
+		// OR REPLACE tables are keyed by their first column, OR IGNORE ones by every column
+		key := fmt.Sprint(args)
+		if strings.Contains(q, "OR REPLACE") {
+			key = fmt.Sprint(args[0])
+		}
+		if s.db.rows[table] == nil {
+			s.db.rows[table] = map[string][]driver.Value{}
+		}
+		_, exists := s.db.rows[table][key]
+		if exists && strings.Contains(q, "OR IGNORE") {
+			return driver.RowsAffected(0), nil
+		}
+		if !exists {
+			s.db.order[table] = append(s.db.order[table], key)
+		}
+		s.db.rows[table][key] = args
+	default:
+		return nil, fmt.Errorf("unexpected statement %q", q)
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+	if !strings.HasPrefix(s.query, "SELECT height, handled FROM sync_state ") {
+		return nil, fmt.Errorf("unexpected query %q", s.query)
+	}
+	rows := &fakeRows{}
+	if state, ok := s.db.states[args[0].(string)]; ok {
+		rows.values = [][]driver.Value{{state[0], state[1]}}
+	}
+	return rows, nil
+}
+
+type fakeRows struct {
+	values [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string { return []string{"height", "handled"} }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+func TestStore_Checkpointer(t *testing.T) {
+	st, _ := openFakeDB(t)
+	ctx := context.Background()
+
+	if _, ok, err := st.Load(ctx, "events"); err != nil || ok {
+		t.Fatalf("Expected no checkpoint, got ok=%v err=%v", ok, err)
+	}
+	if err := st.Save(ctx, "events", eventsync.Checkpoint{Height: 100, Handled: 2}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if err := st.Save(ctx, "events", eventsync.Checkpoint{Height: 120}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	cp, ok, err := st.Load(ctx, "events")
+	if err != nil || !ok {
+		t.Fatalf("Expected checkpoint, got ok=%v err=%v", ok, err)
+	}
+	if cp != (eventsync.Checkpoint{Height: 120}) {
+		t.Errorf("Expected height 120, got %+v", cp)
+	}
+}
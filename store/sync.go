@@ -0,0 +1,175 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/peterargue/find-api/eventsync"
+	"github.com/peterargue/find-api/flow"
+	"github.com/peterargue/find-api/simple"
+)
+
+// transfersPageSize is the page size used to walk the transfers endpoint
+const transfersPageSize = 100
+
+// SyncEvents mirrors an event type into the events table from startHeight, or
+// from where the previous sync of the type stopped, to the chain head
+func (s *Store) SyncEvents(ctx context.Context, src eventsync.Source, name string, startHeight uint64) error {
+	cfg := eventsync.Config{
+		EventName:    name,
+		StartHeight:  startHeight,
+		Checkpointer: s,
+	}
+	return eventsync.Run(ctx, src, cfg, s.insertEvent)
+}
+
+// insertEvent stores an event, ignoring one that is already stored
+func (s *Store) insertEvent(ctx context.Context, e simple.Event) error {
+	fields, err := json.Marshal(e.Fields)
+	if err != nil {
+		return fmt.Errorf("encode fields: %w", err)
+	}
+	_, err = s.db.ExecContext(ctx,
+		`INSERT OR IGNORE INTO events (name, block_height, transaction_hash, event_index, timestamp, fields)
+		VALUES (?, ?, ?, ?, ?, ?)`,
+		e.Name, e.BlockHeight, e.TransactionHash, e.EventIndex, e.Timestamp, string(fields))
+	return err
+}
+
+// SyncBlocks mirrors blocks into the blocks table from startHeight, or from
+// where the previous block sync stopped, to the chain head
+func (s *Store) SyncBlocks(ctx context.Context, svc *flow.Service, startHeight uint64) error {
+	const name = "blocks"
+	cp, ok, err := s.Load(ctx, name)
+	if err != nil {
+		return fmt.Errorf("load checkpoint: %w", err)
+	}
+	if !ok {
+		cp = eventsync.Checkpoint{Height: startHeight}
+	}
+
+	latest, err := svc.GetLatestBlock(ctx)
+	if err != nil {
+		return fmt.Errorf("fetch latest block: %w", err)
+	}
+	if cp.Height > latest.Height {
+		return nil
+	}
+
+	for blk, err := range svc.GetBlocksRange().From(cp.Height).To(latest.Height).All(ctx) {
+		if err != nil {
+			return err
+		}
+		if _, err := s.db.ExecContext(ctx,
+			`INSERT OR REPLACE INTO blocks (height, id, timestamp, tx_count, evm_tx_count, fees, total_gas)
+			VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			blk.Height, blk.ID, blk.Timestamp, blk.Tx, blk.EvmTxCount, blk.Fees, blk.TotalGasUsed); err != nil {
+			return fmt.Errorf("store block %d: %w", blk.Height, err)
+		}
+		if err := s.Save(ctx, name, eventsync.Checkpoint{Height: blk.Height + 1}); err != nil {
+			return fmt.Errorf("save checkpoint: %w", err)
+		}
+	}
+	return nil
+}
+
+// SyncFTTransfers mirrors a token's transfers into the ft_transfers table
+// from startHeight, or from where the previous sync of the token stopped, to
+// the chain head. The transfers endpoint lists newest first, so pages are
+// walked back until they pass the synced height, then stored oldest first in
+// batches, each committed together with the checkpoint it reaches.
+func (s *Store) SyncFTTransfers(ctx context.Context, svc *flow.Service, token string, startHeight uint64) error {
+	name := "ft_transfers:" + token
+	cp, ok, err := s.Load(ctx, name)
+	if err != nil {
+		return fmt.Errorf("load checkpoint: %w", err)
+	}
+	if !ok {
+		cp = eventsync.Checkpoint{Height: startHeight}
+	}
+
+	latest, err := svc.GetLatestBlock(ctx)
+	if err != nil {
+		return fmt.Errorf("fetch latest block: %w", err)
+	}
+	if cp.Height > latest.Height {
+		return nil
+	}
+
+	var transfers []flow.FTTransfer
+	for offset := 0; ; offset += transfersPageSize {
+		resp, err := svc.GetFTTransfers().Token(token).Limit(transfersPageSize).Offset(offset).Do(ctx)
+		if err != nil {
+			return fmt.Errorf("fetch transfers at offset %d: %w", offset, err)
+		}
+
+		passed := false
+		for _, t := range resp.Data {
+			if t.BlockHeight < cp.Height {
+				passed = true
+				continue
+			}
+			// Transfers above the head seen at the start are picked up by the next sync
+			if t.BlockHeight > latest.Height {
+				continue
+			}
+			transfers = append(transfers, t)
+		}
+		if passed || len(resp.Data) < transfersPageSize {
+			break
+		}
+	}
+	slices.Reverse(transfers)
+	if len(transfers) == 0 {
+		return s.Save(ctx, name, eventsync.Checkpoint{Height: latest.Height + 1})
+	}
+
+	// Batches end on a height boundary, so every height below a saved
+	// checkpoint is complete
+	for len(transfers) > 0 {
+		n := min(transfersPageSize, len(transfers))
+		for n < len(transfers) && transfers[n].BlockHeight == transfers[n-1].BlockHeight {
+			n++
+		}
+		next := latest.Height + 1
+		if n < len(transfers) {
+			next = transfers[n-1].BlockHeight + 1
+		}
+		if err := s.storeTransfers(ctx, name, token, transfers[:n], next); err != nil {
+			return err
+		}
+		transfers = transfers[n:]
+	}
+	return nil
+}
+
+// storeTransfers replaces the stored transfers at the heights of a batch,
+// sorted by height, and saves the checkpoint in the same transaction. If the
+// sync stops, no heights at or above the checkpoint are left half stored.
+func (s *Store) storeTransfers(ctx context.Context, name, token string, batch []flow.FTTransfer, next uint64) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx,
+		`DELETE FROM ft_transfers WHERE token = ? AND block_height BETWEEN ? AND ?`,
+		token, batch[0].BlockHeight, batch[len(batch)-1].BlockHeight); err != nil {
+		return fmt.Errorf("clear transfers: %w", err)
+	}
+	for _, t := range batch {
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO ft_transfers (token, block_height, transaction_id, address, direction, amount, sender, receiver, approx_usd_price, timestamp)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			token, t.BlockHeight, t.TransactionID, t.Address, t.Direction, t.Amount, t.Sender, t.Receiver, t.ApproxUSDPrice, t.Timestamp); err != nil {
+			return fmt.Errorf("store transfer %s: %w", t.TransactionID, err)
+		}
+	}
+	if _, err := tx.ExecContext(ctx, saveCheckpoint, name, next, 0); err != nil {
+		return fmt.Errorf("save checkpoint: %w", err)
+	}
+	return tx.Commit()
+}
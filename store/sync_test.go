@@ -0,0 +1,199 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/peterargue/find-api/eventsync"
+	"github.com/peterargue/find-api/findapitest"
+	"github.com/peterargue/find-api/flow"
+	"github.com/peterargue/find-api/simple"
+)
+
+const deposited = "A.1654653399040a61.FlowToken.TokensDeposited"
+
+func TestStore_SyncEvents(t *testing.T) {
+	server := findapitest.NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	server.AddBlocks(simple.Block{Height: 100}, simple.Block{Height: 110})
+	server.AddEvents(
+		simple.Event{Name: deposited, BlockHeight: 101, TransactionHash: "a", Fields: map[string]interface{}{"amount": "1.0"}},
+		simple.Event{Name: deposited, BlockHeight: 105, TransactionHash: "b", Fields: map[string]interface{}{"amount": "2.0"}},
+	)
+
+	st, fdb := openFakeDB(t)
+	ctx := context.Background()
+	src := eventsync.SimpleSource(client.Simple)
+
+	if err := st.SyncEvents(ctx, src, deposited, 100); err != nil {
+		t.Fatalf("SyncEvents failed: %v", err)
+	}
+	rows := fdb.table("events")
+	if len(rows) != 2 {
+		t.Fatalf("Expected 2 stored events, got %d", len(rows))
+	}
+	if rows[0][5] != `{"amount":"1.0"}` {
+		t.Errorf("Expected fields stored as JSON, got %v", rows[0][5])
+	}
+
+	// A second sync resumes from the checkpoint and only stores new events
+	server.AddBlocks(simple.Block{Height: 120})
+	server.AddEvents(simple.Event{Name: deposited, BlockHeight: 115, TransactionHash: "c"})
+	if err := st.SyncEvents(ctx, src, deposited, 100); err != nil {
+		t.Fatalf("SyncEvents failed: %v", err)
+	}
+	if rows := fdb.table("events"); len(rows) != 3 {
+		t.Errorf("Expected 3 stored events, got %d", len(rows))
+	}
+	cp, _, _ := st.Load(ctx, deposited)
+	if cp.Height != 121 {
+		t.Errorf("Expected checkpoint at 121, got %d", cp.Height)
+	}
+}
+
+func TestStore_SyncBlocks(t *testing.T) {
+	server := findapitest.NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	for h := uint64(1); h <= 10; h++ {
+		server.AddBlocks(simple.Block{Height: h, ID: "block"})
+	}
+
+	st, fdb := openFakeDB(t)
+	ctx := context.Background()
+
+	if err := st.SyncBlocks(ctx, client.Flow, 5); err != nil {
+		t.Fatalf("SyncBlocks failed: %v", err)
+	}
+	rows := fdb.table("blocks")
+	if len(rows) != 6 || rows[0][0] != int64(5) || rows[5][0] != int64(10) {
+		t.Fatalf("Expected blocks 5-10, got %v", rows)
+	}
+
+	server.AddBlocks(simple.Block{Height: 11}, simple.Block{Height: 12})
+	if err := st.SyncBlocks(ctx, client.Flow, 5); err != nil {
+		t.Fatalf("SyncBlocks failed: %v", err)
+	}
+	if rows := fdb.table("blocks"); len(rows) != 8 {
+		t.Errorf("Expected 8 stored blocks, got %d", len(rows))
+	}
+}
+
+func TestStore_SyncFTTransfers(t *testing.T) {
+	server := findapitest.NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	server.AddBlocks(simple.Block{Height: 200})
+	token := "A.1654653399040a61.FlowToken"
+	transfers := flow.TransfersResponse{Data: []flow.FTTransfer{
+		{BlockHeight: 210, TransactionID: "future", Amount: 1},
+		{BlockHeight: 190, TransactionID: "tx3", Amount: 3, Address: "0x01", Direction: "in"},
+		{BlockHeight: 150, TransactionID: "tx2", Amount: 2, Address: "0x01", Direction: "in"},
+		{BlockHeight: 90, TransactionID: "tx1", Amount: 1, Address: "0x01", Direction: "in"},
+	}}
+	body, _ := json.Marshal(transfers)
+	server.AddFixture("GET /flow/v1/ft/transfer", http.StatusOK, body)
+
+	st, fdb := openFakeDB(t)
+	ctx := context.Background()
+
+	if err := st.SyncFTTransfers(ctx, client.Flow, token, 100); err != nil {
+		t.Fatalf("SyncFTTransfers failed: %v", err)
+	}
+	rows := fdb.table("ft_transfers")
+	if len(rows) != 2 || rows[0][2] != "tx2" || rows[1][2] != "tx3" {
+		t.Fatalf("Expected transfers tx2 and tx3, got %v", rows)
+	}
+	cp, _, _ := st.Load(ctx, "ft_transfers:"+token)
+	if cp.Height != 201 {
+		t.Errorf("Expected checkpoint at 201, got %d", cp.Height)
+	}
+
+	// Re-syncing with the same data stores nothing new
+	if err := st.SyncFTTransfers(ctx, client.Flow, token, 100); err != nil {
+		t.Fatalf("SyncFTTransfers failed: %v", err)
+	}
+	if rows := fdb.table("ft_transfers"); len(rows) != 2 {
+		t.Errorf("Expected 2 stored transfers, got %d", len(rows))
+	}
+}
+
+func TestStore_SyncFTTransfersEqualTransfers(t *testing.T) {
+	server := findapitest.NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	server.AddBlocks(simple.Block{Height: 200})
+	token := "A.1654653399040a61.FlowToken"
+	// One transaction paying the same amount to the same account twice
+	payment := flow.FTTransfer{BlockHeight: 150, TransactionID: "tx1", Amount: 5, Address: "0x01", Direction: "in", Sender: "0x02", Receiver: "0x01"}
+	body, _ := json.Marshal(flow.TransfersResponse{Data: []flow.FTTransfer{payment, payment}})
+	server.AddFixture("GET /flow/v1/ft/transfer", http.StatusOK, body)
+
+	st, fdb := openFakeDB(t)
+	if err := st.SyncFTTransfers(context.Background(), client.Flow, token, 100); err != nil {
+		t.Fatalf("SyncFTTransfers failed: %v", err)
+	}
+	rows := fdb.table("ft_transfers")
+	if len(rows) != 2 {
+		t.Fatalf("Expected both equal transfers stored, got %d", len(rows))
+	}
+	var total float64
+	for _, row := range rows {
+		total += row[5].(float64)
+	}
+	if total != 10 {
+		t.Errorf("Expected a total of 10, got %v", total)
+	}
+}
+
+func TestStore_SyncFTTransfersCheckpointsBatches(t *testing.T) {
+	server := findapitest.NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	server.AddBlocks(simple.Block{Height: 300})
+	token := "A.1654653399040a61.FlowToken"
+	// 150 transfers at heights 101-250, newest first, then one already synced
+	var transfers []flow.FTTransfer
+	for h := uint64(250); h > 100; h-- {
+		transfers = append(transfers, flow.FTTransfer{BlockHeight: h, TransactionID: fmt.Sprint("tx", h), Amount: 1})
+	}
+	transfers = append(transfers, flow.FTTransfer{BlockHeight: 90, TransactionID: "old", Amount: 1})
+	body, _ := json.Marshal(flow.TransfersResponse{Data: transfers})
+	server.AddFixture("GET /flow/v1/ft/transfer", http.StatusOK, body)
+
+	st, fdb := openFakeDB(t)
+	ctx := context.Background()
+	name := "ft_transfers:" + token
+
+	// A failure in the second batch keeps the first batch's checkpoint
+	fdb.failHeight = 220
+	if err := st.SyncFTTransfers(ctx, client.Flow, token, 100); err == nil {
+		t.Fatal("Expected error for failed insert")
+	}
+	cp, _, _ := st.Load(ctx, name)
+	if cp.Height != 201 {
+		t.Errorf("Expected checkpoint at 201, got %d", cp.Height)
+	}
+
+	// Resuming replaces the partly stored heights instead of duplicating them
+	fdb.failHeight = 0
+	if err := st.SyncFTTransfers(ctx, client.Flow, token, 100); err != nil {
+		t.Fatalf("SyncFTTransfers failed: %v", err)
+	}
+	if rows := fdb.table("ft_transfers"); len(rows) != 150 {
+		t.Errorf("Expected 150 stored transfers, got %d", len(rows))
+	}
+	cp, _, _ = st.Load(ctx, name)
+	if cp.Height != 301 {
+		t.Errorf("Expected checkpoint at 301, got %d", cp.Height)
+	}
+}
@@ -0,0 +1,104 @@
+package findapi
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrNoToken is returned by TokenInfo when the client holds no token
+var ErrNoToken = errors.New("no token has been issued")
+
+// TokenInfo holds the claims of the client's current JWT. The claims are read
+// locally without verifying the signature, which only the API can do.
+type TokenInfo struct {
+	Subject   string
+	Issuer    string
+	Scopes    []string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// Expired reports whether the token has expired
+func (t TokenInfo) Expired() bool {
+	return !t.ExpiresAt.IsZero() && !time.Now().Before(t.ExpiresAt)
+}
+
+// TokenInfo returns the claims of the token the client currently authenticates
+// with, or ErrNoToken if none has been issued yet
+func (c *Client) TokenInfo() (*TokenInfo, error) {
+	c.tokenMu.RLock()
+	token := c.accessToken
+	c.tokenMu.RUnlock()
+
+	if token == "" {
+		return nil, ErrNoToken
+	}
+	return parseToken(token)
+}
+
+// jwtClaims are the registered and scope claims of a JWT payload
+type jwtClaims struct {
+	Subject  string          `json:"sub"`
+	Issuer   string          `json:"iss"`
+	IssuedAt int64           `json:"iat"`
+	Expiry   int64           `json:"exp"`
+	Scope    json.RawMessage `json:"scope"`
+	Scopes   []string        `json:"scopes"`
+}
+
+// parseToken decodes the payload of a JWT
+func parseToken(token string) (*TokenInfo, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("malformed token: expected 3 segments, got %d", len(parts))
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, fmt.Errorf("malformed token payload: %w", err)
+	}
+
+	var claims jwtClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("malformed token claims: %w", err)
+	}
+
+	info := &TokenInfo{
+		Subject: claims.Subject,
+		Issuer:  claims.Issuer,
+		Scopes:  claims.Scopes,
+	}
+	if claims.IssuedAt > 0 {
+		info.IssuedAt = time.Unix(claims.IssuedAt, 0)
+	}
+	if claims.Expiry > 0 {
+		info.ExpiresAt = time.Unix(claims.Expiry, 0)
+	}
+
+	// The scope claim is a space separated string (RFC 8693) or a list
+	if len(claims.Scope) > 0 {
+		var scope string
+		if err := json.Unmarshal(claims.Scope, &scope); err == nil {
+			info.Scopes = append(info.Scopes, strings.Fields(scope)...)
+		} else {
+			var scopes []string
+			if err := json.Unmarshal(claims.Scope, &scopes); err != nil {
+				return nil, fmt.Errorf("malformed token scope claim: %w", err)
+			}
+			info.Scopes = append(info.Scopes, scopes...)
+		}
+	}
+	return info, nil
+}
+
+// tokenExpiry returns when a newly issued token expires, preferring the
+// token's own exp claim over the expiry reported alongside it
+func tokenExpiry(token string, reported int64) time.Time {
+	if info, err := parseToken(token); err == nil && !info.ExpiresAt.IsZero() {
+		return info.ExpiresAt
+	}
+	return time.Unix(reported, 0)
+}
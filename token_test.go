@@ -0,0 +1,111 @@
+package findapi
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// testJWT builds an unsigned JWT carrying the given claims JSON
+func testJWT(claims string) string {
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`)) + "." + enc.EncodeToString([]byte(claims)) + ".sig"
+}
+
+func TestClient_TokenInfo(t *testing.T) {
+	client := NewClient("", "")
+	if _, err := client.TokenInfo(); !errors.Is(err, ErrNoToken) {
+		t.Errorf("Expected ErrNoToken, got %v", err)
+	}
+
+	exp := time.Now().Add(time.Hour).Unix()
+	token := testJWT(fmt.Sprintf(`{"sub":"alice","iss":"find","iat":1700000000,"exp":%d,"scope":"read:flow read:market"}`, exp))
+	client = NewClient("", "", WithToken(token, exp))
+
+	info, err := client.TokenInfo()
+	if err != nil {
+		t.Fatalf("TokenInfo failed: %v", err)
+	}
+	if info.Subject != "alice" || info.Issuer != "find" {
+		t.Errorf("Expected subject alice from find, got %s from %s", info.Subject, info.Issuer)
+	}
+	if len(info.Scopes) != 2 || info.Scopes[1] != "read:market" {
+		t.Errorf("Expected 2 scopes, got %v", info.Scopes)
+	}
+	if info.IssuedAt.Unix() != 1700000000 || info.ExpiresAt.Unix() != exp {
+		t.Errorf("Expected iat 1700000000 and exp %d, got %v and %v", exp, info.IssuedAt, info.ExpiresAt)
+	}
+	if info.Expired() {
+		t.Error("Expected token not to be expired")
+	}
+
+	if _, err := parseToken("not-a-jwt"); err == nil {
+		t.Error("Expected error for malformed token")
+	}
+}
+
+func TestClient_TokenExpiryFromClaims(t *testing.T) {
+	generated := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/v1/generate" {
+			generated++
+			// The response claims 10 minutes, but the token itself expires within the refresh buffer
+			token := testJWT(fmt.Sprintf(`{"sub":"alice","exp":%d}`, time.Now().Add(30*time.Second).Unix()))
+			fmt.Fprintf(w, `{"access_token":%q,"exp":%d}`, token, time.Now().Add(10*time.Minute).Unix())
+			return
+		}
+		w.Write([]byte(`{"blocks":[]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("user", "pass", WithBaseURL(server.URL))
+	ctx := context.Background()
+	for range 2 {
+		if _, err := client.Simple.GetBlocks().Height(1).Do(ctx); err != nil {
+			t.Fatalf("GetBlocks failed: %v", err)
+		}
+	}
+	if generated != 2 {
+		t.Errorf("Expected the token's exp claim to force a refresh, got %d token requests", generated)
+	}
+}
+
+func TestClient_Close(t *testing.T) {
+	var generated int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/v1/generate" {
+			generated++
+			fmt.Fprintf(w, `{"access_token":"access","exp":%d}`, time.Now().Add(10*time.Minute).Unix())
+			return
+		}
+		w.Write([]byte(`{"blocks":[]}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	client := NewClient("user", "pass", WithBaseURL(server.URL))
+	if _, err := client.Simple.GetBlocks().Height(1).Do(ctx); err != nil {
+		t.Fatalf("GetBlocks failed: %v", err)
+	}
+
+	// Close discards the token locally
+	if err := client.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if _, err := client.TokenInfo(); !errors.Is(err, ErrNoToken) {
+		t.Errorf("Expected cached token cleared, got %v", err)
+	}
+
+	// The client stays usable and generates a new token
+	if _, err := client.Simple.GetBlocks().Height(1).Do(ctx); err != nil {
+		t.Fatalf("GetBlocks failed: %v", err)
+	}
+	if generated != 2 {
+		t.Errorf("Expected 2 token requests, got %d", generated)
+	}
+}
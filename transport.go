@@ -0,0 +1,162 @@
+package findapi
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// transportConfig holds transport options, resolved once all options are applied
+// so they compose regardless of order (including with WithHTTPClient)
+type transportConfig struct {
+	transport http.RoundTripper
+	proxy     *url.URL
+	tlsConfig *tls.Config
+	pins      []string
+}
+
+// WithTransport sets the RoundTripper used for requests (e.g. a tuned *http.Transport
+// for connection pooling or HTTP/2). WithProxy and WithTLSConfig are applied on top
+// when the transport is an *http.Transport.
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(c *Client) {
+		c.transportConfig.transport = transport
+	}
+}
+
+// WithProxy routes all requests through the given proxy, overriding the environment
+// (HTTP_PROXY/HTTPS_PROXY) configuration
+func WithProxy(proxyURL *url.URL) ClientOption {
+	return func(c *Client) {
+		c.transportConfig.proxy = proxyURL
+	}
+}
+
+// WithTLSConfig sets the TLS configuration used for requests (e.g. custom root CAs or
+// client certificates for mTLS)
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(c *Client) {
+		c.transportConfig.tlsConfig = config
+	}
+}
+
+// ErrCertificatePinMismatch is returned when no certificate presented by the
+// server matches a pin configured with WithPinnedCertificates
+var ErrCertificatePinMismatch = errors.New("no server certificate matches a pinned public key")
+
+// WithPinnedCertificates enforces SPKI pinning: connections are rejected unless
+// a certificate in the server's chain has one of the given public key pins. Pins
+// are base64 SHA-256 hashes of the SubjectPublicKeyInfo, optionally prefixed
+// with "sha256/" (see SPKIPin). Only verified chains are checked; with
+// InsecureSkipVerify the leaf certificate itself must be pinned. Pinning is
+// applied in addition to normal certificate verification and requires an
+// *http.Transport.
+func WithPinnedCertificates(pins ...string) ClientOption {
+	return func(c *Client) {
+		for _, p := range pins {
+			c.transportConfig.pins = append(c.transportConfig.pins, strings.TrimPrefix(strings.TrimSpace(p), "sha256/"))
+		}
+	}
+}
+
+// SPKIPin returns the pin of a certificate's public key in the format accepted
+// by WithPinnedCertificates
+func SPKIPin(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return "sha256/" + base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// verifyPins returns a tls.Config.VerifyConnection func that accepts a connection
+// only if a verified chain contains a certificate matching one of pins. The raw
+// PeerCertificates are untrusted (a peer can append any public certificate to its
+// chain), so when verification is skipped only the leaf is compared.
+func verifyPins(pins []string) func(tls.ConnectionState) error {
+	allowed := make(map[string]bool, len(pins))
+	for _, p := range pins {
+		allowed[p] = true
+	}
+	pinned := func(cert *x509.Certificate) bool {
+		return allowed[strings.TrimPrefix(SPKIPin(cert), "sha256/")]
+	}
+	return func(cs tls.ConnectionState) error {
+		if len(cs.VerifiedChains) == 0 {
+			if len(cs.PeerCertificates) > 0 && pinned(cs.PeerCertificates[0]) {
+				return nil
+			}
+			return fmt.Errorf("%w (%s)", ErrCertificatePinMismatch, cs.ServerName)
+		}
+		for _, chain := range cs.VerifiedChains {
+			for _, cert := range chain {
+				if pinned(cert) {
+					return nil
+				}
+			}
+		}
+		return fmt.Errorf("%w (%s)", ErrCertificatePinMismatch, cs.ServerName)
+	}
+}
+
+// errTransport fails every request, so misconfigured security options fail closed
+type errTransport struct{ err error }
+
+func (t errTransport) RoundTrip(*http.Request) (*http.Response, error) { return nil, t.err }
+
+// configureTransport installs the configured transport on a copy of the HTTP client.
+// Proxy and TLS settings require an *http.Transport and are ignored for other RoundTrippers,
+// except pinning, which fails every request rather than silently going unenforced.
+func (c *Client) configureTransport() {
+	cfg := c.transportConfig
+	if cfg.transport == nil && cfg.proxy == nil && cfg.tlsConfig == nil && len(cfg.pins) == 0 {
+		return
+	}
+
+	rt := cfg.transport
+	if rt == nil {
+		rt = c.httpClient.Transport
+	}
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+
+	if cfg.proxy != nil || cfg.tlsConfig != nil || len(cfg.pins) > 0 {
+		if t, ok := rt.(*http.Transport); ok {
+			t = t.Clone()
+			if cfg.proxy != nil {
+				t.Proxy = http.ProxyURL(cfg.proxy)
+			}
+			if cfg.tlsConfig != nil {
+				t.TLSClientConfig = cfg.tlsConfig.Clone()
+			}
+			if len(cfg.pins) > 0 {
+				if t.TLSClientConfig == nil {
+					t.TLSClientConfig = &tls.Config{}
+				}
+				verifyPinned := verifyPins(cfg.pins)
+				if verify := t.TLSClientConfig.VerifyConnection; verify != nil {
+					t.TLSClientConfig.VerifyConnection = func(cs tls.ConnectionState) error {
+						if err := verify(cs); err != nil {
+							return err
+						}
+						return verifyPinned(cs)
+					}
+				} else {
+					t.TLSClientConfig.VerifyConnection = verifyPinned
+				}
+			}
+			rt = t
+		} else if len(cfg.pins) > 0 {
+			rt = errTransport{err: errors.New("certificate pinning requires an *http.Transport")}
+		}
+	}
+
+	// Copy so a client passed via WithHTTPClient is not mutated
+	hc := *c.httpClient
+	hc.Transport = rt
+	c.httpClient = &hc
+}
@@ -0,0 +1,202 @@
+package findapi
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+type countingTransport struct {
+	calls atomic.Int32
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.calls.Add(1)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestClient_WithTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	rt := &countingTransport{}
+	client := NewClient("", "", WithBaseURL(server.URL), WithTransport(rt))
+
+	resp, err := client.DoRequest(context.Background(), http.MethodGet, "/public/v1/resolver", nil)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if rt.calls.Load() != 1 {
+		t.Errorf("Expected 1 call through custom transport, got %d", rt.calls.Load())
+	}
+}
+
+func TestClient_WithProxy(t *testing.T) {
+	var proxied atomic.Int32
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied.Add(1)
+		if r.URL.Host != "api.example.invalid" {
+			t.Errorf("Expected proxied host api.example.invalid, got %s", r.URL.Host)
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer proxy.Close()
+
+	proxyURL, _ := url.Parse(proxy.URL)
+	httpClient := &http.Client{}
+	client := NewClient("", "",
+		WithBaseURL("http://api.example.invalid"),
+		WithProxy(proxyURL),
+		WithHTTPClient(httpClient),
+	)
+
+	resp, err := client.DoRequest(context.Background(), http.MethodGet, "/public/v1/resolver", nil)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if proxied.Load() != 1 {
+		t.Errorf("Expected 1 proxied request, got %d", proxied.Load())
+	}
+	if httpClient.Transport != nil {
+		t.Error("Expected caller's HTTP client to be left unmodified")
+	}
+}
+
+func TestClient_WithTLSConfig(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+
+	// Without the server's CA the handshake must fail
+	client := NewClient("", "", WithBaseURL(server.URL))
+	if _, err := client.DoRequest(ctx, http.MethodGet, "/public/v1/resolver", nil); err == nil {
+		t.Fatal("Expected certificate verification error")
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+	client = NewClient("", "", WithBaseURL(server.URL), WithTLSConfig(&tls.Config{RootCAs: pool}))
+
+	resp, err := client.DoRequest(ctx, http.MethodGet, "/public/v1/resolver", nil)
+	if err != nil {
+		t.Fatalf("Request with custom CA failed: %v", err)
+	}
+	resp.Body.Close()
+}
+
+func TestClient_WithPinnedCertificates(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+	tlsConfig := &tls.Config{RootCAs: pool}
+
+	client := NewClient("", "",
+		WithBaseURL(server.URL),
+		WithTLSConfig(tlsConfig),
+		WithPinnedCertificates(SPKIPin(server.Certificate())),
+	)
+	resp, err := client.DoRequest(ctx, http.MethodGet, "/public/v1/resolver", nil)
+	if err != nil {
+		t.Fatalf("Request with matching pin failed: %v", err)
+	}
+	resp.Body.Close()
+
+	// A valid certificate with the wrong pin must be rejected
+	client = NewClient("", "",
+		WithBaseURL(server.URL),
+		WithTLSConfig(tlsConfig),
+		WithPinnedCertificates("sha256/AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="),
+	)
+	_, err = client.DoRequest(ctx, http.MethodGet, "/public/v1/resolver", nil)
+	if !errors.Is(err, ErrCertificatePinMismatch) {
+		t.Errorf("Expected ErrCertificatePinMismatch, got %v", err)
+	}
+	if tlsConfig.VerifyConnection != nil {
+		t.Error("Expected caller's TLS config to be left unmodified")
+	}
+
+	// Pinning cannot be enforced on a custom RoundTripper, so requests fail closed
+	client = NewClient("", "",
+		WithBaseURL(server.URL),
+		WithTransport(&countingTransport{}),
+		WithPinnedCertificates(SPKIPin(server.Certificate())),
+	)
+	if _, err := client.DoRequest(ctx, http.MethodGet, "/public/v1/resolver", nil); err == nil {
+		t.Error("Expected error for pinning on a non-*http.Transport")
+	}
+}
+
+func TestVerifyPins(t *testing.T) {
+	leaf := &x509.Certificate{RawSubjectPublicKeyInfo: []byte("leaf")}
+	root := &x509.Certificate{RawSubjectPublicKeyInfo: []byte("root")}
+	pinned := &x509.Certificate{RawSubjectPublicKeyInfo: []byte("pinned")}
+	verify := verifyPins([]string{strings.TrimPrefix(SPKIPin(pinned), "sha256/")})
+
+	tests := []struct {
+		name    string
+		state   tls.ConnectionState
+		wantErr bool
+	}{
+		{
+			name: "pinned cert in verified chain",
+			state: tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{leaf, pinned},
+				VerifiedChains:   [][]*x509.Certificate{{leaf, pinned, root}},
+			},
+		},
+		{
+			name: "pinned cert appended to unrelated chain",
+			state: tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{leaf, pinned},
+				VerifiedChains:   [][]*x509.Certificate{{leaf, root}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unverified pinned leaf",
+			state: tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{pinned},
+			},
+		},
+		{
+			name: "unverified pinned intermediate",
+			state: tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{leaf, pinned},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verify(tt.state)
+			if tt.wantErr && !errors.Is(err, ErrCertificatePinMismatch) {
+				t.Errorf("Expected ErrCertificatePinMismatch, got %v", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
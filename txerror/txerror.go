@@ -0,0 +1,224 @@
+// Package txerror decodes Flow transaction execution errors into typed codes.
+//
+// The API reports a failed transaction's error as an error_code field and a
+// free-form error message that usually starts with "[Error Code: NNNN]". Parse
+// normalises both into an Error, and the Is* helpers classify common failures.
+package txerror
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Code is an FVM error code
+type Code int
+
+// Transaction validation errors (1000 - 1049)
+const (
+	CodeTxValidation             Code = 1000
+	CodeInvalidTxByteSize        Code = 1001
+	CodeInvalidReferenceBlock    Code = 1002
+	CodeExpiredTransaction       Code = 1003
+	CodeInvalidScript            Code = 1004
+	CodeInvalidGasLimit          Code = 1005
+	CodeInvalidProposalSignature Code = 1006
+	CodeInvalidProposalSeqNumber Code = 1007
+	CodeInvalidPayloadSignature  Code = 1008
+	CodeInvalidEnvelopeSignature Code = 1009
+)
+
+// Base errors (1050 - 1099)
+const (
+	CodeFVMInternal            Code = 1050
+	CodeValue                  Code = 1051
+	CodeInvalidArgument        Code = 1052
+	CodeInvalidAddress         Code = 1053
+	CodeInvalidLocation        Code = 1054
+	CodeAccountAuthorization   Code = 1055
+	CodeOperationAuthorization Code = 1056
+	CodeOperationNotSupported  Code = 1057
+	CodeBlockHeightOutOfRange  Code = 1058
+)
+
+// Execution errors (1100 - 1199)
+const (
+	CodeExecution                      Code = 1100
+	CodeCadenceRuntime                 Code = 1101
+	CodeEncodingUnsupportedValue       Code = 1102
+	CodeStorageCapacityExceeded        Code = 1103
+	CodeGasLimitExceeded               Code = 1104
+	CodeEventLimitExceeded             Code = 1105
+	CodeLedgerInteractionLimitExceeded Code = 1106
+	CodeStateKeySizeLimit              Code = 1107
+	CodeStateValueSizeLimit            Code = 1108
+	CodeTransactionFeeDeductionFailed  Code = 1109
+	CodeComputationLimitExceeded       Code = 1110
+	CodeMemoryLimitExceeded            Code = 1111
+	CodeCouldNotDecodeExecutionParam   Code = 1112
+	CodeScriptExecutionTimedOut        Code = 1113
+	CodeScriptExecutionCancelled       Code = 1114
+	CodeEventEncoding                  Code = 1115
+	CodeInvalidInternalStateAccess     Code = 1116
+	CodeInsufficientPayerBalance       Code = 1118
+)
+
+// Account errors (1200 - 1249)
+const (
+	CodeAccount                      Code = 1200
+	CodeAccountNotFound              Code = 1201
+	CodeAccountPublicKeyNotFound     Code = 1202
+	CodeAccountAlreadyExists         Code = 1203
+	CodeFrozenAccount                Code = 1204
+	CodeAccountStorageNotInitialized Code = 1205
+	CodeAccountPublicKeyLimit        Code = 1206
+)
+
+// Contract and standard library errors (1250+)
+const (
+	CodeContract              Code = 1250
+	CodeContractNotFound      Code = 1251
+	CodeContractNamesNotFound Code = 1252
+	CodeEVM                   Code = 1300
+)
+
+var codeNames = map[Code]string{
+	CodeTxValidation:                   "transaction validation error",
+	CodeInvalidTxByteSize:              "invalid transaction byte size",
+	CodeInvalidReferenceBlock:          "invalid reference block",
+	CodeExpiredTransaction:             "expired transaction",
+	CodeInvalidScript:                  "invalid script",
+	CodeInvalidGasLimit:                "invalid gas limit",
+	CodeInvalidProposalSignature:       "invalid proposal signature",
+	CodeInvalidProposalSeqNumber:       "invalid proposal sequence number",
+	CodeInvalidPayloadSignature:        "invalid payload signature",
+	CodeInvalidEnvelopeSignature:       "invalid envelope signature",
+	CodeFVMInternal:                    "FVM internal error",
+	CodeValue:                          "value error",
+	CodeInvalidArgument:                "invalid argument",
+	CodeInvalidAddress:                 "invalid address",
+	CodeInvalidLocation:                "invalid location",
+	CodeAccountAuthorization:           "account authorization error",
+	CodeOperationAuthorization:         "operation authorization error",
+	CodeOperationNotSupported:          "operation not supported",
+	CodeBlockHeightOutOfRange:          "block height out of range",
+	CodeExecution:                      "execution error",
+	CodeCadenceRuntime:                 "Cadence runtime error",
+	CodeEncodingUnsupportedValue:       "encoding unsupported value",
+	CodeStorageCapacityExceeded:        "storage capacity exceeded",
+	CodeGasLimitExceeded:               "gas limit exceeded",
+	CodeEventLimitExceeded:             "event limit exceeded",
+	CodeLedgerInteractionLimitExceeded: "ledger interaction limit exceeded",
+	CodeStateKeySizeLimit:              "state key size limit",
+	CodeStateValueSizeLimit:            "state value size limit",
+	CodeTransactionFeeDeductionFailed:  "transaction fee deduction failed",
+	CodeComputationLimitExceeded:       "computation limit exceeded",
+	CodeMemoryLimitExceeded:            "memory limit exceeded",
+	CodeCouldNotDecodeExecutionParam:   "could not decode execution parameter",
+	CodeScriptExecutionTimedOut:        "script execution timed out",
+	CodeScriptExecutionCancelled:       "script execution cancelled",
+	CodeEventEncoding:                  "event encoding error",
+	CodeInvalidInternalStateAccess:     "invalid internal state access",
+	CodeInsufficientPayerBalance:       "insufficient payer balance",
+	CodeAccount:                        "account error",
+	CodeAccountNotFound:                "account not found",
+	CodeAccountPublicKeyNotFound:       "account public key not found",
+	CodeAccountAlreadyExists:           "account already exists",
+	CodeFrozenAccount:                  "frozen account",
+	CodeAccountStorageNotInitialized:   "account storage not initialized",
+	CodeAccountPublicKeyLimit:          "account public key limit",
+	CodeContract:                       "contract error",
+	CodeContractNotFound:               "contract not found",
+	CodeContractNamesNotFound:          "contract names not found",
+	CodeEVM:                            "EVM error",
+}
+
+// String returns a human readable name for the code
+func (c Code) String() string {
+	if name, ok := codeNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("error code %d", int(c))
+}
+
+// Error is a decoded transaction execution error
+type Error struct {
+	// Code is the FVM error code, or 0 if none could be determined
+	Code Code
+	// Message is the error message with any "[Error Code: NNNN]" prefix removed
+	Message string
+}
+
+func (e *Error) Error() string {
+	if e.Code == 0 {
+		return e.Message
+	}
+	return fmt.Sprintf("[Error Code: %d] %s", int(e.Code), e.Message)
+}
+
+// Source is implemented by transaction models that can report an execution error
+type Source interface {
+	ExecutionError() *Error
+}
+
+var codePrefix = regexp.MustCompile(`^\s*\[Error Code:\s*(\d+)\]\s*`)
+
+// Parse decodes a transaction's error_code and error fields. The code is taken
+// from the error_code field when it is numeric, otherwise from the message
+// prefix. Returns nil if both are empty.
+func Parse(code, message string) *Error {
+	code = strings.TrimSpace(code)
+	if code == "" && strings.TrimSpace(message) == "" {
+		return nil
+	}
+
+	e := &Error{Message: strings.TrimSpace(message)}
+	if m := codePrefix.FindStringSubmatch(message); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		e.Code = Code(n)
+		e.Message = strings.TrimSpace(message[len(m[0]):])
+	}
+	if n, err := strconv.Atoi(code); err == nil {
+		e.Code = Code(n)
+	}
+	return e
+}
+
+// HasCode reports whether the transaction failed with the given code
+func HasCode(tx Source, code Code) bool {
+	e := tx.ExecutionError()
+	return e != nil && e.Code == code
+}
+
+// IsInsufficientBalance reports whether the transaction failed because the
+// payer could not cover fees or a vault withdrawal exceeded its balance
+func IsInsufficientBalance(tx Source) bool {
+	e := tx.ExecutionError()
+	if e == nil {
+		return false
+	}
+	if e.Code == CodeInsufficientPayerBalance || e.Code == CodeTransactionFeeDeductionFailed {
+		return true
+	}
+	msg := strings.ToLower(e.Message)
+	return strings.Contains(msg, "insufficient balance") ||
+		strings.Contains(msg, "less than or equal than the balance") ||
+		strings.Contains(msg, "less than or equal to the balance")
+}
+
+// IsStorageExceeded reports whether the transaction failed because an account exceeded its storage capacity
+func IsStorageExceeded(tx Source) bool {
+	return HasCode(tx, CodeStorageCapacityExceeded)
+}
+
+// IsComputationLimitExceeded reports whether the transaction ran out of computation
+func IsComputationLimitExceeded(tx Source) bool {
+	e := tx.ExecutionError()
+	return e != nil && (e.Code == CodeComputationLimitExceeded || e.Code == CodeGasLimitExceeded)
+}
+
+// IsCadenceRuntimeError reports whether the transaction failed with a Cadence runtime error (e.g. a failed pre-condition or panic)
+func IsCadenceRuntimeError(tx Source) bool {
+	return HasCode(tx, CodeCadenceRuntime)
+}
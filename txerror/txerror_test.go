@@ -0,0 +1,101 @@
+package txerror
+
+import "testing"
+
+type fakeTx struct {
+	code    string
+	message string
+}
+
+func (f fakeTx) ExecutionError() *Error {
+	return Parse(f.code, f.message)
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    string
+		message string
+		want    *Error
+	}{
+		{name: "success", want: nil},
+		{
+			name:    "prefix only",
+			message: "[Error Code: 1103] The account with address (0x1) uses 101 bytes of storage which is over its capacity",
+			want:    &Error{Code: CodeStorageCapacityExceeded, Message: "The account with address (0x1) uses 101 bytes of storage which is over its capacity"},
+		},
+		{
+			name:    "code field wins",
+			code:    "1118",
+			message: "[Error Code: 1101] payer has insufficient balance",
+			want:    &Error{Code: CodeInsufficientPayerBalance, Message: "payer has insufficient balance"},
+		},
+		{
+			name: "code only",
+			code: "1110",
+			want: &Error{Code: CodeComputationLimitExceeded},
+		},
+		{
+			name:    "no code",
+			message: "something went wrong",
+			want:    &Error{Message: "something went wrong"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Parse(tt.code, tt.message)
+			if tt.want == nil {
+				if got != nil {
+					t.Fatalf("Expected nil, got %+v", got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatal("Expected error, got nil")
+			}
+			if got.Code != tt.want.Code {
+				t.Errorf("Expected code %d, got %d", tt.want.Code, got.Code)
+			}
+			if got.Message != tt.want.Message {
+				t.Errorf("Expected message %q, got %q", tt.want.Message, got.Message)
+			}
+		})
+	}
+}
+
+func TestHelpers(t *testing.T) {
+	vault := fakeTx{message: "[Error Code: 1101] error caused by: pre-condition failed: Amount withdrawn must be less than or equal than the balance of the Vault"}
+	if !IsInsufficientBalance(vault) {
+		t.Error("Expected vault withdrawal failure to be insufficient balance")
+	}
+	if !IsCadenceRuntimeError(vault) {
+		t.Error("Expected vault withdrawal failure to be a Cadence runtime error")
+	}
+
+	storage := fakeTx{code: "1103", message: "storage capacity exceeded"}
+	if !IsStorageExceeded(storage) {
+		t.Error("Expected storage exceeded")
+	}
+	if IsInsufficientBalance(storage) {
+		t.Error("Expected storage failure not to be insufficient balance")
+	}
+
+	ok := fakeTx{}
+	if IsInsufficientBalance(ok) || IsStorageExceeded(ok) || IsComputationLimitExceeded(ok) {
+		t.Error("Expected successful transaction to match no helpers")
+	}
+}
+
+func TestError(t *testing.T) {
+	e := Parse("1103", "over capacity")
+	if e.Error() != "[Error Code: 1103] over capacity" {
+		t.Errorf("Expected formatted error, got %q", e.Error())
+	}
+	if CodeStorageCapacityExceeded.String() != "storage capacity exceeded" {
+		t.Errorf("Expected code name, got %q", CodeStorageCapacityExceeded.String())
+	}
+	if Code(9999).String() != "error code 9999" {
+		t.Errorf("Expected fallback name, got %q", Code(9999).String())
+	}
+}
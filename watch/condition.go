@@ -0,0 +1,165 @@
+package watch
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	findapi "github.com/peterargue/find-api"
+	"github.com/peterargue/find-api/flow"
+)
+
+// pageSize is the number of records requested per page
+const pageSize = 100
+
+// Condition matches activity in a range of blocks
+type Condition interface {
+	// Name identifies the condition in notifications and errors
+	Name() string
+	// Check returns a notification for each match sealed between fromHeight
+	// and toHeight, inclusive
+	Check(ctx context.Context, client *findapi.Client, fromHeight, toHeight uint64) ([]Notification, error)
+}
+
+// eventCondition matches every event of a type
+type eventCondition struct {
+	eventName string
+}
+
+// Event matches every emitted event of a type, e.g.
+// A.1654653399040a61.FlowToken.TokensDeposited. The payload is a simple.Event.
+func Event(eventName string) Condition {
+	return eventCondition{eventName: eventName}
+}
+
+func (c eventCondition) Name() string {
+	return "event " + c.eventName
+}
+
+func (c eventCondition) Check(ctx context.Context, client *findapi.Client, fromHeight, toHeight uint64) ([]Notification, error) {
+	var matches []Notification
+	for offset := 0; ; offset += pageSize {
+		resp, err := client.Simple.GetEvents().Name(c.eventName).FromHeight(fromHeight).ToHeight(toHeight).Offset(offset).Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range resp.Events {
+			matches = append(matches, Notification{
+				ID:            fmt.Sprintf("%s/%s/%d", e.Name, e.TransactionHash, e.EventIndex),
+				BlockHeight:   e.BlockHeight,
+				TransactionID: e.TransactionHash,
+				Payload:       e,
+			})
+		}
+		if len(resp.Events) < pageSize {
+			return matches, nil
+		}
+	}
+}
+
+// accountCondition matches transactions involving an account
+type accountCondition struct {
+	address string
+}
+
+// AccountActivity matches every transaction an account takes part in, as
+// payer, proposer or authorizer. The payload is a flow.AccountTransaction.
+func AccountActivity(address string) Condition {
+	return accountCondition{address: address}
+}
+
+func (c accountCondition) Name() string {
+	return "account " + c.address
+}
+
+func (c accountCondition) Check(ctx context.Context, client *findapi.Client, fromHeight, toHeight uint64) ([]Notification, error) {
+	var matches []Notification
+	err := walkBack(fromHeight, func(offset int) ([]flow.AccountTransaction, error) {
+		resp, err := client.Flow.GetAccountTransactions().Address(c.address).Limit(pageSize).Offset(offset).Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data, nil
+	}, func(tx flow.AccountTransaction) uint64 { return tx.BlockHeight }, func(tx flow.AccountTransaction) {
+		if tx.BlockHeight > toHeight {
+			return
+		}
+		matches = append(matches, Notification{
+			ID:            fmt.Sprintf("account/%s/%s", strings.ToLower(c.address), tx.TransactionID),
+			BlockHeight:   tx.BlockHeight,
+			TransactionID: tx.TransactionID,
+			Payload:       tx,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(matches)
+	return matches, nil
+}
+
+// transferCondition matches large transfers of a token
+type transferCondition struct {
+	token     string
+	minAmount float64
+}
+
+// TransferAbove matches transfers of a token, e.g. A.1654653399040a61.FlowToken,
+// of at least minAmount tokens. The payload is a flow.FTTransfer.
+func TransferAbove(token string, minAmount float64) Condition {
+	return transferCondition{token: token, minAmount: minAmount}
+}
+
+func (c transferCondition) Name() string {
+	return fmt.Sprintf("transfer %s >= %g", c.token, c.minAmount)
+}
+
+func (c transferCondition) Check(ctx context.Context, client *findapi.Client, fromHeight, toHeight uint64) ([]Notification, error) {
+	var matches []Notification
+	err := walkBack(fromHeight, func(offset int) ([]flow.FTTransfer, error) {
+		resp, err := client.Flow.GetFTTransfers().Token(c.token).Limit(pageSize).Offset(offset).Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data, nil
+	}, func(t flow.FTTransfer) uint64 { return t.BlockHeight }, func(t flow.FTTransfer) {
+		if t.BlockHeight > toHeight || t.Amount < c.minAmount {
+			return
+		}
+		matches = append(matches, Notification{
+			ID:            fmt.Sprintf("transfer/%s/%s/%s/%s", c.token, t.TransactionID, strings.ToLower(t.Address), t.Direction),
+			BlockHeight:   t.BlockHeight,
+			TransactionID: t.TransactionID,
+			Payload:       t,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(matches)
+	return matches, nil
+}
+
+// walkBack pages through a newest-first listing, passing each record at or
+// above fromHeight to visit in listing order, until a page reaches below
+// fromHeight or the listing ends
+func walkBack[T any](fromHeight uint64, fetch func(offset int) ([]T, error), height func(T) uint64, visit func(T)) error {
+	for offset := 0; ; offset += pageSize {
+		page, err := fetch(offset)
+		if err != nil {
+			return err
+		}
+		passed := false
+		for _, item := range page {
+			if height(item) < fromHeight {
+				passed = true
+				continue
+			}
+			visit(item)
+		}
+		if passed || len(page) < pageSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package watch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/peterargue/find-api/findapitest"
+	"github.com/peterargue/find-api/flow"
+)
+
+func TestTransferAbove(t *testing.T) {
+	server := findapitest.NewServer()
+	defer server.Close()
+
+	body, _ := json.Marshal(flow.TransfersResponse{Data: []flow.FTTransfer{
+		{BlockHeight: 130, TransactionID: "late", Amount: 5000},
+		{BlockHeight: 120, TransactionID: "big2", Amount: 2500, Address: "0xABC", Direction: "in"},
+		{BlockHeight: 115, TransactionID: "small", Amount: 10},
+		{BlockHeight: 110, TransactionID: "big1", Amount: 1000, Address: "0xdef", Direction: "out"},
+		{BlockHeight: 90, TransactionID: "old", Amount: 9000},
+	}})
+	server.AddFixture("GET /flow/v1/ft/transfer", http.StatusOK, body)
+
+	cond := TransferAbove("A.1654653399040a61.FlowToken", 1000)
+	matches, err := cond.Check(context.Background(), server.Client(), 100, 125)
+	if err != nil {
+		t.Fatalf("Check failed: %v", err)
+	}
+	if len(matches) != 2 {
+		t.Fatalf("Expected 2 matches, got %+v", matches)
+	}
+	if matches[0].TransactionID != "big1" || matches[1].TransactionID != "big2" {
+		t.Errorf("Expected matches oldest first, got %s, %s", matches[0].TransactionID, matches[1].TransactionID)
+	}
+	if matches[1].ID != "transfer/A.1654653399040a61.FlowToken/big2/0xabc/in" {
+		t.Errorf("Unexpected ID %s", matches[1].ID)
+	}
+	if _, ok := matches[0].Payload.(flow.FTTransfer); !ok {
+		t.Errorf("Expected FTTransfer payload, got %T", matches[0].Payload)
+	}
+}
+
+func TestAccountActivity(t *testing.T) {
+	server := findapitest.NewServer()
+	defer server.Close()
+
+	body, _ := json.Marshal(flow.AccountTransactionsResponse{Data: []flow.AccountTransaction{
+		{BlockHeight: 105, TransactionID: "tx2"},
+		{BlockHeight: 101, TransactionID: "tx1"},
+		{BlockHeight: 99, TransactionID: "tx0"},
+	}})
+	server.AddFixture("GET /flow/v1/account/{address}/transaction", http.StatusOK, body)
+
+	matches, err := AccountActivity("0x1654653399040a61").Check(context.Background(), server.Client(), 100, 110)
+	if err != nil {
+		t.Fatalf("Check failed: %v", err)
+	}
+	if len(matches) != 2 || matches[0].TransactionID != "tx1" || matches[1].TransactionID != "tx2" {
+		t.Fatalf("Expected tx1 and tx2, got %+v", matches)
+	}
+}
@@ -0,0 +1,195 @@
+// Package watch polls the API for registered conditions, such as an event
+// being emitted, activity on an account or a large token transfer, and
+// dispatches a notification to a callback or a signed webhook for each match.
+//
+//	w := watch.New(client, watch.WithInterval(30*time.Second))
+//	w.Register(watch.TransferAbove("A.1654653399040a61.FlowToken", 100000), watch.Webhook(url, secret))
+//	err := w.Run(ctx)
+package watch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	findapi "github.com/peterargue/find-api"
+)
+
+// DefaultInterval is how often conditions are checked unless WithInterval is set
+const DefaultInterval = 15 * time.Second
+
+// Notification describes one match of a condition
+type Notification struct {
+	// ID identifies the match, stable across retries, so receivers can
+	// discard duplicates
+	ID string `json:"id"`
+	// Condition is the name of the condition that matched
+	Condition     string    `json:"condition"`
+	Time          time.Time `json:"time"`
+	BlockHeight   uint64    `json:"block_height"`
+	TransactionID string    `json:"transaction_id,omitempty"`
+	// Payload is the matched record, e.g. a simple.Event or flow.FTTransfer
+	Payload any `json:"payload"`
+}
+
+// Handler receives notifications. A returned error is retried with the
+// watcher's backoff policy.
+type Handler func(ctx context.Context, n Notification) error
+
+// Option configures a Watcher
+type Option func(*Watcher)
+
+// WithInterval sets how often conditions are checked (default DefaultInterval)
+func WithInterval(interval time.Duration) Option {
+	return func(w *Watcher) {
+		w.interval = interval
+	}
+}
+
+// WithRetry sets the backoff policy for failed deliveries. A zero
+// MaxAttempts defaults to 5 attempts per notification.
+func WithRetry(b findapi.Backoff) Option {
+	return func(w *Watcher) {
+		w.retry = b
+	}
+}
+
+// OnError sets a callback for errors that do not stop the watcher: failed
+// condition checks, which are retried on the next poll, and notifications
+// that could not be delivered after every retry
+func OnError(fn func(error)) Option {
+	return func(w *Watcher) {
+		w.onError = fn
+	}
+}
+
+// rule pairs a condition with its handler and the last height it checked
+type rule struct {
+	condition Condition
+	handler   Handler
+	checked   uint64
+}
+
+// Watcher checks registered conditions against new blocks and dispatches
+// notifications for their matches
+type Watcher struct {
+	client   *findapi.Client
+	interval time.Duration
+	retry    findapi.Backoff
+	onError  func(error)
+
+	mu    sync.Mutex
+	rules []*rule
+}
+
+// New creates a watcher polling through client
+func New(client *findapi.Client, opts ...Option) *Watcher {
+	w := &Watcher{
+		client:   client,
+		interval: DefaultInterval,
+		retry:    findapi.DefaultBackoff(),
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	if w.retry.MaxAttempts == 0 {
+		w.retry.MaxAttempts = 5
+	}
+	return w
+}
+
+// Register adds a condition and the handler notified of its matches. Only
+// blocks sealed after the condition's first check are considered, so
+// registering does not replay history.
+func (w *Watcher) Register(condition Condition, handler Handler) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.rules = append(w.rules, &rule{condition: condition, handler: handler})
+}
+
+// Run checks the registered conditions every interval until ctx is done,
+// then returns ctx's error
+func (w *Watcher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.Poll(ctx); err != nil && ctx.Err() == nil {
+			w.reportError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll checks every registered condition once against the blocks sealed
+// since its previous check and dispatches the matches. Run calls it on each
+// tick; it is exported for callers driving their own schedule, and must not
+// run concurrently with itself or Run.
+func (w *Watcher) Poll(ctx context.Context) error {
+	head, err := w.client.Simple.GetLatestHeight(ctx)
+	if err != nil {
+		return fmt.Errorf("fetch latest height: %w", err)
+	}
+
+	w.mu.Lock()
+	rules := append([]*rule(nil), w.rules...)
+	w.mu.Unlock()
+
+	var errs []error
+	for _, r := range rules {
+		if r.checked == 0 {
+			r.checked = head
+			continue
+		}
+		if head <= r.checked {
+			continue
+		}
+
+		matches, err := r.condition.Check(ctx, w.client, r.checked+1, head)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("check %s: %w", r.condition.Name(), err))
+			continue
+		}
+		for _, n := range matches {
+			n.Condition = r.condition.Name()
+			if n.Time.IsZero() {
+				n.Time = time.Now()
+			}
+			if err := w.dispatch(ctx, r.handler, n); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				w.reportError(fmt.Errorf("deliver %s: %w", n.ID, err))
+			}
+		}
+		r.checked = head
+	}
+	return errors.Join(errs...)
+}
+
+// dispatch delivers a notification, retrying failures with the backoff policy
+func (w *Watcher) dispatch(ctx context.Context, handler Handler, n Notification) error {
+	var lastErr error
+	for range w.retry.Attempts(ctx) {
+		if lastErr = handler(ctx, n); lastErr == nil {
+			return nil
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return errors.Join(err, lastErr)
+	}
+	return lastErr
+}
+
+// reportError passes an error to the OnError callback, if set
+func (w *Watcher) reportError(err error) {
+	if w.onError != nil {
+		w.onError(err)
+	}
+}
@@ -0,0 +1,123 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	findapi "github.com/peterargue/find-api"
+	"github.com/peterargue/find-api/findapitest"
+	"github.com/peterargue/find-api/simple"
+)
+
+const deposited = "A.1654653399040a61.FlowToken.TokensDeposited"
+
+func TestWatcher_Poll(t *testing.T) {
+	server := findapitest.NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	server.AddBlocks(simple.Block{Height: 100})
+	// Events up to the first poll are history and are not notified
+	server.AddEvents(simple.Event{Name: deposited, BlockHeight: 100, TransactionHash: "old"})
+
+	var (
+		got      []Notification
+		failures = 1
+		reported []error
+	)
+	w := New(client,
+		WithRetry(findapi.Backoff{MaxAttempts: 3}),
+		OnError(func(err error) { reported = append(reported, err) }),
+	)
+	w.Register(Event(deposited), func(ctx context.Context, n Notification) error {
+		if failures > 0 {
+			failures--
+			return errors.New("receiver down")
+		}
+		got = append(got, n)
+		return nil
+	})
+
+	ctx := context.Background()
+	if err := w.Poll(ctx); err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("Expected no notifications on the first poll, got %d", len(got))
+	}
+
+	server.AddBlocks(simple.Block{Height: 105})
+	server.AddEvents(
+		simple.Event{Name: deposited, BlockHeight: 102, TransactionHash: "a", EventIndex: 1},
+		simple.Event{Name: deposited, BlockHeight: 104, TransactionHash: "b"},
+	)
+	if err := w.Poll(ctx); err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("Expected 2 notifications, got %d", len(got))
+	}
+	if got[0].ID != deposited+"/a/1" || got[0].Condition != "event "+deposited || got[0].BlockHeight != 102 {
+		t.Errorf("Unexpected notification %+v", got[0])
+	}
+	if got[0].Time.IsZero() {
+		t.Error("Expected notification time to be set")
+	}
+	if len(reported) != 0 {
+		t.Errorf("Expected the failed delivery to succeed on retry, got %v", reported)
+	}
+
+	// Nothing new sealed: nothing is notified again
+	if err := w.Poll(ctx); err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if len(got) != 2 {
+		t.Errorf("Expected no duplicate notifications, got %d", len(got))
+	}
+}
+
+func TestWatcher_DeliveryExhausted(t *testing.T) {
+	server := findapitest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	server.AddBlocks(simple.Block{Height: 100})
+
+	var reported []error
+	w := New(client, WithRetry(findapi.Backoff{MaxAttempts: 2}), OnError(func(err error) { reported = append(reported, err) }))
+	attempts := 0
+	w.Register(Event(deposited), func(ctx context.Context, n Notification) error {
+		attempts++
+		return errors.New("receiver down")
+	})
+
+	ctx := context.Background()
+	w.Poll(ctx)
+	server.AddBlocks(simple.Block{Height: 101})
+	server.AddEvents(simple.Event{Name: deposited, BlockHeight: 101, TransactionHash: "a"})
+	if err := w.Poll(ctx); err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts, got %d", attempts)
+	}
+	if len(reported) != 1 {
+		t.Errorf("Expected the undelivered notification to be reported, got %v", reported)
+	}
+}
+
+func TestWatcher_Run(t *testing.T) {
+	server := findapitest.NewServer()
+	defer server.Close()
+	server.AddBlocks(simple.Block{Height: 100})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	w := New(server.Client(), WithInterval(10*time.Millisecond))
+	w.Register(Event(deposited), func(ctx context.Context, n Notification) error { return nil })
+	if err := w.Run(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded, got %v", err)
+	}
+}
@@ -0,0 +1,110 @@
+package watch
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Headers set on webhook requests
+const (
+	// SignatureHeader carries "sha256=" followed by the hex HMAC-SHA256 of
+	// the timestamp, a dot and the request body, keyed with the secret
+	SignatureHeader = "X-Find-Signature"
+	// TimestampHeader carries the Unix time the request was signed at
+	TimestampHeader = "X-Find-Timestamp"
+)
+
+// WebhookOption configures a webhook handler
+type WebhookOption func(*webhook)
+
+// WithWebhookClient sets the HTTP client used to deliver webhooks
+// (default http.DefaultClient)
+func WithWebhookClient(client *http.Client) WebhookOption {
+	return func(w *webhook) {
+		w.client = client
+	}
+}
+
+type webhook struct {
+	url    string
+	secret []byte
+	client *http.Client
+}
+
+// Webhook returns a handler POSTing each notification as JSON to url, signed
+// with secret (see SignatureHeader). A non-2xx response is an error, so the
+// delivery is retried by the watcher. Receivers check requests with
+// VerifySignature.
+func Webhook(url, secret string, opts ...WebhookOption) Handler {
+	w := &webhook{url: url, secret: []byte(secret), client: http.DefaultClient}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w.deliver
+}
+
+func (w *webhook) deliver(ctx context.Context, n Notification) error {
+	body, err := json.Marshal(n)
+	if err != nil {
+		return fmt.Errorf("encode notification: %w", err)
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, "sha256="+sign(w.secret, timestamp, body))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// VerifySignature reports whether a webhook request's signature matches its
+// timestamp and body for secret, and the timestamp is within maxAge of now.
+// A zero maxAge skips the age check.
+func VerifySignature(secret string, header http.Header, body []byte, maxAge time.Duration) bool {
+	timestamp := header.Get(TimestampHeader)
+	signature, ok := strings.CutPrefix(header.Get(SignatureHeader), "sha256=")
+	if timestamp == "" || !ok {
+		return false
+	}
+	if maxAge > 0 {
+		unix, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil || time.Since(time.Unix(unix, 0)).Abs() > maxAge {
+			return false
+		}
+	}
+	want := sign([]byte(secret), timestamp, body)
+	return hmac.Equal([]byte(signature), []byte(want))
+}
+
+// sign returns the hex HMAC-SHA256 of timestamp, a dot and body
+func sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,65 @@
+package watch
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	findapi "github.com/peterargue/find-api"
+)
+
+func TestWebhook(t *testing.T) {
+	const secret = "s3cret"
+	calls := 0
+	var received Notification
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := io.ReadAll(r.Body)
+		if !VerifySignature(secret, r.Header, body, time.Minute) {
+			t.Error("Expected a valid signature")
+		}
+		if VerifySignature("wrong", r.Header, body, 0) {
+			t.Error("Expected signature check to fail with the wrong secret")
+		}
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		json.Unmarshal(body, &received)
+	}))
+	defer server.Close()
+
+	hook := Webhook(server.URL, secret)
+	n := Notification{ID: "n1", Condition: "event X", BlockHeight: 7, Payload: map[string]string{"amount": "1.0"}}
+
+	if err := hook(context.Background(), n); err == nil {
+		t.Fatal("Expected an error for a 503 response")
+	}
+
+	w := &Watcher{retry: findapi.Backoff{MaxAttempts: 3}}
+	if err := w.dispatch(context.Background(), hook, n); err != nil {
+		t.Fatalf("dispatch failed: %v", err)
+	}
+	if received.ID != "n1" || received.BlockHeight != 7 {
+		t.Errorf("Unexpected notification received %+v", received)
+	}
+}
+
+func TestVerifySignature_Expired(t *testing.T) {
+	body := []byte(`{"id":"n1"}`)
+	timestamp := "1000"
+	header := http.Header{}
+	header.Set(TimestampHeader, timestamp)
+	header.Set(SignatureHeader, "sha256="+sign([]byte("s"), timestamp, body))
+
+	if !VerifySignature("s", header, body, 0) {
+		t.Error("Expected signature to match without an age check")
+	}
+	if VerifySignature("s", header, body, time.Hour) {
+		t.Error("Expected an old timestamp to be rejected")
+	}
+}